@@ -23,11 +23,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/database"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/jobs"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/routes"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/validators"
@@ -101,6 +103,11 @@ func main() {
 		logger.Fatal("Failed to setup routes", zap.Error(err))
 	}
 
+	// Start background jobs (subscription reminders, trial expiry and email retries)
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	jobs.SetupJobs(jobsCtx, database.GetDB(), logger, cfg)
+
 	// Start server
 	addr := fmt.Sprintf(":%s", cfg.Port)
 	logger.Info("Server starting",
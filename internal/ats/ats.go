@@ -0,0 +1,114 @@
+// Package ats is a rules engine that checks a curriculum for common applicant tracking
+// system (ATS) problems. It is deterministic and does not use the AI layer or its quota.
+package ats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+)
+
+// Severity ranks how much a finding hurts ATS parsing.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// penalty is how many points a finding of the severity removes from the score.
+func (s Severity) penalty() int {
+	switch s {
+	case SeverityError:
+		return 15
+	case SeverityWarning:
+		return 7
+	default:
+		return 2
+	}
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 0
+	case SeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Finding is one rule violation. Section names the curriculum part it refers to. Rule is a
+// stable identifier clients can key on; Message is translated to the input's language.
+type Finding struct {
+	Rule     string
+	Severity Severity
+	Section  string
+	Message  string
+}
+
+// Report is the result of running every rule against a curriculum.
+// Score starts at 100 and loses points per finding; Passed means there are no errors.
+type Report struct {
+	Score    int
+	Passed   bool
+	Findings []Finding
+}
+
+// Input is what the rules inspect. SectionNames are the headings the curriculum is
+// rendered with; Now is the reference time for date checks. Language is the code or tag
+// findings are written in (English when empty or unsupported).
+type Input struct {
+	Curriculum   *models.Curriculums
+	SectionNames []string
+	Now          time.Time
+	Language     string
+}
+
+// rule inspects the input and returns its findings.
+type rule func(in Input) []Finding
+
+// rules run in this order; findings are then sorted by severity, keeping this order.
+var rules = []rule{
+	checkContactFields,
+	checkPhone,
+	checkEmail,
+	checkIntroLength,
+	checkSkills,
+	checkWorkDates,
+	checkWorkGaps,
+	checkEducationDates,
+	checkSectionNames,
+}
+
+// Check runs every rule against the curriculum.
+func Check(in Input) Report {
+	if in.Now.IsZero() {
+		in.Now = time.Now()
+	}
+
+	findings := []Finding{}
+	for _, r := range rules {
+		findings = append(findings, r(in)...)
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity.rank() < findings[j].Severity.rank()
+	})
+
+	score := 100
+	passed := true
+	for _, f := range findings {
+		score -= f.Severity.penalty()
+		if f.Severity == SeverityError {
+			passed = false
+		}
+	}
+	if score < 0 {
+		score = 0
+	}
+
+	return Report{Score: score, Passed: passed, Findings: findings}
+}
@@ -0,0 +1,243 @@
+package ats
+
+import (
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/validation"
+)
+
+const (
+	// maxIntroWords and minIntroWords bound a summary recruiters and parsers read in full.
+	maxIntroWords = 120
+	minIntroWords = 25
+	// maxWorkGap is the longest break between jobs reported as unexplained.
+	maxWorkGap = 6 * 30 * 24 * time.Hour
+	// maxHeadingLength is the longest line treated as a heading inside free text.
+	maxHeadingLength = 40
+)
+
+// standardSectionNames are the headings ATS parsers recognize, in English, Portuguese and Spanish.
+var standardSectionNames = map[string]bool{
+	"summary": true, "professional summary": true, "profile": true, "objective": true,
+	"experience": true, "work experience": true, "professional experience": true, "employment history": true,
+	"education": true, "skills": true, "technical skills": true, "languages": true, "courses": true,
+	"certifications": true, "projects": true, "contact": true, "contact information": true,
+	"personal information": true, "links": true, "social links": true, "awards": true, "publications": true,
+	"volunteer experience": true, "resumo": true, "resumo profissional": true, "perfil": true, "objetivo": true, "experiência": true,
+	"experiência profissional": true, "formação": true, "formação acadêmica": true, "educação": true,
+	"habilidades": true, "competências": true, "idiomas": true, "cursos": true, "certificações": true,
+	"projetos": true, "contato": true,
+	"resumen": true, "perfil profesional": true, "experiencia": true, "experiencia laboral": true,
+	"experiencia profesional": true, "educación": true, "formación": true, "formación académica": true,
+	"habilidades técnicas": true, "cursos y certificaciones": true, "proyectos": true, "contacto": true,
+}
+
+func checkContactFields(in Input) []Finding {
+	c := in.Curriculum
+	var findings []Finding
+	if strings.TrimSpace(c.FullName) == "" {
+		findings = append(findings, Finding{Rule: "missing_full_name", Severity: SeverityError, Section: "contact", Message: i18n.T(in.Language, "Full name is missing.")})
+	}
+	if strings.TrimSpace(c.Email) == "" {
+		findings = append(findings, Finding{Rule: "missing_email", Severity: SeverityError, Section: "contact", Message: i18n.T(in.Language, "Email is missing; recruiters cannot contact you.")})
+	}
+	if strings.TrimSpace(c.Phone) == "" {
+		findings = append(findings, Finding{Rule: "missing_phone", Severity: SeverityError, Section: "contact", Message: i18n.T(in.Language, "Phone number is missing.")})
+	}
+	if strings.TrimSpace(c.SocialLinks) == "" {
+		findings = append(findings, Finding{Rule: "missing_social_links", Severity: SeverityInfo, Section: "contact", Message: i18n.T(in.Language, "Add a LinkedIn or portfolio link.")})
+	}
+	return findings
+}
+
+func checkPhone(in Input) []Finding {
+	phone := strings.TrimSpace(in.Curriculum.Phone)
+	if phone == "" || validation.IsValidPhone(phone) {
+		return nil
+	}
+	return []Finding{{Rule: "invalid_phone", Severity: SeverityError, Section: "contact", Message: i18n.Tf(in.Language, "Phone number %q is not valid; use the international format, e.g. +55 11 91234-5678.", phone)}}
+}
+
+func checkEmail(in Input) []Finding {
+	email := strings.TrimSpace(in.Curriculum.Email)
+	if email == "" {
+		return nil
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return []Finding{{Rule: "invalid_email", Severity: SeverityError, Section: "contact", Message: i18n.Tf(in.Language, "Email %q is not a valid address.", email)}}
+	}
+	return nil
+}
+
+func checkIntroLength(in Input) []Finding {
+	intro := strings.TrimSpace(in.Curriculum.Intro)
+	words := len(strings.Fields(intro))
+	switch {
+	case intro == "":
+		return []Finding{{Rule: "missing_intro", Severity: SeverityWarning, Section: "intro", Message: i18n.T(in.Language, "Add a short professional summary.")}}
+	case words > maxIntroWords:
+		return []Finding{{Rule: "intro_too_long", Severity: SeverityWarning, Section: "intro", Message: i18n.Tf(in.Language, "Summary has %d words; keep it under %d.", words, maxIntroWords)}}
+	case words < minIntroWords:
+		return []Finding{{Rule: "intro_too_short", Severity: SeverityInfo, Section: "intro", Message: i18n.Tf(in.Language, "Summary has only %d words; aim for at least %d.", words, minIntroWords)}}
+	}
+	return nil
+}
+
+func checkSkills(in Input) []Finding {
+	if strings.TrimSpace(in.Curriculum.Skills) == "" {
+		return []Finding{{Rule: "missing_skills", Severity: SeverityError, Section: "skills", Message: i18n.T(in.Language, "Skills are missing; ATS keyword matching relies on them.")}}
+	}
+	return nil
+}
+
+func checkWorkDates(in Input) []Finding {
+	works := in.Curriculum.Works
+	if len(works) == 0 {
+		return []Finding{{Rule: "missing_work_experience", Severity: SeverityWarning, Section: "works", Message: i18n.T(in.Language, "No work experience listed.")}}
+	}
+
+	var findings []Finding
+	current := 0
+	for _, w := range works {
+		label := workLabel(in.Language, w)
+		if w.StartDate.IsZero() {
+			findings = append(findings, Finding{Rule: "missing_start_date", Severity: SeverityError, Section: "works", Message: i18n.Tf(in.Language, "%s has no start date.", label)})
+			continue
+		}
+		if w.StartDate.After(in.Now) {
+			findings = append(findings, Finding{Rule: "future_start_date", Severity: SeverityWarning, Section: "works", Message: i18n.Tf(in.Language, "%s starts in the future.", label)})
+		}
+		if w.EndDate == nil {
+			current++
+		} else if w.EndDate.Before(w.StartDate) {
+			findings = append(findings, Finding{Rule: "end_before_start", Severity: SeverityError, Section: "works", Message: i18n.Tf(in.Language, "%s ends before it starts.", label)})
+		}
+		if strings.TrimSpace(w.Description) == "" {
+			findings = append(findings, Finding{Rule: "missing_work_description", Severity: SeverityWarning, Section: "works", Message: i18n.Tf(in.Language, "%s has no description of responsibilities or achievements.", label)})
+		}
+	}
+	if current > 1 {
+		findings = append(findings, Finding{Rule: "multiple_current_jobs", Severity: SeverityInfo, Section: "works", Message: i18n.Tf(in.Language, "%d jobs have no end date; set end dates for past positions.", current)})
+	}
+	return findings
+}
+
+// checkWorkGaps reports breaks longer than maxWorkGap between consecutive jobs.
+// Overlapping jobs are merged so a long-running job covers shorter ones.
+func checkWorkGaps(in Input) []Finding {
+	type period struct {
+		start, end time.Time
+		label      string
+	}
+	var periods []period
+	for _, w := range in.Curriculum.Works {
+		if w.StartDate.IsZero() {
+			continue
+		}
+		end := in.Now
+		if w.EndDate != nil {
+			end = *w.EndDate
+		}
+		if end.Before(w.StartDate) {
+			continue
+		}
+		periods = append(periods, period{start: w.StartDate, end: end, label: workLabel(in.Language, w)})
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start.Before(periods[j].start) })
+
+	var findings []Finding
+	for i := 1; i < len(periods); i++ {
+		prev := periods[i-1]
+		if gap := periods[i].start.Sub(prev.end); gap > maxWorkGap {
+			findings = append(findings, Finding{
+				Rule:     "employment_gap",
+				Severity: SeverityWarning,
+				Section:  "works",
+				Message:  i18n.Tf(in.Language, "%d-month gap between %s and %s.", int(gap.Hours()/24/30), prev.label, periods[i].label),
+			})
+		}
+		if periods[i].end.Before(prev.end) {
+			periods[i].end = prev.end
+			periods[i].label = prev.label
+		}
+	}
+	return findings
+}
+
+func checkEducationDates(in Input) []Finding {
+	var findings []Finding
+	for _, e := range in.Curriculum.Educations {
+		label := strings.TrimSpace(e.Degree + " - " + e.Institution)
+		if e.StartDate.IsZero() {
+			findings = append(findings, Finding{Rule: "missing_start_date", Severity: SeverityWarning, Section: "educations", Message: i18n.Tf(in.Language, "%s has no start date.", label)})
+			continue
+		}
+		if e.EndDate != nil && e.EndDate.Before(e.StartDate) {
+			findings = append(findings, Finding{Rule: "end_before_start", Severity: SeverityError, Section: "educations", Message: i18n.Tf(in.Language, "%s ends before it starts.", label)})
+		}
+	}
+	return findings
+}
+
+// checkSectionNames flags rendered headings and headings typed inside free-text fields
+// that ATS parsers do not recognize.
+func checkSectionNames(in Input) []Finding {
+	var findings []Finding
+	seen := map[string]bool{}
+	report := func(name, section string) {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if key == "" || standardSectionNames[key] || seen[key] {
+			return
+		}
+		seen[key] = true
+		findings = append(findings, Finding{
+			Rule:     "non_standard_section_name",
+			Severity: SeverityWarning,
+			Section:  section,
+			Message:  i18n.Tf(in.Language, "Section %q may not be recognized by ATS parsers; prefer names like Summary, Experience, Education or Skills.", name),
+		})
+	}
+
+	for _, name := range in.SectionNames {
+		report(name, "layout")
+	}
+
+	c := in.Curriculum
+	fields := []struct{ section, text string }{
+		{"intro", c.Intro},
+		{"skills", c.Skills},
+		{"courses", c.Courses},
+	}
+	for _, w := range c.Works {
+		fields = append(fields, struct{ section, text string }{"works", w.Description})
+	}
+	for _, field := range fields {
+		for _, line := range strings.Split(field.text, "\n") {
+			if heading, ok := headingOf(line); ok {
+				report(heading, field.section)
+			}
+		}
+	}
+	return findings
+}
+
+// headingOf returns the heading text when the line is a short label ending with a colon.
+// All-caps lines are not treated as headings because skills are often acronyms (AWS, SQL).
+func headingOf(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasSuffix(line, ":") || utf8.RuneCountInString(line) > maxHeadingLength {
+		return "", false
+	}
+	heading := strings.TrimSpace(strings.TrimSuffix(line, ":"))
+	return heading, heading != ""
+}
+
+func workLabel(lang string, w models.Work) string {
+	return strings.TrimSpace(i18n.Tf(lang, "%s at %s", w.Position, w.Company))
+}
@@ -0,0 +1,256 @@
+package ats
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+)
+
+var now = time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func work(position string, start time.Time, end *time.Time) models.Work {
+	return models.Work{Position: position, Company: "Acme", StartDate: start, EndDate: end}
+}
+
+func ended(year int, month time.Month) *time.Time {
+	t := date(year, month)
+	return &t
+}
+
+func rulesOf(findings []Finding) []string {
+	rules := []string{}
+	for _, f := range findings {
+		rules = append(rules, f.Rule)
+	}
+	return rules
+}
+
+func messagesOf(findings []Finding) []string {
+	messages := []string{}
+	for _, f := range findings {
+		messages = append(messages, f.Message)
+	}
+	return messages
+}
+
+func TestCheckWorkGaps(t *testing.T) {
+	tests := []struct {
+		name  string
+		works []models.Work
+		want  []string
+	}{
+		{
+			name:  "no works",
+			works: nil,
+			want:  []string{},
+		},
+		{
+			name: "consecutive jobs",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+				work("Senior Developer", date(2020, time.March), ended(2022, time.January)),
+			},
+			want: []string{},
+		},
+		{
+			name: "gap longer than six months",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+				work("Senior Developer", date(2021, time.January), ended(2022, time.January)),
+			},
+			want: []string{"12-month gap between Developer at Acme and Senior Developer at Acme."},
+		},
+		{
+			name: "gap of exactly six months is not reported",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+				work("Senior Developer", date(2020, time.January).Add(maxWorkGap), nil),
+			},
+			want: []string{},
+		},
+		{
+			name: "unsorted input",
+			works: []models.Work{
+				work("Senior Developer", date(2021, time.January), ended(2022, time.January)),
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+			},
+			want: []string{"12-month gap between Developer at Acme and Senior Developer at Acme."},
+		},
+		{
+			name: "long job covers a shorter overlapping one",
+			works: []models.Work{
+				work("Architect", date(2015, time.January), ended(2022, time.January)),
+				work("Consultant", date(2016, time.January), ended(2017, time.January)),
+				work("Director", date(2022, time.March), ended(2023, time.January)),
+			},
+			want: []string{},
+		},
+		{
+			name: "gap after merged jobs names the job that ended last",
+			works: []models.Work{
+				work("Architect", date(2015, time.January), ended(2020, time.January)),
+				work("Consultant", date(2016, time.January), ended(2017, time.January)),
+				work("Director", date(2021, time.January), ended(2022, time.January)),
+			},
+			want: []string{"12-month gap between Architect at Acme and Director at Acme."},
+		},
+		{
+			name: "open-ended job runs until now",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), nil),
+				work("Consultant", date(2023, time.January), ended(2023, time.June)),
+			},
+			want: []string{},
+		},
+		{
+			name: "open-ended job after a gap",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+				work("Senior Developer", date(2021, time.January), nil),
+			},
+			want: []string{"12-month gap between Developer at Acme and Senior Developer at Acme."},
+		},
+		{
+			name: "jobs without start date or ending before they start are ignored",
+			works: []models.Work{
+				work("Developer", date(2018, time.January), ended(2020, time.January)),
+				work("Intern", time.Time{}, ended(2020, time.June)),
+				work("Trainee", date(2020, time.June), ended(2020, time.February)),
+				work("Senior Developer", date(2020, time.May), nil),
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := Input{Curriculum: &models.Curriculums{Works: tt.works}, Now: now}
+			got := messagesOf(checkWorkGaps(in))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkWorkGaps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckIntroLength(t *testing.T) {
+	words := func(n int) string {
+		return strings.TrimSpace(strings.Repeat("word ", n))
+	}
+
+	tests := []struct {
+		name  string
+		intro string
+		want  []string
+	}{
+		{name: "empty", intro: "", want: []string{"missing_intro"}},
+		{name: "whitespace only", intro: " \n\t ", want: []string{"missing_intro"}},
+		{name: "one word", intro: words(1), want: []string{"intro_too_short"}},
+		{name: "one below minimum", intro: words(minIntroWords - 1), want: []string{"intro_too_short"}},
+		{name: "minimum", intro: words(minIntroWords), want: []string{}},
+		{name: "maximum", intro: words(maxIntroWords), want: []string{}},
+		{name: "one above maximum", intro: words(maxIntroWords + 1), want: []string{"intro_too_long"}},
+		{name: "words split by newlines", intro: strings.ReplaceAll(words(minIntroWords), " ", "\n"), want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := Input{Curriculum: &models.Curriculums{Intro: tt.intro}, Now: now}
+			got := rulesOf(checkIntroLength(in))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkIntroLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckIntroLengthMessage(t *testing.T) {
+	in := Input{Curriculum: &models.Curriculums{Intro: "Backend developer"}, Now: now}
+
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "", want: "Summary has only 2 words; aim for at least 25."},
+		{language: "en", want: "Summary has only 2 words; aim for at least 25."},
+		{language: "pt-BR", want: "O resumo tem apenas 2 palavras; procure ter pelo menos 25."},
+		{language: "es", want: "El resumen tiene solo 2 palabras; intenta tener al menos 25."},
+		{language: "xx", want: "Summary has only 2 words; aim for at least 25."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			in.Language = tt.language
+			got := messagesOf(checkIntroLength(in))
+			if len(got) != 1 || got[0] != tt.want {
+				t.Errorf("checkIntroLength() messages = %q, want [%q]", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeadingOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   string
+		wantOK bool
+	}{
+		{name: "label with colon", line: "Experience:", want: "Experience", wantOK: true},
+		{name: "surrounding spaces", line: "  Key Achievements :  ", want: "Key Achievements", wantOK: true},
+		{name: "accented label", line: "Experiência profissional:", want: "Experiência profissional", wantOK: true},
+		{name: "no colon", line: "Experience", want: "", wantOK: false},
+		{name: "all caps without colon", line: "AWS", want: "", wantOK: false},
+		{name: "colon inside the line", line: "Stack: Go, MySQL", want: "", wantOK: false},
+		{name: "colon only", line: ":", want: "", wantOK: false},
+		{name: "spaces and colon", line: "   :", want: "", wantOK: false},
+		{name: "empty line", line: "", want: "", wantOK: false},
+		{name: "at maximum length", line: strings.Repeat("a", maxHeadingLength-1) + ":", want: strings.Repeat("a", maxHeadingLength-1), wantOK: true},
+		{name: "above maximum length", line: strings.Repeat("a", maxHeadingLength) + ":", want: "", wantOK: false},
+		{name: "length counted in runes", line: strings.Repeat("é", maxHeadingLength-1) + ":", want: strings.Repeat("é", maxHeadingLength-1), wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := headingOf(tt.line)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("headingOf(%q) = (%q, %v), want (%q, %v)", tt.line, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCheckEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  []string
+	}{
+		{name: "empty", email: "", want: []string{}},
+		{name: "plain address", email: "jane@example.com", want: []string{}},
+		{name: "surrounding spaces", email: "  jane@example.com ", want: []string{}},
+		{name: "subaddress", email: "jane+cv@example.co.uk", want: []string{}},
+		{name: "display name", email: "Jane Doe <jane@example.com>", want: []string{"invalid_email"}},
+		{name: "angle brackets", email: "<jane@example.com>", want: []string{"invalid_email"}},
+		{name: "missing at sign", email: "jane.example.com", want: []string{"invalid_email"}},
+		{name: "missing domain", email: "jane@", want: []string{"invalid_email"}},
+		{name: "two addresses", email: "jane@example.com, john@example.com", want: []string{"invalid_email"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := Input{Curriculum: &models.Curriculums{Email: tt.email}, Now: now}
+			got := rulesOf(checkEmail(in))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checkEmail(%q) = %v, want %v", tt.email, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,21 +2,24 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config holds application configuration
 type Config struct {
-	Port       string
-	Mode       string
-	DB         DatabaseConfig
-	Redis      RedisConfig
-	WorkerPool WorkerPoolConfig
-	Email      EmailConfig
-	App        AppConfig
-	Stripe     StripeConfig
-	OpenAI     OpenAIConfig
+	Port         string
+	Mode         string
+	DB           DatabaseConfig
+	Redis        RedisConfig
+	WorkerPool   WorkerPoolConfig
+	Email        EmailConfig
+	App          AppConfig
+	Stripe       StripeConfig
+	Subscription SubscriptionPolicy
+	OpenAI       OpenAIConfig
+	Newsletter   NewsletterConfig
 }
 
 // DatabaseConfig holds database configuration
@@ -51,23 +54,56 @@ type WorkerPoolConfig struct {
 
 // EmailConfig holds email configuration
 type EmailConfig struct {
-	APIKey string
-	From   string
+	// Provider is the delivery provider: EmailProviderResend, EmailProviderSMTP or
+	// EmailProviderFile. Empty when no provider is configured.
+	Provider string
+	APIKey   string
+	From     string
+	SMTP     SMTPConfig
+	// OutboxDir is where the file provider writes messages.
+	OutboxDir string
+	// MaxAttempts is how many times a message is tried before the outbox gives up on it.
+	MaxAttempts int
+	// RetryInterval is how often the outbox retries failed messages.
+	RetryInterval time.Duration
+}
+
+// SMTPConfig holds the SMTP server used by the smtp email provider
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// NewsletterConfig holds newsletter configuration
+type NewsletterConfig struct {
+	// LinkBaseURL is the public URL of this API, used in confirmation and unsubscribe links.
+	LinkBaseURL string
+	// SigningSecret signs the confirmation and unsubscribe links. The newsletter is
+	// unavailable when it is empty.
+	SigningSecret string
+	// BatchSize is how many emails of a campaign are sent per job run.
+	BatchSize int
+	// ConfirmationTTL is how long a double opt-in confirmation link stays valid.
+	ConfirmationTTL time.Duration
 }
 
 // AppConfig holds application configuration
 type AppConfig struct {
 	URL         string
 	StaticToken string
+	// CountryHeader is the geo-IP header set by the CDN in front of the API (e.g. CF-IPCountry).
+	// Empty disables viewer countries: clients can set any header, so it must only be
+	// configured when every request goes through that CDN.
+	CountryHeader string
 }
 
 // StripeConfig holds Stripe configuration
 type StripeConfig struct {
 	SecretKey     string
 	WebhookSecret string
-	PriceSimple   string
-	PriceMedium   string
-	PriceUltra    string
+	Prices        PriceCatalog
 }
 
 // OpenAIConfig holds OpenAI configuration
@@ -85,10 +121,6 @@ func LoadConfig() *Config {
 
 	mode := os.Getenv("GIN_MODE")
 
-	// Email configuration
-	emailAPIKey := os.Getenv("RESEND_API_KEY")
-	emailFrom := os.Getenv("MAIL_FROM")
-
 	// App configuration
 	appURL := os.Getenv("APP_URL")
 	staticToken := os.Getenv("BACKEND_APIKEY")
@@ -116,23 +148,21 @@ func LoadConfig() *Config {
 			MemoryLimit:       os.Getenv("REDIS_MEMORY_LIMIT"),
 			MemoryReservation: os.Getenv("REDIS_MEMORY_RESERVATION"),
 		},
-		Email: EmailConfig{
-			APIKey: emailAPIKey,
-			From:   emailFrom,
-		},
+		Email: LoadEmailConfig(),
 		App: AppConfig{
-			URL:         appURL,
-			StaticToken: staticToken,
+			URL:           appURL,
+			StaticToken:   staticToken,
+			CountryHeader: os.Getenv("TRUSTED_COUNTRY_HEADER"),
 		},
 		Stripe: StripeConfig{
 			SecretKey:     os.Getenv("STRIPE_SECRET_KEY"),
 			WebhookSecret: os.Getenv("STRIPE_WEBHOOK_SECRET"),
-			PriceSimple:   os.Getenv("STRIPE_PRICE_ID_SIMPLE"),
-			PriceMedium:   os.Getenv("STRIPE_PRICE_ID_MEDIUM"),
-			PriceUltra:    os.Getenv("STRIPE_PRICE_ID_ULTRA"),
+			Prices:        LoadPriceCatalog(),
 		},
+		Subscription: LoadSubscriptionPolicy(),
 		OpenAI: OpenAIConfig{
 			APIKey: os.Getenv("OPENAI_API_KEY"),
 		},
+		Newsletter: LoadNewsletterConfig(appURL),
 	}
 }
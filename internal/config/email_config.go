@@ -0,0 +1,68 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// Email delivery providers.
+const (
+	EmailProviderResend = "resend"
+	EmailProviderSMTP   = "smtp"
+	EmailProviderFile   = "file"
+)
+
+// Env keys for email delivery.
+const (
+	envEmailProvider      = "EMAIL_PROVIDER"
+	envResendAPIKey       = "RESEND_API_KEY"
+	envMailFrom           = "MAIL_FROM"
+	envSMTPHost           = "SMTP_HOST"
+	envSMTPPort           = "SMTP_PORT"
+	envSMTPUsername       = "SMTP_USERNAME"
+	envSMTPPassword       = "SMTP_PASSWORD"
+	envEmailOutboxDir     = "EMAIL_OUTBOX_DIR"
+	envEmailMaxAttempts   = "EMAIL_MAX_ATTEMPTS"
+	envEmailRetryInterval = "EMAIL_RETRY_INTERVAL_SECONDS"
+)
+
+// LoadEmailConfig reads the email delivery settings from env. Without EMAIL_PROVIDER the
+// provider is resend when RESEND_API_KEY is set, else smtp when SMTP_HOST is set.
+func LoadEmailConfig() EmailConfig {
+	cfg := EmailConfig{
+		Provider: os.Getenv(envEmailProvider),
+		APIKey:   os.Getenv(envResendAPIKey),
+		From:     os.Getenv(envMailFrom),
+		SMTP: SMTPConfig{
+			Host:     os.Getenv(envSMTPHost),
+			Port:     ParseIntEnv(envSMTPPort, 587),
+			Username: os.Getenv(envSMTPUsername),
+			Password: os.Getenv(envSMTPPassword),
+		},
+		OutboxDir:   os.Getenv(envEmailOutboxDir),
+		MaxAttempts: ParseIntEnv(envEmailMaxAttempts, 6),
+	}
+
+	if cfg.Provider == "" {
+		switch {
+		case cfg.APIKey != "":
+			cfg.Provider = EmailProviderResend
+		case cfg.SMTP.Host != "":
+			cfg.Provider = EmailProviderSMTP
+		}
+	}
+	if cfg.OutboxDir == "" {
+		cfg.OutboxDir = "tmp/outbox"
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 6
+	}
+
+	interval := ParseIntEnv(envEmailRetryInterval, 60)
+	if interval <= 0 {
+		interval = 60
+	}
+	cfg.RetryInterval = time.Duration(interval) * time.Second
+
+	return cfg
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"time"
+)
+
+// Env keys for the newsletter.
+const (
+	envAPIURL                      = "API_URL"
+	envNewsletterSigningSecret     = "NEWSLETTER_SIGNING_SECRET"
+	envNewsletterBatchSize         = "NEWSLETTER_BATCH_SIZE"
+	envNewsletterConfirmationHours = "NEWSLETTER_CONFIRMATION_HOURS"
+)
+
+// LoadNewsletterConfig reads the newsletter settings from env. Links default to appURL when
+// API_URL is not set (the frontend proxying the API, as for share pages). There is no
+// default signing secret: without NEWSLETTER_SIGNING_SECRET the newsletter is unavailable.
+func LoadNewsletterConfig(appURL string) NewsletterConfig {
+	linkBaseURL := os.Getenv(envAPIURL)
+	if linkBaseURL == "" {
+		linkBaseURL = appURL
+	}
+
+	batchSize := ParseIntEnv(envNewsletterBatchSize, 100)
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	confirmationHours := ParseIntEnv(envNewsletterConfirmationHours, 72)
+	if confirmationHours <= 0 {
+		confirmationHours = 72
+	}
+
+	return NewsletterConfig{
+		LinkBaseURL:     strings.TrimRight(linkBaseURL, "/"),
+		SigningSecret:   os.Getenv(envNewsletterSigningSecret),
+		BatchSize:       batchSize,
+		ConfirmationTTL: time.Duration(confirmationHours) * time.Hour,
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+)
+
+// Billing intervals supported by the price catalog.
+const (
+	BillingIntervalMonth = "month"
+	BillingIntervalYear  = "year"
+)
+
+// Currencies supported by the price catalog (ISO 4217, lower case as used by Stripe).
+const (
+	CurrencyBRL = "brl"
+	CurrencyEUR = "eur"
+	CurrencyUSD = "usd"
+)
+
+// Env keys for the price catalog.
+// Per-combination prices use STRIPE_PRICE_ID_<PLAN>_<INTERVAL>_<CURRENCY>,
+// e.g. STRIPE_PRICE_ID_MEDIUM_YEAR_EUR. The legacy STRIPE_PRICE_ID_<PLAN>
+// variables are kept as the monthly price in the default currency.
+const (
+	envDefaultCurrency = "STRIPE_DEFAULT_CURRENCY"
+	envPriceIDPrefix   = "STRIPE_PRICE_ID_"
+)
+
+// SupportedBillingIntervals lists the intervals accepted at checkout.
+func SupportedBillingIntervals() []string {
+	return []string{BillingIntervalMonth, BillingIntervalYear}
+}
+
+// SupportedCurrencies lists the currencies accepted at checkout.
+func SupportedCurrencies() []string {
+	return []string{CurrencyBRL, CurrencyEUR, CurrencyUSD}
+}
+
+// PriceCatalogEntry links one Stripe price to a plan, billing interval and currency.
+type PriceCatalogEntry struct {
+	Plan     models.SubscriptionPlan
+	Interval string
+	Currency string
+	PriceID  string
+}
+
+// PriceCatalog holds every sellable Stripe price.
+type PriceCatalog struct {
+	DefaultCurrency string
+	Entries         []PriceCatalogEntry
+}
+
+// Find returns the price for the given plan, interval and currency.
+func (pc PriceCatalog) Find(plan models.SubscriptionPlan, interval, currency string) (PriceCatalogEntry, bool) {
+	for _, e := range pc.Entries {
+		if e.Plan == plan && e.Interval == interval && e.Currency == currency {
+			return e, true
+		}
+	}
+	return PriceCatalogEntry{}, false
+}
+
+// FindByPriceID maps a Stripe price ID back to its catalog entry.
+func (pc PriceCatalog) FindByPriceID(priceID string) (PriceCatalogEntry, bool) {
+	if priceID == "" {
+		return PriceCatalogEntry{}, false
+	}
+	for _, e := range pc.Entries {
+		if e.PriceID == priceID {
+			return e, true
+		}
+	}
+	return PriceCatalogEntry{}, false
+}
+
+// LoadPriceCatalog builds the catalog from STRIPE_PRICE_ID_* environment variables.
+func LoadPriceCatalog() PriceCatalog {
+	defaultCurrency := strings.ToLower(os.Getenv(envDefaultCurrency))
+	if defaultCurrency == "" {
+		defaultCurrency = CurrencyBRL
+	}
+
+	catalog := PriceCatalog{DefaultCurrency: defaultCurrency}
+	paidPlans := []models.SubscriptionPlan{
+		models.SubscriptionPlanSimple,
+		models.SubscriptionPlanMedium,
+		models.SubscriptionPlanUltra,
+	}
+
+	for _, plan := range paidPlans {
+		for _, interval := range SupportedBillingIntervals() {
+			for _, currency := range SupportedCurrencies() {
+				key := fmt.Sprintf("%s%s_%s_%s", envPriceIDPrefix, strings.ToUpper(string(plan)), strings.ToUpper(interval), strings.ToUpper(currency))
+				priceID := os.Getenv(key)
+				if priceID == "" && interval == BillingIntervalMonth && currency == defaultCurrency {
+					priceID = os.Getenv(envPriceIDPrefix + strings.ToUpper(string(plan)))
+				}
+				if priceID == "" {
+					continue
+				}
+				catalog.Entries = append(catalog.Entries, PriceCatalogEntry{
+					Plan:     plan,
+					Interval: interval,
+					Currency: currency,
+					PriceID:  priceID,
+				})
+			}
+		}
+	}
+
+	return catalog
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"os"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+)
+
+// Env keys for subscription lifecycle policies.
+const (
+	envTrialPlan                  = "SUBSCRIPTION_TRIAL_PLAN"
+	envTrialDays                  = "SUBSCRIPTION_TRIAL_DAYS"
+	envTrialReminderDays          = "SUBSCRIPTION_TRIAL_REMINDER_DAYS"
+	envPastDueGraceDays           = "SUBSCRIPTION_PAST_DUE_GRACE_DAYS"
+	envPaymentFailedReminderHours = "SUBSCRIPTION_PAYMENT_FAILED_REMINDER_HOURS"
+	envSubscriptionJobsInterval   = "SUBSCRIPTION_JOBS_INTERVAL_MINUTES"
+)
+
+// SubscriptionPolicy holds the trial, grace period and reminder rules applied to subscriptions.
+type SubscriptionPolicy struct {
+	// TrialPlan is the paid plan granted during the first-time trial (no card required).
+	TrialPlan models.SubscriptionPlan
+	// TrialDays is the trial length; 0 disables trials.
+	TrialDays int
+	// TrialReminderDays is how many days before the trial ends the reminder email is sent.
+	TrialReminderDays int
+	// PastDueGraceDays is how long a past_due subscription keeps its paid plan.
+	PastDueGraceDays int
+	// PaymentFailedReminderHours is the delay after a failed payment before the reminder email is sent.
+	PaymentFailedReminderHours int
+	// JobsInterval is how often the scheduled subscription jobs run.
+	JobsInterval time.Duration
+}
+
+// TrialEnabled reports whether first-time trials can be started.
+func (p SubscriptionPolicy) TrialEnabled() bool {
+	return p.TrialDays > 0 && p.TrialPlan.IsValid() && p.TrialPlan != models.SubscriptionPlanFree
+}
+
+// TrialDuration returns the trial length.
+func (p SubscriptionPolicy) TrialDuration() time.Duration {
+	return time.Duration(p.TrialDays) * 24 * time.Hour
+}
+
+// PastDueGrace returns the grace period granted to past_due subscriptions.
+func (p SubscriptionPolicy) PastDueGrace() time.Duration {
+	return time.Duration(p.PastDueGraceDays) * 24 * time.Hour
+}
+
+// LoadSubscriptionPolicy reads the subscription policies from env (SUBSCRIPTION_*) with fallback to defaults.
+func LoadSubscriptionPolicy() SubscriptionPolicy {
+	trialPlan := models.SubscriptionPlan(os.Getenv(envTrialPlan))
+	if trialPlan == "" {
+		trialPlan = models.SubscriptionPlanMedium
+	}
+
+	interval := ParseIntEnv(envSubscriptionJobsInterval, 60)
+	if interval <= 0 {
+		interval = 60
+	}
+
+	return SubscriptionPolicy{
+		TrialPlan:                  trialPlan,
+		TrialDays:                  ParseIntEnv(envTrialDays, 7),
+		TrialReminderDays:          ParseIntEnv(envTrialReminderDays, 2),
+		PastDueGraceDays:           ParseIntEnv(envPastDueGraceDays, 3),
+		PaymentFailedReminderHours: ParseIntEnv(envPaymentFailedReminderHours, 1),
+		JobsInterval:               time.Duration(interval) * time.Minute,
+	}
+}
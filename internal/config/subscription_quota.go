@@ -12,18 +12,29 @@ const (
 	envQuotaUltraMonthly  = "SUBSCRIPTION_QUOTA_ULTRA_MONTHLY"
 )
 
-// PlanQuota holds the monthly request limit for a subscription plan.
+// Env keys for the number of alternatives an AI generation may return per plan.
+const (
+	envMaxAlternativesFree   = "SUBSCRIPTION_MAX_ALTERNATIVES_FREE"
+	envMaxAlternativesSimple = "SUBSCRIPTION_MAX_ALTERNATIVES_SIMPLE"
+	envMaxAlternativesMedium = "SUBSCRIPTION_MAX_ALTERNATIVES_MEDIUM"
+	envMaxAlternativesUltra  = "SUBSCRIPTION_MAX_ALTERNATIVES_ULTRA"
+)
+
+// PlanQuota holds the monthly request limit for a subscription plan and how many
+// alternatives a single AI generation may return.
 type PlanQuota struct {
 	MonthlyRequests int64
+	MaxAlternatives int
 }
 
 // DefaultAIQuotaByPlan returns the monthly request quota per subscription plan,
-// read from env (SUBSCRIPTION_QUOTA_*_MONTHLY) with fallback to defaults.
+// read from env (SUBSCRIPTION_QUOTA_*_MONTHLY and SUBSCRIPTION_MAX_ALTERNATIVES_*)
+// with fallback to defaults.
 func DefaultAIQuotaByPlan() map[models.SubscriptionPlan]PlanQuota {
 	return map[models.SubscriptionPlan]PlanQuota{
-		models.SubscriptionPlanFree:   {MonthlyRequests: int64(ParseIntEnv(envQuotaFreeMonthly, 10))},
-		models.SubscriptionPlanSimple: {MonthlyRequests: int64(ParseIntEnv(envQuotaSimpleMonthly, 30))},
-		models.SubscriptionPlanMedium: {MonthlyRequests: int64(ParseIntEnv(envQuotaMediumMonthly, 100))},
-		models.SubscriptionPlanUltra:  {MonthlyRequests: int64(ParseIntEnv(envQuotaUltraMonthly, -1))},
+		models.SubscriptionPlanFree:   {MonthlyRequests: int64(ParseIntEnv(envQuotaFreeMonthly, 10)), MaxAlternatives: ParseIntEnv(envMaxAlternativesFree, 1)},
+		models.SubscriptionPlanSimple: {MonthlyRequests: int64(ParseIntEnv(envQuotaSimpleMonthly, 30)), MaxAlternatives: ParseIntEnv(envMaxAlternativesSimple, 2)},
+		models.SubscriptionPlanMedium: {MonthlyRequests: int64(ParseIntEnv(envQuotaMediumMonthly, 100)), MaxAlternatives: ParseIntEnv(envMaxAlternativesMedium, 3)},
+		models.SubscriptionPlanUltra:  {MonthlyRequests: int64(ParseIntEnv(envQuotaUltraMonthly, -1)), MaxAlternatives: ParseIntEnv(envMaxAlternativesUltra, 5)},
 	}
 }
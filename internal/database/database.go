@@ -2,9 +2,11 @@ package database
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
@@ -48,18 +50,44 @@ func AutoMigrate(log *zap.Logger) error {
 	if err := DB.AutoMigrate(
 		&models.User{},
 		&models.Subscription{},
+		&models.PlanPrice{},
+		&models.ReferralCampaign{},
+		&models.ReferralReward{},
+		&models.Invoice{},
+		&models.Organization{},
+		&models.OrganizationMember{},
+		&models.OrganizationInvitation{},
+		&models.ClientProfile{},
 		&models.Curriculums{},
+		&models.CurriculumShareLink{},
+		&models.CurriculumShareView{},
+		&models.Application{},
+		&models.ApplicationContact{},
+		&models.ApplicationStatusChange{},
+		&models.CoverLetter{},
+		&models.InterviewPracticeSet{},
+		&models.InterviewQuestion{},
+		&models.AIGeneration{},
+		&models.AIGenerationAlternative{},
 		&models.Work{},
 		&models.Configuration{},
 		&models.Session{},
 		&models.Education{},
 		&models.CurriculumCreationStats{},
+		&models.OutboxEmail{},
+		&models.NewsletterCampaign{},
+		&models.NewsletterDelivery{},
 	); err != nil {
 		// Restore original logger before returning error
 		DB.Config.Logger = originalLogger
 		return errors.WrapError(err, "failed to run migrations")
 	}
 
+	if err := normalizeConfigurationLanguages(log); err != nil {
+		DB.Config.Logger = originalLogger
+		return errors.WrapError(err, "failed to normalize configuration languages")
+	}
+
 	// Restore original logger after migrations
 	DB.Config.Logger = originalLogger
 
@@ -67,6 +95,48 @@ func AutoMigrate(log *zap.Logger) error {
 	return nil
 }
 
+// normalizeConfigurationLanguages rewrites configuration languages stored before the locale
+// registry ("pt", "pt_PT", "portuguese") to their registry tag, so filters on the tag, like
+// newsletter campaigns, find them. Unknown values become the default language.
+func normalizeConfigurationLanguages(log *zap.Logger) error {
+	tags := make([]string, 0, len(locale.All()))
+	for _, l := range locale.All() {
+		tags = append(tags, l.Tag)
+	}
+
+	var languages []string
+	if err := DB.Unscoped().Model(&models.Configuration{}).
+		Where("language NOT IN ?", tags).
+		Distinct().
+		Pluck("language", &languages).Error; err != nil {
+		return err
+	}
+
+	for _, language := range languages {
+		tag := languageTag(language)
+		if err := DB.Unscoped().Model(&models.Configuration{}).
+			Where("language = ?", language).
+			Update("language", tag).Error; err != nil {
+			return err
+		}
+		log.Info("Normalized configuration language", zap.String("from", language), zap.String("to", tag))
+	}
+	return nil
+}
+
+// languageTag resolves a stored language by code or tag, then by its English or native name.
+func languageTag(language string) string {
+	if l, ok := locale.Lookup(language); ok {
+		return l.Tag
+	}
+	for _, l := range locale.All() {
+		if strings.EqualFold(language, l.Name) || strings.EqualFold(language, l.NativeName) {
+			return l.Tag
+		}
+	}
+	return locale.Default().Tag
+}
+
 // GetDB returns the database instance
 func GetDB() *gorm.DB {
 	return DB
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AIAlternatives is embedded in the responses of the text generators. Alternatives holds
+// every variant returned in the call (the request's alternatives field, bounded by the
+// plan); GenerationID identifies the call when sending feedback.
+type AIAlternatives struct {
+	GenerationID *uuid.UUID `json:"generation_id,omitempty"`
+	Alternatives []string   `json:"alternatives,omitempty"`
+}
+
+// AIGenerationFeedbackRequest marks the alternative the user kept and the ones they discarded.
+// Indexes are zero-based positions in the alternatives list; at least one must be given.
+type AIGenerationFeedbackRequest struct {
+	ChosenIndex     *int   `json:"chosen_index" binding:"omitempty,min=0"`
+	RejectedIndexes []int  `json:"rejected_indexes" binding:"omitempty,dive,min=0"`
+	Comment         string `json:"comment,omitempty" binding:"max=2000"`
+}
+
+// AIGenerationFeedbackResponse represents the feedback stored for a generation
+type AIGenerationFeedbackResponse struct {
+	GenerationID    uuid.UUID  `json:"generation_id"`
+	Feature         string     `json:"feature"`
+	Alternatives    int        `json:"alternatives"`
+	ChosenIndex     *int       `json:"chosen_index,omitempty"`
+	RejectedIndexes []int      `json:"rejected_indexes"`
+	Comment         string     `json:"comment,omitempty"`
+	FeedbackAt      *time.Time `json:"feedback_at,omitempty"`
+}
+
+// AIGenerationChoiceStats is how often the alternative at Index was chosen
+type AIGenerationChoiceStats struct {
+	Index int   `json:"index"`
+	Count int64 `json:"count"`
+}
+
+// AIGenerationFeatureStats summarizes the feedback received by one generator
+type AIGenerationFeatureStats struct {
+	Feature      string                    `json:"feature"`
+	Generations  int64                     `json:"generations"`
+	WithFeedback int64                     `json:"with_feedback"`
+	FeedbackRate float64                   `json:"feedback_rate"`
+	Chosen       int64                     `json:"chosen"`
+	AllRejected  int64                     `json:"all_rejected"`
+	Choices      []AIGenerationChoiceStats `json:"choices"`
+}
+
+// AIGenerationStatsResponse represents the prompt quality analytics of the AI generators
+type AIGenerationStatsResponse struct {
+	Features []AIGenerationFeatureStats `json:"features"`
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicationContactRequest represents a recruiter or interviewer of an application
+type ApplicationContactRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=255"`
+	Role        string `json:"role,omitempty" binding:"omitempty,max=255"`
+	Email       string `json:"email,omitempty" binding:"omitempty,email,max=255"`
+	Phone       string `json:"phone,omitempty" binding:"omitempty,max=50"`
+	LinkedInURL string `json:"linkedin_url,omitempty" binding:"omitempty,url,max=512"`
+}
+
+// ApplicationRequest represents the request to create or update a job application.
+// On update, Contacts replaces the existing contacts; the status is changed through its own endpoint.
+type ApplicationRequest struct {
+	Company      string                      `json:"company" binding:"required,min=1,max=255"`
+	Position     string                      `json:"position" binding:"required,min=1,max=255"`
+	JobURL       string                      `json:"job_url,omitempty" binding:"omitempty,url,max=2048"`
+	Location     string                      `json:"location,omitempty" binding:"omitempty,max=255"`
+	CurriculumID *string                     `json:"curriculum_id,omitempty" binding:"omitempty,uuid"`
+	Status       string                      `json:"status,omitempty" binding:"omitempty,oneof=wishlist applied screening interviewing offer accepted rejected withdrawn"`
+	AppliedAt    *time.Time                  `json:"applied_at,omitempty"`
+	FollowUpAt   *time.Time                  `json:"follow_up_at,omitempty"`
+	Notes        string                      `json:"notes,omitempty" binding:"omitempty,max=10000"`
+	Contacts     []ApplicationContactRequest `json:"contacts,omitempty" binding:"omitempty,max=20,dive"`
+}
+
+// UpdateApplicationStatusRequest represents the request to move an application through the pipeline.
+// FollowUpAt optionally schedules the next follow-up reminder.
+type UpdateApplicationStatusRequest struct {
+	Status     string     `json:"status" binding:"required,oneof=wishlist applied screening interviewing offer accepted rejected withdrawn"`
+	Note       string     `json:"note,omitempty" binding:"omitempty,max=2000"`
+	FollowUpAt *time.Time `json:"follow_up_at,omitempty"`
+}
+
+// ApplicationContactResponse represents an application contact
+type ApplicationContactResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Role        string    `json:"role,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Phone       string    `json:"phone,omitempty"`
+	LinkedInURL string    `json:"linkedin_url,omitempty"`
+}
+
+// ApplicationResponse represents a tracked job application
+type ApplicationResponse struct {
+	ID              uuid.UUID                    `json:"id"`
+	CurriculumID    *uuid.UUID                   `json:"curriculum_id,omitempty"`
+	Company         string                       `json:"company"`
+	Position        string                       `json:"position"`
+	JobURL          string                       `json:"job_url,omitempty"`
+	Location        string                       `json:"location,omitempty"`
+	Status          string                       `json:"status"`
+	AppliedAt       *time.Time                   `json:"applied_at,omitempty"`
+	StatusChangedAt time.Time                    `json:"status_changed_at"`
+	FollowUpAt      *time.Time                   `json:"follow_up_at,omitempty"`
+	Notes           string                       `json:"notes,omitempty"`
+	Contacts        []ApplicationContactResponse `json:"contacts"`
+	CreatedAt       time.Time                    `json:"created_at"`
+	UpdatedAt       time.Time                    `json:"updated_at"`
+}
+
+// ApplicationStatusChangeResponse represents one entry of an application's status history
+type ApplicationStatusChangeResponse struct {
+	FromStatus string    `json:"from_status,omitempty"`
+	ToStatus   string    `json:"to_status"`
+	Note       string    `json:"note,omitempty"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// ApplicationStatsResponse represents the user's application pipeline summary.
+// Rates are percentages of the applications that were actually sent (applied_at set).
+type ApplicationStatsResponse struct {
+	Total             int            `json:"total"`
+	Active            int            `json:"active"`
+	ByStatus          map[string]int `json:"by_status"`
+	AppliedLast30Days int            `json:"applied_last_30_days"`
+	ResponseRate      float64        `json:"response_rate"`
+	InterviewRate     float64        `json:"interview_rate"`
+	OfferRate         float64        `json:"offer_rate"`
+	FollowUpsDue      int            `json:"follow_ups_due"`
+}
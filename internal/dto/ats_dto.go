@@ -0,0 +1,17 @@
+package dto
+
+// ATSFinding represents one rule violation found by the ATS checker
+type ATSFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"` // error, warning or info
+	Section  string `json:"section"`
+	Message  string `json:"message"`
+}
+
+// ATSCheckResponse represents the deterministic ATS check of a curriculum.
+// Score starts at 100 and loses points per finding; Passed means no error-level findings.
+type ATSCheckResponse struct {
+	Score    int          `json:"score"`
+	Passed   bool         `json:"passed"`
+	Findings []ATSFinding `json:"findings"`
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/google/uuid"
 )
 
-// UpdateConfigurationRequest represents the request structure for updating a configuration
+// UpdateConfigurationRequest represents the request structure for updating a configuration.
+// Language is a supported language code or tag ("pt", "pt-BR"); it is stored as a BCP-47 tag.
+// Newsletter is left unchanged when omitted; true starts the double opt-in (a confirmation
+// email is sent) and false unsubscribes.
 type UpdateConfigurationRequest struct {
-	Language   string `json:"language" binding:"omitempty,min=2,max=255"`
-	Newsletter bool   `json:"newsletter" binding:"omitempty"`
+	Language   string `json:"language" binding:"omitempty,locale"`
+	Newsletter *bool  `json:"newsletter" binding:"omitempty"`
 }
 
 // ConfigurationResponse represents the response structure for configuration data
@@ -18,6 +21,16 @@ type ConfigurationResponse struct {
 	UserID     uuid.UUID `json:"user_id"`
 	Language   string    `json:"language"`
 	Newsletter bool      `json:"newsletter"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	// NewsletterStatus is unsubscribed, pending (waiting for the email confirmation) or subscribed
+	NewsletterStatus string    `json:"newsletter_status"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// LocaleResponse represents a language supported by the API
+type LocaleResponse struct {
+	Tag        string `json:"tag"`
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	NativeName string `json:"native_name"`
 }
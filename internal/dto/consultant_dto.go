@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientProfileRequest represents the request to create or update a consultant's client profile
+type ClientProfileRequest struct {
+	Name  string `json:"name" binding:"required,min=2,max=255"`
+	Email string `json:"email" binding:"required,email,max=255"`
+	Notes string `json:"notes" binding:"omitempty,max=2000"`
+}
+
+// ClientProfileResponse represents a client profile managed by a consultant
+type ClientProfileResponse struct {
+	ID          uuid.UUID            `json:"id"`
+	Name        string               `json:"name"`
+	Email       string               `json:"email"`
+	Notes       string               `json:"notes,omitempty"`
+	Curriculums []CurriculumResponse `json:"curriculums,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+// UpdateCurriculumStatusRequest represents the consultant's request to share a curriculum for review or take it back to draft
+type UpdateCurriculumStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=draft in_review"`
+}
+
+// RequestCurriculumChangesRequest represents the client's feedback when a curriculum is sent back to the consultant
+type RequestCurriculumChangesRequest struct {
+	Comment string `json:"comment" binding:"required,min=3,max=2000"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GenerateCoverLetterRequest represents the request to generate a cover letter from a curriculum.
+// Tone defaults to formal and Language to the language the curriculum is written in.
+// When ApplicationID is set, Company and Position default to the tracked application's.
+type GenerateCoverLetterRequest struct {
+	JobDescription string  `json:"job_description" binding:"required,min=50,max=20000"`
+	Company        string  `json:"company,omitempty" binding:"omitempty,max=255"`
+	Position       string  `json:"position,omitempty" binding:"omitempty,max=255"`
+	Tone           string  `json:"tone,omitempty" binding:"omitempty,oneof=formal friendly enthusiastic confident concise"`
+	Language       string  `json:"language,omitempty" binding:"omitempty,locale"`
+	ApplicationID  *string `json:"application_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// UpdateCoverLetterRequest represents the request to edit a generated cover letter
+type UpdateCoverLetterRequest struct {
+	Title    string `json:"title" binding:"required,min=1,max=255"`
+	Company  string `json:"company,omitempty" binding:"omitempty,max=255"`
+	Position string `json:"position,omitempty" binding:"omitempty,max=255"`
+	Content  string `json:"content" binding:"required,min=1,max=20000"`
+}
+
+// CoverLetterResponse represents a stored cover letter
+type CoverLetterResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	CurriculumID   uuid.UUID  `json:"curriculum_id"`
+	ApplicationID  *uuid.UUID `json:"application_id,omitempty"`
+	Title          string     `json:"title"`
+	Company        string     `json:"company,omitempty"`
+	Position       string     `json:"position,omitempty"`
+	JobDescription string     `json:"job_description"`
+	Tone           string     `json:"tone"`
+	Language       string     `json:"language,omitempty"`
+	Content        string     `json:"content"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
@@ -19,27 +19,34 @@ type CreateCurriculumRequest struct {
 	Courses       string                   `json:"courses"`
 	SocialLinks   string                   `json:"social_links"`
 	ImageURL      *string                  `json:"image_url" binding:"omitempty,url"`
+	ClientID      *string                  `json:"client_id,omitempty" binding:"omitempty,uuid"`
 	Works         []CreateWorkRequest      `json:"works"`
 	Educations    []CreateEducationRequest `json:"educations"`
 }
 
 // CurriculumResponse represents the response structure for curriculum data
 type CurriculumResponse struct {
-	ID            uuid.UUID           `json:"id"`
-	FullName      string              `json:"full_name"`
-	Email         string              `json:"email"`
-	Phone         string              `json:"phone"`
-	DriverLicense string              `json:"driver_license"`
-	Intro         string              `json:"intro"`
-	Skills        string              `json:"skills"`
-	Languages     string              `json:"languages"`
-	Courses       string              `json:"courses"`
-	SocialLinks   string              `json:"social_links"`
-	ImageURL      *string             `json:"image_url,omitempty"`
-	Works         []WorkResponse      `json:"works"`
-	Educations    []EducationResponse `json:"educations"`
-	CreatedAt     time.Time           `json:"created_at"`
-	UpdatedAt     time.Time           `json:"updated_at"`
+	ID                 uuid.UUID           `json:"id"`
+	FullName           string              `json:"full_name"`
+	Email              string              `json:"email"`
+	Phone              string              `json:"phone"`
+	DriverLicense      string              `json:"driver_license"`
+	Intro              string              `json:"intro"`
+	Skills             string              `json:"skills"`
+	Languages          string              `json:"languages"`
+	Courses            string              `json:"courses"`
+	SocialLinks        string              `json:"social_links"`
+	ImageURL           *string             `json:"image_url,omitempty"`
+	Works              []WorkResponse      `json:"works"`
+	Educations         []EducationResponse `json:"educations"`
+	ClientProfileID    *uuid.UUID          `json:"client_profile_id,omitempty"`
+	Status             string              `json:"status"`
+	ReviewComment      string              `json:"review_comment,omitempty"`
+	StatusChangedAt    *time.Time          `json:"status_changed_at,omitempty"`
+	SourceCurriculumID *uuid.UUID          `json:"source_curriculum_id,omitempty"`
+	JobDescription     string              `json:"job_description,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
 }
 
 // CurriculumBodyResponse represents the response structure for curriculum body in text format
@@ -52,3 +59,10 @@ type CurriculumListResponse struct {
 	Data       []CurriculumResponse `json:"data"`
 	Pagination CursorPagination     `json:"pagination"`
 }
+
+// TailorCurriculumRequest represents the request to tailor a curriculum to a job posting.
+// Language defaults to the language the curriculum is written in.
+type TailorCurriculumRequest struct {
+	JobDescription string `json:"job_description" binding:"required,min=50,max=20000"`
+	Language       string `json:"language,omitempty" binding:"omitempty,locale"`
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateShareLinkRequest represents the request to create a public link to a curriculum.
+// ExpiresInHours of zero (or omitted) creates a link without expiry.
+type CreateShareLinkRequest struct {
+	Label          string `json:"label,omitempty" binding:"omitempty,max=255"`
+	Password       string `json:"password,omitempty" binding:"omitempty,min=4,max=72"`
+	ExpiresInHours int    `json:"expires_in_hours,omitempty" binding:"omitempty,min=1,max=8760"`
+}
+
+// ShareLinkResponse represents a share link as seen by the curriculum owner
+type ShareLinkResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	CurriculumID      uuid.UUID  `json:"curriculum_id"`
+	Slug              string     `json:"slug"`
+	URL               string     `json:"url"`
+	Label             string     `json:"label,omitempty"`
+	PasswordProtected bool       `json:"password_protected"`
+	Active            bool       `json:"active"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+	RevokedAt         *time.Time `json:"revoked_at,omitempty"`
+	ViewCount         int64      `json:"view_count"`
+	LastViewedAt      *time.Time `json:"last_viewed_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// ShareViewResponse represents one recorded open of a share link
+type ShareViewResponse struct {
+	ViewedAt time.Time `json:"viewed_at"`
+	Referrer string    `json:"referrer,omitempty"`
+	Country  string    `json:"country,omitempty"`
+}
+
+// ShareViewCountResponse represents the number of views for a country or referrer
+type ShareViewCountResponse struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// ShareLinkViewsResponse represents the view analytics of a share link
+type ShareLinkViewsResponse struct {
+	ShareLinkID uuid.UUID                `json:"share_link_id"`
+	TotalViews  int64                    `json:"total_views"`
+	ByCountry   []ShareViewCountResponse `json:"by_country"`
+	ByReferrer  []ShareViewCountResponse `json:"by_referrer"`
+	Recent      []ShareViewResponse      `json:"recent"`
+}
+
+// PublicCurriculumResponse represents the curriculum served on a public share link.
+// Content is the rendered plain text version of the curriculum.
+type PublicCurriculumResponse struct {
+	FullName    string              `json:"full_name"`
+	Email       string              `json:"email"`
+	Phone       string              `json:"phone"`
+	Intro       string              `json:"intro"`
+	Skills      string              `json:"skills"`
+	Languages   string              `json:"languages"`
+	Courses     string              `json:"courses"`
+	SocialLinks string              `json:"social_links"`
+	ImageURL    *string             `json:"image_url,omitempty"`
+	Works       []WorkResponse      `json:"works"`
+	Educations  []EducationResponse `json:"educations"`
+	Content     string              `json:"content"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
@@ -1,9 +1,15 @@
 package dto
 
-// ErrorResponse represents a simple error message response.
-// The actual message varies by status (e.g. 400: "invalid user ID format", 404: "user not found").
+// ErrorResponse documents the RFC 7807 problem body (application/problem+json) of error responses.
+// Code is stable and meant for clients to match on; Detail is translated and varies by case
+// (e.g. 400: "invalid user ID format", 404: "user not found").
 type ErrorResponse struct {
-	Error string `json:"error" example:"user not found"`
+	Type     string `json:"type" example:"urn:dafon-cv:problem:user-not-found"`
+	Title    string `json:"title" example:"Not Found"`
+	Status   int    `json:"status" example:"404"`
+	Detail   string `json:"detail" example:"user not found"`
+	Instance string `json:"instance" example:"/api/v1/users/5f0c3c1e-8a2b-4c1d-9e7f-1a2b3c4d5e6f"`
+	Code     string `json:"code" example:"USER_NOT_FOUND"`
 }
 
 // MessageResponse represents a simple message response (e.g. delete success).
@@ -11,12 +17,29 @@ type MessageResponse struct {
 	Message string `json:"message" example:"User deleted successfully"`
 }
 
-// ErrorResponseValidation represents error response for validation (e.g. generate AI 400).
+// FieldError is one invalid field of a VALIDATION_FAILED problem.
+type FieldError struct {
+	Field   string `json:"field" example:"email"`
+	Message string `json:"message" example:"Invalid email"`
+}
+
+// ErrorResponseValidation documents the problem body of validation errors (e.g. generate AI 400).
 type ErrorResponseValidation struct {
-	Error string `json:"error" example:"Validation error"`
+	Type     string       `json:"type" example:"urn:dafon-cv:problem:validation-failed"`
+	Title    string       `json:"title" example:"Bad Request"`
+	Status   int          `json:"status" example:"400"`
+	Detail   string       `json:"detail" example:"Invalid input data"`
+	Instance string       `json:"instance" example:"/api/v1/generate-intro-ai"`
+	Code     string       `json:"code" example:"VALIDATION_FAILED"`
+	Errors   []FieldError `json:"errors,omitempty"`
 }
 
-// ErrorResponseServer represents error response for server errors (e.g. generate AI 500).
+// ErrorResponseServer documents the problem body of server errors (e.g. generate AI 500).
 type ErrorResponseServer struct {
-	Error string `json:"error" example:"Internal server error"`
+	Type     string `json:"type" example:"urn:dafon-cv:problem:internal-error"`
+	Title    string `json:"title" example:"Internal Server Error"`
+	Status   int    `json:"status" example:"500"`
+	Detail   string `json:"detail" example:"Internal server error"`
+	Instance string `json:"instance" example:"/api/v1/generate-intro-ai"`
+	Code     string `json:"code" example:"INTERNAL_ERROR"`
 }
@@ -2,10 +2,26 @@ package dto
 
 // GenerateAcademicAIRequest represents the request structure for AI filtering
 type GenerateAcademicAIRequest struct {
-	Content string `json:"content" binding:"required,min=3,max=20000"`
+	Content      string `json:"content" binding:"required,min=3,max=20000"`
+	Alternatives int    `json:"alternatives,omitempty" binding:"omitempty,min=1,max=10"`
 }
 
 // GenerateAcademicAIResponse represents the response structure for AI filtering
 type GenerateAcademicAIResponse struct {
 	FilteredContent string `json:"filtered_content"`
+	AIAlternatives
+}
+
+// GeneratedAcademicActivity is one academic activity of the v2 response.
+type GeneratedAcademicActivity struct {
+	Title       string `json:"title"`
+	Type        string `json:"type" enums:"subject,project,research,extracurricular,achievement"`
+	Description string `json:"description"`
+}
+
+// GenerateAcademicAIV2Response represents the typed response of the v2 academic generator.
+// Clarification is set, and Activities empty, when the input was too vague.
+type GenerateAcademicAIV2Response struct {
+	Activities    []GeneratedAcademicActivity `json:"activities"`
+	Clarification string                      `json:"clarification,omitempty"`
 }
@@ -5,7 +5,8 @@ type GenerateAnalyzeAIRequest struct {
 	Content string `json:"content" binding:"required,min=500,max=20000" example:"string"`
 }
 
-// GenerateAnalyzeAIResponse represents the response structure for AI curriculum analysis
+// GenerateAnalyzeAIResponse represents the response structure for AI curriculum analysis.
+// ATSChecks is filled by the deterministic rules engine, not by the AI.
 type GenerateAnalyzeAIResponse struct {
 	Score                 float64           `json:"score,omitempty"`
 	Description           string            `json:"description,omitempty"`
@@ -15,6 +16,7 @@ type GenerateAnalyzeAIResponse struct {
 	ProfessionalAlignment []string          `json:"professional_alignment,omitempty"`
 	Strengths             []string          `json:"strengths,omitempty"`
 	Recommendations       []string          `json:"recommendations,omitempty"`
+	ATSChecks             *ATSCheckResponse `json:"ats_checks,omitempty"`
 }
 
 // ATSCompatibility represents ATS-related evaluation
@@ -2,10 +2,28 @@ package dto
 
 // GenerateCoursesAIRequest represents the request structure for AI filtering
 type GenerateCoursesAIRequest struct {
-	Content string `json:"content" binding:"required,min=3,max=20000"`
+	Content      string `json:"content" binding:"required,min=3,max=20000"`
+	Alternatives int    `json:"alternatives,omitempty" binding:"omitempty,min=1,max=10"`
 }
 
 // GenerateCoursesAIResponse represents the response structure for AI filtering
 type GenerateCoursesAIResponse struct {
 	FilteredContent string `json:"filtered_content"`
+	AIAlternatives
+}
+
+// GeneratedCourse is one suggested course or certification of the v2 response.
+// Provider is empty when the course is not tied to a specific institution.
+type GeneratedCourse struct {
+	Name     string `json:"name"`
+	Type     string `json:"type" enums:"course,certification"`
+	Provider string `json:"provider"`
+	Level    string `json:"level" enums:"beginner,intermediate,advanced"`
+}
+
+// GenerateCoursesAIV2Response represents the typed response of the v2 course generator.
+// Clarification is set, and Courses empty, when the input was too vague.
+type GenerateCoursesAIV2Response struct {
+	Courses       []GeneratedCourse `json:"courses"`
+	Clarification string            `json:"clarification,omitempty"`
 }
@@ -1,11 +1,17 @@
 package dto
 
 // GenerateIntroAIRequest represents the request structure for AI filtering
+// With CurriculumID the output is grounded in that curriculum of the user and written in
+// Language when set, otherwise in the user's configured language.
 type GenerateIntroAIRequest struct {
-	Content string `json:"content" binding:"required,min=3,max=20000"`
+	Content      string `json:"content" binding:"required,min=3,max=20000"`
+	Alternatives int    `json:"alternatives,omitempty" binding:"omitempty,min=1,max=10"`
+	CurriculumID string `json:"curriculum_id,omitempty" binding:"omitempty,uuid"`
+	Language     string `json:"language,omitempty" binding:"omitempty,locale"`
 }
 
 // GenerateIntroAIResponse represents the response structure for AI filtering
 type GenerateIntroAIResponse struct {
 	FilteredContent string `json:"filtered_content"`
+	AIAlternatives
 }
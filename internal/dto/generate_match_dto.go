@@ -0,0 +1,34 @@
+package dto
+
+// GenerateMatchAIRequest represents the request to score a curriculum against a job description
+type GenerateMatchAIRequest struct {
+	JobDescription string `json:"job_description" binding:"required,min=50,max=20000"`
+}
+
+// KeywordCoverage represents how a job description keyword is covered by the curriculum
+type KeywordCoverage struct {
+	Keyword            string   `json:"keyword"`
+	JobMentions        int      `json:"job_mentions"`
+	CurriculumMentions int      `json:"curriculum_mentions"`
+	Sections           []string `json:"sections"`
+	Matched            bool     `json:"matched"`
+}
+
+// BulletRewrite represents a suggested rewrite of a work description
+type BulletRewrite struct {
+	Company       string   `json:"company"`
+	Position      string   `json:"position"`
+	Original      string   `json:"original"`
+	Suggested     string   `json:"suggested"`
+	KeywordsAdded []string `json:"keywords_added,omitempty"`
+}
+
+// GenerateMatchAIResponse represents the match between a curriculum and a job description.
+// Score, keywords and coverage are computed deterministically; rewrites come from the AI.
+type GenerateMatchAIResponse struct {
+	Score             float64           `json:"score"`
+	MatchedKeywords   []string          `json:"matched_keywords"`
+	MissingKeywords   []string          `json:"missing_keywords"`
+	KeywordCoverage   []KeywordCoverage `json:"keyword_coverage"`
+	SuggestedRewrites []BulletRewrite   `json:"suggested_rewrites"`
+}
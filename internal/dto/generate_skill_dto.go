@@ -2,10 +2,27 @@ package dto
 
 // GenerateSkillAIRequest represents the request structure for AI filtering
 type GenerateSkillAIRequest struct {
-	Content string `json:"content" binding:"required,min=3,max=20000"`
+	Content      string `json:"content" binding:"required,min=3,max=20000"`
+	Alternatives int    `json:"alternatives,omitempty" binding:"omitempty,min=1,max=10"`
 }
 
 // GenerateSkillAIResponse represents the response structure for AI filtering
 type GenerateSkillAIResponse struct {
 	FilteredContent string `json:"filtered_content"`
+	AIAlternatives
+}
+
+// GeneratedSkill is one suggested skill of the v2 response.
+// Category is hard or soft; Proficiency is the level the field usually expects.
+type GeneratedSkill struct {
+	Name        string `json:"name"`
+	Category    string `json:"category" enums:"hard,soft"`
+	Proficiency string `json:"proficiency" enums:"beginner,intermediate,advanced,expert"`
+}
+
+// GenerateSkillAIV2Response represents the typed response of the v2 skill generator.
+// Clarification is set, and Skills empty, when the input was too vague.
+type GenerateSkillAIV2Response struct {
+	Skills        []GeneratedSkill `json:"skills"`
+	Clarification string           `json:"clarification,omitempty"`
 }
@@ -1,11 +1,32 @@
 package dto
 
 // GenerateTaskAIRequest represents the request structure for AI filtering
+// With CurriculumID the output is grounded in that curriculum of the user and written in
+// Language when set, otherwise in the user's configured language.
 type GenerateTaskAIRequest struct {
-	Content string `json:"content" binding:"required,min=3,max=20000"`
+	Content      string `json:"content" binding:"required,min=3,max=20000"`
+	Alternatives int    `json:"alternatives,omitempty" binding:"omitempty,min=1,max=10"`
+	CurriculumID string `json:"curriculum_id,omitempty" binding:"omitempty,uuid"`
+	Language     string `json:"language,omitempty" binding:"omitempty,locale"`
 }
 
 // GenerateTaskAIResponse represents the response structure for AI filtering
 type GenerateTaskAIResponse struct {
 	FilteredContent string `json:"filtered_content"`
+	AIAlternatives
+}
+
+// GeneratedTask is one task bullet of the v2 response. ActionVerb is the verb the bullet
+// starts with; Metric suggests how to quantify it and is empty when nothing fits.
+type GeneratedTask struct {
+	Text       string `json:"text"`
+	ActionVerb string `json:"action_verb"`
+	Metric     string `json:"metric"`
+}
+
+// GenerateTaskAIV2Response represents the typed response of the v2 task generator.
+// Clarification is set, and Tasks empty, when the input was too vague.
+type GenerateTaskAIV2Response struct {
+	Tasks         []GeneratedTask `json:"tasks"`
+	Clarification string          `json:"clarification,omitempty"`
 }
@@ -3,16 +3,21 @@ package dto
 // GenerateTranslationAIRequest represents the request structure for AI translation (runtime: flexible map).
 type GenerateTranslationAIRequest struct {
 	CurriculumData map[string]interface{} `json:"curriculum_data" binding:"required"`
-	TargetLanguage string                 `json:"target_language" binding:"required,oneof=pt en es"`
+	TargetLanguage string                 `json:"target_language" binding:"required,locale"`
 }
 
 // GenerateTranslationAIRequestDoc is the documented request for Swagger (same shape as curriculum + target_language).
 type GenerateTranslationAIRequestDoc struct {
 	CurriculumData CurriculumResponse `json:"curriculum_data" binding:"required"`
-	TargetLanguage string             `json:"target_language" binding:"required,oneof=pt en es"`
+	TargetLanguage string             `json:"target_language" binding:"required,locale"`
 }
 
 // GenerateTranslationAIResponse represents the response structure for AI translation (runtime: flexible map).
 type GenerateTranslationAIResponse struct {
 	TranslatedCurriculum map[string]interface{} `json:"translated_curriculum"`
 }
+
+// TranslateCurriculumRequest represents the request to translate a stored curriculum into a new one
+type TranslateCurriculumRequest struct {
+	TargetLanguage string `json:"target_language" binding:"required,locale"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GenerateInterviewPrepRequest represents the request to generate an interview practice set.
+// QuestionCount defaults to 10 and Language to the language the curriculum is written in.
+// When ApplicationID is set, Company and Position default to the tracked application's.
+type GenerateInterviewPrepRequest struct {
+	JobDescription string  `json:"job_description" binding:"required,min=50,max=20000"`
+	Company        string  `json:"company,omitempty" binding:"omitempty,max=255"`
+	Position       string  `json:"position,omitempty" binding:"omitempty,max=255"`
+	QuestionCount  int     `json:"question_count,omitempty" binding:"omitempty,min=4,max=20"`
+	Language       string  `json:"language,omitempty" binding:"omitempty,locale"`
+	ApplicationID  *string `json:"application_id,omitempty" binding:"omitempty,uuid"`
+}
+
+// UpdateInterviewQuestionRequest represents the user's practice notes for a question
+type UpdateInterviewQuestionRequest struct {
+	Notes     string `json:"notes" binding:"max=10000"`
+	Practiced bool   `json:"practiced"`
+}
+
+// STARAnswerResponse is a suggested answer in Situation, Task, Action, Result format
+type STARAnswerResponse struct {
+	Situation string `json:"situation"`
+	Task      string `json:"task"`
+	Action    string `json:"action"`
+	Result    string `json:"result"`
+}
+
+// InterviewQuestionResponse represents a practice question with its suggested answer
+type InterviewQuestionResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	Category  string             `json:"category"`
+	Question  string             `json:"question"`
+	WorkID    *uuid.UUID         `json:"work_id,omitempty"`
+	Answer    STARAnswerResponse `json:"answer"`
+	Notes     string             `json:"notes"`
+	Practiced bool               `json:"practiced"`
+}
+
+// InterviewPracticeSetResponse represents an interview practice set
+type InterviewPracticeSetResponse struct {
+	ID             uuid.UUID                   `json:"id"`
+	CurriculumID   uuid.UUID                   `json:"curriculum_id"`
+	ApplicationID  *uuid.UUID                  `json:"application_id,omitempty"`
+	Title          string                      `json:"title"`
+	Company        string                      `json:"company,omitempty"`
+	Position       string                      `json:"position,omitempty"`
+	JobDescription string                      `json:"job_description"`
+	Language       string                      `json:"language,omitempty"`
+	PracticedCount int                         `json:"practiced_count"`
+	Questions      []InterviewQuestionResponse `json:"questions"`
+	CreatedAt      time.Time                   `json:"created_at"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// LinkedInImportIssue is a row of the LinkedIn export that could not be imported.
+// Row is the line number in the CSV file (the header is line 1) and 0 for whole-file issues.
+type LinkedInImportIssue struct {
+	File   string `json:"file"`
+	Row    int    `json:"row,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// LinkedInImportResponse represents the draft curriculum created from a LinkedIn export.
+// MissingFields lists curriculum fields the export had no data for and that must be filled in.
+type LinkedInImportResponse struct {
+	Curriculum         CurriculumResponse    `json:"curriculum"`
+	FilesRead          []string              `json:"files_read"`
+	ImportedWorks      int                   `json:"imported_works"`
+	ImportedEducations int                   `json:"imported_educations"`
+	ImportedSkills     int                   `json:"imported_skills"`
+	ImportedLanguages  int                   `json:"imported_languages"`
+	MissingFields      []string              `json:"missing_fields"`
+	Skipped            []LinkedInImportIssue `json:"skipped"`
+}
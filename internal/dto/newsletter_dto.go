@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NewsletterSubscriptionResponse represents the newsletter subscription of a user.
+// Status is unsubscribed, pending (waiting for the email confirmation) or subscribed.
+type NewsletterSubscriptionResponse struct {
+	Status         string     `json:"status"`
+	RequestedAt    *time.Time `json:"requested_at,omitempty"`
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty"`
+}
+
+// CreateNewsletterCampaignRequest represents the request to create a newsletter campaign.
+// HTMLBody is sent as written; TextBody defaults to a plain-text version of it. With Language,
+// only subscribers using that language receive the campaign.
+type CreateNewsletterCampaignRequest struct {
+	Subject  string `json:"subject" binding:"required,min=3,max=255"`
+	HTMLBody string `json:"html_body" binding:"required,max=200000"`
+	TextBody string `json:"text_body" binding:"omitempty,max=100000"`
+	Language string `json:"language" binding:"omitempty,locale"`
+}
+
+// NewsletterCampaignStatsResponse counts the emails of a campaign. Queued emails were rejected
+// on the first attempt and are being retried; they end up sent or failed.
+type NewsletterCampaignStatsResponse struct {
+	Recipients   int64 `json:"recipients"`
+	Sent         int64 `json:"sent"`
+	Queued       int64 `json:"queued"`
+	Failed       int64 `json:"failed"`
+	Unsubscribed int64 `json:"unsubscribed"`
+}
+
+// NewsletterCampaignResponse represents a newsletter campaign
+type NewsletterCampaignResponse struct {
+	ID          uuid.UUID                       `json:"id"`
+	Subject     string                          `json:"subject"`
+	HTMLBody    string                          `json:"html_body"`
+	TextBody    string                          `json:"text_body,omitempty"`
+	Language    string                          `json:"language,omitempty"`
+	Status      string                          `json:"status"`
+	CreatedByID uuid.UUID                       `json:"created_by_id"`
+	StartedAt   *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt *time.Time                      `json:"completed_at,omitempty"`
+	CreatedAt   time.Time                       `json:"created_at"`
+	Stats       NewsletterCampaignStatsResponse `json:"stats"`
+}
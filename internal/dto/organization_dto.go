@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateOrganizationRequest represents the request to create an organization
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required,min=2,max=255"`
+}
+
+// InviteOrganizationMemberRequest represents the request to invite a user to the organization
+type InviteOrganizationMemberRequest struct {
+	Email string `json:"email" binding:"required,email,max=255"`
+	Role  string `json:"role" binding:"omitempty,oneof=admin member"`
+}
+
+// AcceptOrganizationInvitationRequest represents the request to join an organization through an invitation token
+type AcceptOrganizationInvitationRequest struct {
+	Token string `json:"token" binding:"required,len=64,hexadecimal"`
+}
+
+// UpdateOrganizationMemberRequest represents the request to change a member's role
+type UpdateOrganizationMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin member"`
+}
+
+// CreateOrganizationCheckoutRequest represents the request to buy seats for the organization
+type CreateOrganizationCheckoutRequest struct {
+	Plan       string `json:"plan" binding:"required,oneof=simple medium ultra"`
+	Seats      int    `json:"seats" binding:"required,min=1,max=1000"`
+	Interval   string `json:"interval,omitempty" binding:"omitempty,oneof=month year"`
+	Currency   string `json:"currency,omitempty" binding:"omitempty,oneof=brl eur usd"`
+	SuccessURL string `json:"success_url" binding:"required,url"`
+	CancelURL  string `json:"cancel_url" binding:"required,url"`
+}
+
+// UpdateOrganizationSeatsRequest represents the request to change the number of paid seats
+type UpdateOrganizationSeatsRequest struct {
+	Seats int `json:"seats" binding:"required,min=1,max=1000"`
+}
+
+// OrganizationMemberResponse represents an organization member
+type OrganizationMemberResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Name     string    `json:"name,omitempty"`
+	Email    string    `json:"email,omitempty"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// OrganizationInvitationResponse represents a pending invitation.
+// Token and InviteURL are only returned when the invitation is created.
+type OrganizationInvitationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Token     string    `json:"token,omitempty"`
+	InviteURL string    `json:"invite_url,omitempty"`
+}
+
+// OrganizationResponse represents the caller's organization, its seat usage and billing state
+type OrganizationResponse struct {
+	ID                uuid.UUID                        `json:"id"`
+	Name              string                           `json:"name"`
+	Role              string                           `json:"role"`
+	Plan              string                           `json:"plan"`
+	Status            string                           `json:"status"`
+	Seats             int                              `json:"seats"`
+	SeatsUsed         int64                            `json:"seats_used"`
+	BillingInterval   string                           `json:"billing_interval,omitempty"`
+	Currency          string                           `json:"currency,omitempty"`
+	CurrentPeriodEnd  *time.Time                       `json:"current_period_end,omitempty"`
+	CancelAtPeriodEnd bool                             `json:"cancel_at_period_end"`
+	Members           []OrganizationMemberResponse     `json:"members"`
+	Invitations       []OrganizationInvitationResponse `json:"invitations,omitempty"`
+}
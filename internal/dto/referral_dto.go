@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReferralSummaryResponse represents the authenticated user's referral code and results
+type ReferralSummaryResponse struct {
+	Code            string                    `json:"code"`
+	ShareURL        string                    `json:"share_url,omitempty"`
+	ReferredCount   int64                     `json:"referred_count"`
+	ConvertedCount  int64                     `json:"converted_count"`
+	BonusAIRequests int64                     `json:"bonus_ai_requests"`
+	Campaign        *ReferralCampaignResponse `json:"campaign,omitempty"`
+}
+
+// CreateReferralCampaignRequest represents the request to create a referral campaign
+type CreateReferralCampaignRequest struct {
+	Name           string     `json:"name" binding:"required,min=3,max=255"`
+	Description    string     `json:"description" binding:"omitempty,max=2000"`
+	RewardType     string     `json:"reward_type" binding:"required,oneof=ai_credits stripe_coupon"`
+	RewardCredits  int64      `json:"reward_credits" binding:"omitempty,min=1,max=10000"`
+	StripeCouponID string     `json:"stripe_coupon_id" binding:"omitempty,max=255"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+}
+
+// ReferralCampaignResponse represents a referral campaign
+type ReferralCampaignResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	RewardType     string     `json:"reward_type"`
+	RewardCredits  int64      `json:"reward_credits,omitempty"`
+	StripeCouponID *string    `json:"stripe_coupon_id,omitempty"`
+	Active         bool       `json:"active"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+	DeactivatedAt  *time.Time `json:"deactivated_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
@@ -8,6 +8,8 @@ import (
 
 type CreateCheckoutSessionRequest struct {
 	Plan       string `json:"plan" validate:"required,oneof=simple medium ultra"`
+	Interval   string `json:"interval,omitempty" binding:"omitempty,oneof=month year"`
+	Currency   string `json:"currency,omitempty" binding:"omitempty,oneof=brl eur usd"`
 	SuccessURL string `json:"success_url" validate:"required,url"`
 	CancelURL  string `json:"cancel_url" validate:"required,url"`
 }
@@ -25,18 +27,69 @@ type CreatePortalSessionResponse struct {
 	PortalURL string `json:"portal_url"`
 }
 
+type ChangePlanRequest struct {
+	Plan string `json:"plan" validate:"required,oneof=simple medium ultra"`
+}
+
+type ChangePlanPreviewResponse struct {
+	CurrentPlan     string     `json:"current_plan"`
+	NewPlan         string     `json:"new_plan"`
+	ChangeType      string     `json:"change_type"`
+	EffectiveAt     time.Time  `json:"effective_at"`
+	Currency        string     `json:"currency"`
+	ProrationAmount int64      `json:"proration_amount"`
+	AmountDue       int64      `json:"amount_due"`
+	NextInvoiceAt   *time.Time `json:"next_invoice_at,omitempty"`
+}
+
+type ChangePlanResponse struct {
+	Plan                  string     `json:"plan"`
+	Status                string     `json:"status"`
+	ChangeType            string     `json:"change_type"`
+	ScheduledPlan         *string    `json:"scheduled_plan,omitempty"`
+	ScheduledPlanChangeAt *time.Time `json:"scheduled_plan_change_at,omitempty"`
+	CurrentPeriodEnd      *time.Time `json:"current_period_end,omitempty"`
+}
+
 type CancelSubscriptionRequest struct {
 	AtPeriodEnd bool `json:"at_period_end"`
 }
 
 type SubscriptionResponse struct {
-	UserID            uuid.UUID  `json:"user_id"`
-	Plan              string     `json:"plan"`
-	Status            string     `json:"status"`
-	StripeCustomerID  *string    `json:"stripe_customer_id,omitempty"`
-	CurrentPeriodEnd  *time.Time `json:"current_period_end,omitempty"`
-	CancelAtPeriodEnd bool       `json:"cancel_at_period_end"`
-	CanceledAt        *time.Time `json:"canceled_at,omitempty"`
-	TrialEndsAt       *time.Time `json:"trial_ends_at,omitempty"`
-	AccessRevokedAt   *time.Time `json:"access_revoked_at,omitempty"`
+	UserID                uuid.UUID  `json:"user_id"`
+	Plan                  string     `json:"plan"`
+	Status                string     `json:"status"`
+	StripeCustomerID      *string    `json:"stripe_customer_id,omitempty"`
+	BillingInterval       string     `json:"billing_interval,omitempty"`
+	Currency              string     `json:"currency,omitempty"`
+	CurrentPeriodEnd      *time.Time `json:"current_period_end,omitempty"`
+	CancelAtPeriodEnd     bool       `json:"cancel_at_period_end"`
+	CanceledAt            *time.Time `json:"canceled_at,omitempty"`
+	TrialEndsAt           *time.Time `json:"trial_ends_at,omitempty"`
+	TrialAvailable        bool       `json:"trial_available"`
+	GracePeriodEndsAt     *time.Time `json:"grace_period_ends_at,omitempty"`
+	BonusAIRequests       int64      `json:"bonus_ai_requests"`
+	AccessRevokedAt       *time.Time `json:"access_revoked_at,omitempty"`
+	ScheduledPlan         *string    `json:"scheduled_plan,omitempty"`
+	ScheduledPlanChangeAt *time.Time `json:"scheduled_plan_change_at,omitempty"`
+}
+
+type InvoiceResponse struct {
+	ID               string     `json:"id"`
+	Number           string     `json:"number,omitempty"`
+	Status           string     `json:"status"`
+	Currency         string     `json:"currency"`
+	AmountDue        int64      `json:"amount_due"`
+	AmountPaid       int64      `json:"amount_paid"`
+	Total            int64      `json:"total"`
+	HostedInvoiceURL string     `json:"hosted_invoice_url,omitempty"`
+	InvoicePDF       string     `json:"invoice_pdf,omitempty"`
+	PeriodStart      *time.Time `json:"period_start,omitempty"`
+	PeriodEnd        *time.Time `json:"period_end,omitempty"`
+	IssuedAt         time.Time  `json:"issued_at"`
+	PaidAt           *time.Time `json:"paid_at,omitempty"`
+}
+
+type InvoicesResponse struct {
+	Invoices []InvoiceResponse `json:"invoices"`
 }
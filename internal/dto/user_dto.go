@@ -8,9 +8,12 @@ import (
 
 // RegisterRequest represents the request structure for user registration
 type RegisterRequest struct {
-	Name     string  `json:"name" binding:"required,min=10,max=100"`
-	Email    string  `json:"email" binding:"required,email"`
-	ImageURL *string `json:"image_url" binding:"omitempty,url"`
+	Name         string  `json:"name" binding:"required,min=10,max=100"`
+	Email        string  `json:"email" binding:"required,email"`
+	ImageURL     *string `json:"image_url" binding:"omitempty,url"`
+	ReferralCode string  `json:"referral_code" binding:"omitempty,max=16"`
+	// Language of the account (configuration and emails); defaults to Accept-Language
+	Language string `json:"language,omitempty" binding:"omitempty,locale"`
 }
 
 // CreateUserRequest is the documented request for POST /user (required fields only).
@@ -68,6 +71,8 @@ type SendEmailRequest struct {
 	Name     string `json:"name" binding:"required,min=2,max=100"`
 	Email    string `json:"email" binding:"required,email"`
 	URLToken string `json:"url_token" binding:"required,min=1"`
+	// Language of the email; defaults to the user's configured language, then Accept-Language
+	Language string `json:"language,omitempty" binding:"omitempty,locale"`
 }
 
 // SendEmailResponse represents the response structure for sending authentication email
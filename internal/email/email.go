@@ -0,0 +1,179 @@
+// Package email renders the transactional emails. Each message is a pair of embedded
+// templates, templates/<name>.html (html/template) and templates/<name>.txt
+// (text/template, which also defines the subject), wrapped in the shared layouts.
+// Templates are written in English and translated through the i18n catalogs with the
+// "t" and "tf" template functions, so a message is localized by adding catalog entries.
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
+)
+
+// Template names a transactional email.
+type Template string
+
+const (
+	TemplateSessionToken           Template = "session_token"
+	TemplateWelcome                Template = "welcome"
+	TemplateSubscriptionActivated  Template = "subscription_activated"
+	TemplatePaymentFailed          Template = "payment_failed"
+	TemplateTrialEnding            Template = "trial_ending"
+	TemplateQuotaNearlyExhausted   Template = "quota_nearly_exhausted"
+	TemplateAccountDeleted         Template = "account_deleted"
+	TemplateApplicationFollowUp    Template = "application_follow_up"
+	TemplateNewsletterConfirmation Template = "newsletter_confirmation"
+	// TemplateNewsletter wraps an admin-written campaign: Body (trusted HTML), TextBody and
+	// Subject come from the campaign.
+	TemplateNewsletter Template = "newsletter"
+)
+
+// Templates lists every transactional email; all of them are parsed by NewRenderer.
+var Templates = []Template{
+	TemplateSessionToken,
+	TemplateWelcome,
+	TemplateSubscriptionActivated,
+	TemplatePaymentFailed,
+	TemplateTrialEnding,
+	TemplateQuotaNearlyExhausted,
+	TemplateAccountDeleted,
+	TemplateApplicationFollowUp,
+	TemplateNewsletterConfirmation,
+	TemplateNewsletter,
+}
+
+//go:embed templates/*
+var templateFiles embed.FS
+
+// Message is a rendered email.
+type Message struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Data is the input of a template. Renderer.Render adds the common fields Lang, AppURL and
+// Year; the other keys are specific to each template. The layouts also show CTAURL as a
+// button labelled CTALabel, and UnsubscribeURL in the footer, when they are set.
+type Data map[string]any
+
+// Renderer renders the embedded templates. It is safe for concurrent use.
+type Renderer struct {
+	appURL string
+	html   map[Template]*htmltemplate.Template
+	text   map[Template]*texttemplate.Template
+}
+
+// NewRenderer parses every template, so a broken template fails at startup instead of
+// when the email is sent. appURL is the frontend URL used by call-to-action links.
+func NewRenderer(appURL string) (*Renderer, error) {
+	r := &Renderer{
+		appURL: strings.TrimRight(appURL, "/"),
+		html:   make(map[Template]*htmltemplate.Template, len(Templates)),
+		text:   make(map[Template]*texttemplate.Template, len(Templates)),
+	}
+
+	for _, name := range Templates {
+		htmlTmpl, err := htmltemplate.New("layout.html").
+			Funcs(htmltemplate.FuncMap(templateFuncs(i18n.SourceCode))).
+			ParseFS(templateFiles, "templates/layout.html", "templates/"+string(name)+".html")
+		if err != nil {
+			return nil, fmt.Errorf("parse %s html template: %w", name, err)
+		}
+		textTmpl, err := texttemplate.New("layout.txt").
+			Funcs(texttemplate.FuncMap(templateFuncs(i18n.SourceCode))).
+			ParseFS(templateFiles, "templates/layout.txt", "templates/"+string(name)+".txt")
+		if err != nil {
+			return nil, fmt.Errorf("parse %s text template: %w", name, err)
+		}
+		if textTmpl.Lookup("subject") == nil {
+			return nil, fmt.Errorf("%s text template does not define a subject", name)
+		}
+		r.html[name] = htmlTmpl
+		r.text[name] = textTmpl
+	}
+
+	return r, nil
+}
+
+// Render renders the subject, HTML and plain-text bodies of a template in lang (a code or
+// tag; English when empty or not translated).
+func (r *Renderer) Render(name Template, lang string, data Data) (*Message, error) {
+	htmlTmpl, ok := r.html[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+	textTmpl := r.text[name]
+
+	lang = i18n.Match(lang)
+	if lang == "" {
+		lang = i18n.SourceCode
+	}
+
+	input := Data{}
+	for key, value := range data {
+		input[key] = value
+	}
+	input["Lang"] = lang
+	input["AppURL"] = r.appURL
+	input["Year"] = time.Now().UTC().Year()
+
+	funcs := templateFuncs(lang)
+
+	// Clone so the language-bound functions of concurrent renders do not race.
+	localizedHTML, err := htmlTmpl.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("clone %s html template: %w", name, err)
+	}
+	localizedText, err := textTmpl.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("clone %s text template: %w", name, err)
+	}
+	localizedHTML.Funcs(htmltemplate.FuncMap(funcs))
+	localizedText.Funcs(texttemplate.FuncMap(funcs))
+
+	var subject, htmlBody, textBody bytes.Buffer
+	if err := localizedText.ExecuteTemplate(&subject, "subject", input); err != nil {
+		return nil, fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := localizedHTML.ExecuteTemplate(&htmlBody, "layout.html", input); err != nil {
+		return nil, fmt.Errorf("render %s html body: %w", name, err)
+	}
+	if err := localizedText.ExecuteTemplate(&textBody, "layout.txt", input); err != nil {
+		return nil, fmt.Errorf("render %s text body: %w", name, err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    htmlBody.String(),
+		Text:    strings.TrimSpace(textBody.String()) + "\n",
+	}, nil
+}
+
+// templateFuncs returns the template functions bound to lang.
+func templateFuncs(lang string) map[string]any {
+	return map[string]any{
+		// t translates a message written in English.
+		"t": func(message string) string {
+			return i18n.T(lang, message)
+		},
+		// tf translates a format written in English and applies args.
+		"tf": func(format string, args ...any) string {
+			return i18n.Tf(lang, format, args...)
+		},
+		// date formats a date the way readers of lang expect.
+		"date": func(t time.Time) string {
+			if lang == i18n.SourceCode {
+				return t.UTC().Format("January 2, 2006")
+			}
+			return t.UTC().Format("02/01/2006")
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package email
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileSender writes every message as an .eml file instead of delivering it. It is meant for
+// development and tests: the files open in any mail client.
+type FileSender struct {
+	dir string
+}
+
+// NewFileSender creates a new FileSender writing to dir, which is created when missing
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create outbox directory: %w", err)
+	}
+	return &FileSender{dir: dir}, nil
+}
+
+// Name returns "file"
+func (s *FileSender) Name() string {
+	return "file"
+}
+
+// Send writes the envelope to <dir>/<timestamp>-<random>.eml
+func (s *FileSender) Send(ctx context.Context, envelope Envelope) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	message, err := buildMIME(envelope, now)
+	if err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
+
+	random := make([]byte, 4)
+	if _, err := rand.Read(random); err != nil {
+		return err
+	}
+	name := now.UTC().Format("20060102T150405.000000000Z") + "-" + hex.EncodeToString(random) + ".eml"
+	if err := os.WriteFile(filepath.Join(s.dir, name), message, 0o644); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package email
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// htmlLineBreak matches the tags that end a line of text, htmlBlockEnd those that end a
+// paragraph.
+var (
+	htmlLineBreak = regexp.MustCompile(`(?i)<br\s*/?>|</(li|tr)>`)
+	htmlBlockEnd  = regexp.MustCompile(`(?i)</(p|div|h[1-6]|table|ul|ol|blockquote)>`)
+	htmlTag       = regexp.MustCompile(`<[^>]*>`)
+	blankLines    = regexp.MustCompile(`\n{3,}`)
+)
+
+// PlainText returns a readable plain-text version of an HTML fragment, for the text part of
+// messages written only in HTML. Links keep their text but lose their target.
+func PlainText(fragment string) string {
+	text := htmlLineBreak.ReplaceAllString(fragment, "\n")
+	text = htmlBlockEnd.ReplaceAllString(text, "\n\n")
+	text = htmlTag.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	text = strings.Join(lines, "\n")
+	return strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n"))
+}
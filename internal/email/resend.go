@@ -0,0 +1,35 @@
+package email
+
+import (
+	"context"
+
+	"github.com/resend/resend-go/v2"
+)
+
+// ResendSender delivers messages through the Resend API.
+type ResendSender struct {
+	client *resend.Client
+}
+
+// NewResendSender creates a new ResendSender
+func NewResendSender(apiKey string) *ResendSender {
+	return &ResendSender{client: resend.NewClient(apiKey)}
+}
+
+// Name returns "resend"
+func (s *ResendSender) Name() string {
+	return "resend"
+}
+
+// Send delivers the envelope with its plain-text alternative
+func (s *ResendSender) Send(ctx context.Context, envelope Envelope) error {
+	_, err := s.client.Emails.SendWithContext(ctx, &resend.SendEmailRequest{
+		From:    envelope.From,
+		To:      []string{envelope.To},
+		Subject: envelope.Subject,
+		Html:    envelope.HTML,
+		Text:    envelope.Text,
+		Headers: envelope.Headers,
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Envelope is a rendered message addressed to one recipient. Headers are extra message
+// headers, such as List-Unsubscribe.
+type Envelope struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+	Headers map[string]string
+}
+
+// EmailSender delivers envelopes through an email provider. Implementations are safe for
+// concurrent use.
+type EmailSender interface {
+	// Name identifies the provider in logs and in the outbox.
+	Name() string
+	Send(ctx context.Context, envelope Envelope) error
+}
+
+// buildMIME encodes the envelope as a multipart/alternative RFC 5322 message, with the
+// plain-text part first so clients prefer the HTML one.
+func buildMIME(envelope Envelope, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=UTF-8", envelope.Text},
+		{"text/html; charset=UTF-8", envelope.HTML},
+	} {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		w, err := parts.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	messageID, err := newMessageID(envelope.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	headers := [][2]string{
+		{"From", envelope.From},
+		{"To", envelope.To},
+		{"Subject", mime.QEncoding.Encode("UTF-8", envelope.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + parts.Boundary()},
+	}
+	extra := make([]string, 0, len(envelope.Headers))
+	for name := range envelope.Headers {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		headers = append(headers, [2]string{textproto.CanonicalMIMEHeaderKey(name), envelope.Headers[name]})
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&message, "%s: %s\r\n", header[0], header[1])
+	}
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+	return message.Bytes(), nil
+}
+
+// newMessageID returns a random Message-ID in the domain of the sender address.
+func newMessageID(from string) (string, error) {
+	domain := "localhost"
+	if address, err := mail.ParseAddress(from); err == nil {
+		if at := strings.LastIndex(address.Address, "@"); at >= 0 {
+			domain = address.Address[at+1:]
+		}
+	}
+
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">", nil
+}
@@ -0,0 +1,243 @@
+package errors
+
+import stderrors "errors"
+
+// Code is a stable, machine-readable error identifier returned to API clients in the
+// "code" member of problem responses. Clients must match on codes, never on messages:
+// messages are translated and may change, codes may not.
+type Code string
+
+const (
+	// Generic codes, also used for errors reported with a bare HTTP status
+	CodeBadRequest      Code = "BAD_REQUEST"
+	CodeValidation      Code = "VALIDATION_FAILED"
+	CodeUnauthorized    Code = "UNAUTHORIZED"
+	CodeForbidden       Code = "FORBIDDEN"
+	CodeNotFound        Code = "NOT_FOUND"
+	CodeConflict        Code = "CONFLICT"
+	CodeGone            Code = "GONE"
+	CodePayloadTooLarge Code = "PAYLOAD_TOO_LARGE"
+	CodeRateLimited     Code = "RATE_LIMITED"
+	CodeInternal        Code = "INTERNAL_ERROR"
+
+	// Authentication and authorization
+	CodeAuthRequired        Code = "AUTH_REQUIRED"
+	CodeInvalidAuthHeader   Code = "INVALID_AUTH_HEADER"
+	CodeInvalidToken        Code = "INVALID_TOKEN"
+	CodeSessionExpired      Code = "SESSION_EXPIRED"
+	CodeAdminRequired       Code = "ADMIN_REQUIRED"
+	CodeOrganizationRole    Code = "ORGANIZATION_ROLE_REQUIRED"
+	CodeInvalidUserID       Code = "INVALID_USER_ID"
+	CodeInvalidLanguage     Code = "INVALID_LANGUAGE"
+	CodeInvalidCurriculumID Code = "INVALID_CURRICULUM_ID"
+
+	// Request content
+	CodeNoWorkExperience      Code = "NO_WORK_EXPERIENCE"
+	CodeInvalidLinkedInExport Code = "INVALID_LINKEDIN_EXPORT"
+
+	// Subscription plans
+	CodeInvalidPlan       Code = "INVALID_PLAN"
+	CodeQuotaExceeded     Code = "QUOTA_EXCEEDED"
+	CodeAlternativesLimit Code = "ALTERNATIVES_LIMIT_EXCEEDED"
+
+	// Plan changes
+	CodeNoActiveSubscription      Code = "NO_ACTIVE_SUBSCRIPTION"
+	CodePlanUnchanged             Code = "PLAN_UNCHANGED"
+	CodeSubscriptionAlreadyActive Code = "SUBSCRIPTION_ALREADY_ACTIVE"
+	CodeNoBillingAccount          Code = "NO_BILLING_ACCOUNT"
+
+	// Free trial
+	CodeTrialUnavailable           Code = "TRIAL_UNAVAILABLE"
+	CodeTrialAlreadyUsed           Code = "TRIAL_ALREADY_USED"
+	CodeTrialAfterPaidSubscription Code = "TRIAL_AFTER_PAID_SUBSCRIPTION"
+
+	// AI generation feedback
+	CodeFeedbackRequired           Code = "FEEDBACK_REQUIRED"
+	CodeAlternativeIndexOutOfRange Code = "ALTERNATIVE_INDEX_OUT_OF_RANGE"
+	CodeChosenAlternativeRejected  Code = "CHOSEN_ALTERNATIVE_REJECTED"
+
+	// Missing resources
+	CodeUserNotFound                    Code = "USER_NOT_FOUND"
+	CodeCurriculumNotFound              Code = "CURRICULUM_NOT_FOUND"
+	CodeCurriculumOrApplicationNotFound Code = "CURRICULUM_OR_APPLICATION_NOT_FOUND"
+	CodeConfigurationNotFound           Code = "CONFIGURATION_NOT_FOUND"
+	CodeCoverLetterNotFound             Code = "COVER_LETTER_NOT_FOUND"
+	CodeGenerationNotFound              Code = "GENERATION_NOT_FOUND"
+	CodeOrganizationNotFound            Code = "ORGANIZATION_NOT_FOUND"
+	CodeClientProfileNotFound           Code = "CLIENT_PROFILE_NOT_FOUND"
+	CodeReferralCampaignNotFound        Code = "REFERRAL_CAMPAIGN_NOT_FOUND"
+	CodeShareLinkNotFound               Code = "SHARE_LINK_NOT_FOUND"
+	CodeApplicationNotFound             Code = "APPLICATION_NOT_FOUND"
+	CodePracticeSetNotFound             Code = "PRACTICE_SET_NOT_FOUND"
+	CodePracticeQuestionNotFound        Code = "PRACTICE_QUESTION_NOT_FOUND"
+
+	// Organizations
+	CodeAlreadyInOrganization          Code = "ALREADY_IN_ORGANIZATION"
+	CodeInvalidOrganizationRole        Code = "INVALID_ORGANIZATION_ROLE"
+	CodeNoSeatsAvailable               Code = "NO_SEATS_AVAILABLE"
+	CodeSeatsBelowUsage                Code = "SEATS_BELOW_USAGE"
+	CodeInvitationNotPending           Code = "INVITATION_NOT_PENDING"
+	CodeInvitationEmailMismatch        Code = "INVITATION_EMAIL_MISMATCH"
+	CodeOrganizationOwnerImmutable     Code = "ORGANIZATION_OWNER_IMMUTABLE"
+	CodeOrganizationSubscriptionActive Code = "ORGANIZATION_SUBSCRIPTION_ACTIVE"
+	CodeNoOrganizationSubscription     Code = "NO_ORGANIZATION_SUBSCRIPTION"
+
+	// Referral campaigns
+	CodeInvalidRewardType     Code = "INVALID_REWARD_TYPE"
+	CodeRewardCreditsRequired Code = "REWARD_CREDITS_REQUIRED"
+	CodeStripeCouponRequired  Code = "STRIPE_COUPON_REQUIRED"
+	CodeInvalidCampaignPeriod Code = "INVALID_CAMPAIGN_PERIOD"
+
+	// Consultant workspace
+	CodeClientHasCurriculums        Code = "CLIENT_HAS_CURRICULUMS"
+	CodeCurriculumNotLinkedToClient Code = "CURRICULUM_NOT_LINKED_TO_CLIENT"
+	CodeInvalidCurriculumStatus     Code = "INVALID_CURRICULUM_STATUS"
+	CodeCurriculumStatusUnchanged   Code = "CURRICULUM_STATUS_UNCHANGED"
+	CodeCurriculumNotInReview       Code = "CURRICULUM_NOT_IN_REVIEW"
+
+	// Job applications
+	CodeInvalidApplicationStatus   Code = "INVALID_APPLICATION_STATUS"
+	CodeApplicationStatusUnchanged Code = "APPLICATION_STATUS_UNCHANGED"
+
+	// Cover letters
+	CodeInvalidExportFormat     Code = "INVALID_EXPORT_FORMAT"
+	CodeCoverLetterContentBlank Code = "COVER_LETTER_CONTENT_BLANK"
+
+	// Curriculum share links
+	CodeShareLinkUnavailable      Code = "SHARE_LINK_UNAVAILABLE"
+	CodeShareLinkPasswordRequired Code = "SHARE_LINK_PASSWORD_REQUIRED"
+	CodeShareLinkInvalidPassword  Code = "SHARE_LINK_INVALID_PASSWORD"
+
+	// Email delivery
+	CodeEmailUnavailable Code = "EMAIL_UNAVAILABLE"
+
+	// Newsletter
+	CodeNewsletterUnavailable      Code = "NEWSLETTER_UNAVAILABLE"
+	CodeInvalidNewsletterToken     Code = "INVALID_NEWSLETTER_TOKEN"
+	CodeNewsletterCampaignNotFound Code = "NEWSLETTER_CAMPAIGN_NOT_FOUND"
+	CodeNewsletterCampaignNotDraft Code = "NEWSLETTER_CAMPAIGN_NOT_DRAFT"
+)
+
+// codeMessages holds the default (English) message of each code, used when an error is
+// reported by code only. The messages double as i18n catalog keys.
+var codeMessages = map[Code]string{
+	CodeBadRequest:      "Invalid request",
+	CodeValidation:      "Invalid input data",
+	CodeUnauthorized:    "authentication required",
+	CodeForbidden:       "access denied",
+	CodeNotFound:        "resource not found",
+	CodeConflict:        "resource already exists",
+	CodeGone:            "resource is no longer available",
+	CodePayloadTooLarge: "request body too large",
+	CodeRateLimited:     "Rate limit exceeded. Please try again later.",
+	CodeInternal:        "Internal server error",
+
+	CodeAuthRequired:        "authorization header required",
+	CodeInvalidAuthHeader:   "invalid authorization header format",
+	CodeInvalidToken:        "invalid token",
+	CodeSessionExpired:      "invalid or expired session",
+	CodeAdminRequired:       "admin access required",
+	CodeOrganizationRole:    "insufficient organization role",
+	CodeInvalidUserID:       "invalid user ID format",
+	CodeInvalidLanguage:     "Unsupported language",
+	CodeInvalidCurriculumID: "invalid curriculum id",
+
+	CodeNoWorkExperience:      "curriculum has no work experience to ground the answers in",
+	CodeInvalidLinkedInExport: "invalid LinkedIn data export",
+
+	CodeInvalidPlan:       "invalid plan",
+	CodeQuotaExceeded:     "plan limit exceeded",
+	CodeAlternativesLimit: "your plan does not allow that many alternatives",
+
+	CodeNoActiveSubscription:      "no active paid subscription to change; use checkout instead",
+	CodePlanUnchanged:             "subscription is already on this plan",
+	CodeSubscriptionAlreadyActive: "subscription already active; use change-plan to switch plans",
+	CodeNoBillingAccount:          "no billing account yet; subscribe through checkout first",
+
+	CodeTrialUnavailable:           "free trial is not available",
+	CodeTrialAlreadyUsed:           "free trial already used",
+	CodeTrialAfterPaidSubscription: "free trial is only available before the first paid subscription",
+
+	CodeFeedbackRequired:           "chosen_index or rejected_indexes is required",
+	CodeAlternativeIndexOutOfRange: "alternative index out of range",
+	CodeChosenAlternativeRejected:  "the chosen alternative cannot also be rejected",
+
+	CodeUserNotFound:                    "user not found",
+	CodeCurriculumNotFound:              "curriculum not found",
+	CodeCurriculumOrApplicationNotFound: "curriculum or application not found",
+	CodeConfigurationNotFound:           "configuration not found for this user",
+	CodeCoverLetterNotFound:             "cover letter not found",
+	CodeGenerationNotFound:              "generation not found",
+	CodeOrganizationNotFound:            "organization not found",
+	CodeClientProfileNotFound:           "client profile not found",
+	CodeReferralCampaignNotFound:        "referral campaign not found",
+	CodeShareLinkNotFound:               "share link not found",
+	CodeApplicationNotFound:             "application not found",
+	CodePracticeSetNotFound:             "practice set not found",
+	CodePracticeQuestionNotFound:        "practice set or question not found",
+
+	CodeAlreadyInOrganization:          "user already belongs to an organization",
+	CodeInvalidOrganizationRole:        "invalid organization role",
+	CodeNoSeatsAvailable:               "no seats available; increase the number of seats first",
+	CodeSeatsBelowUsage:                "seats must cover the members and pending invitations",
+	CodeInvitationNotPending:           "invitation expired or no longer valid",
+	CodeInvitationEmailMismatch:        "invitation was sent to a different email address",
+	CodeOrganizationOwnerImmutable:     "the organization owner cannot be changed or removed",
+	CodeOrganizationSubscriptionActive: "organization subscription already active; use the seats endpoint to change seats",
+	CodeNoOrganizationSubscription:     "no active organization subscription; use checkout instead",
+
+	CodeInvalidRewardType:     "invalid reward_type; use ai_credits or stripe_coupon",
+	CodeRewardCreditsRequired: "reward_credits is required for ai_credits campaigns",
+	CodeStripeCouponRequired:  "stripe_coupon_id is required for stripe_coupon campaigns",
+	CodeInvalidCampaignPeriod: "ends_at must be after starts_at",
+
+	CodeClientHasCurriculums:        "client profile still has curriculums; delete them first",
+	CodeCurriculumNotLinkedToClient: "only curriculums linked to a client profile can be shared for review",
+	CodeInvalidCurriculumStatus:     "invalid curriculum status",
+	CodeCurriculumStatusUnchanged:   "curriculum already has this status",
+	CodeCurriculumNotInReview:       "curriculum is not awaiting review",
+
+	CodeInvalidApplicationStatus:   "invalid application status",
+	CodeApplicationStatusUnchanged: "application already has this status",
+
+	CodeInvalidExportFormat:     "invalid export format; use txt or html",
+	CodeCoverLetterContentBlank: "content must not be blank",
+
+	CodeShareLinkUnavailable:      "share link is no longer available",
+	CodeShareLinkPasswordRequired: "password required",
+	CodeShareLinkInvalidPassword:  "invalid password",
+
+	CodeEmailUnavailable: "email delivery is not configured",
+
+	CodeNewsletterUnavailable:      "newsletter is not configured",
+	CodeInvalidNewsletterToken:     "invalid or expired newsletter link",
+	CodeNewsletterCampaignNotFound: "newsletter campaign not found",
+	CodeNewsletterCampaignNotDraft: "newsletter campaign was already sent",
+}
+
+// Message returns the default English message of the code.
+func (c Code) Message() string {
+	if message, ok := codeMessages[c]; ok {
+		return message
+	}
+	return codeMessages[CodeInternal]
+}
+
+// NewCodedError creates an application error carrying code, with message as its text
+// (the code's default message when empty).
+func NewCodedError(code Code, message string) *AppError {
+	if message == "" {
+		message = code.Message()
+	}
+	return &AppError{code: code, message: message}
+}
+
+// CodeOf returns the code of the first AppError in err's chain, or "" when there is none
+// or it carries no code.
+func CodeOf(err error) Code {
+	var appErr *AppError
+	if stderrors.As(err, &appErr) {
+		return appErr.code
+	}
+	return ""
+}
@@ -41,6 +41,7 @@ var (
 
 // AppError represents an error that can occur during application operations
 type AppError struct {
+	code    Code
 	message string
 }
 
@@ -49,6 +50,11 @@ func (e *AppError) Error() string {
 	return e.message
 }
 
+// Code returns the error code, or "" for errors created without one
+func (e *AppError) Code() Code {
+	return e.code
+}
+
 // NewAppError creates a new application error with a custom message
 func NewAppError(message string) *AppError {
 	return &AppError{message: message}
@@ -57,7 +63,7 @@ func NewAppError(message string) *AppError {
 // WrapError wraps an existing error with additional context
 func WrapError(err error, context string) error {
 	if appErr, ok := err.(*AppError); ok {
-		return &AppError{message: context + ": " + appErr.message}
+		return &AppError{code: appErr.code, message: context + ": " + appErr.message}
 	}
 	return &AppError{message: context + ": " + err.Error()}
 }
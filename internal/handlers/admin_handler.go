@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -111,7 +112,7 @@ func (h *AdminHandler) GetUserDetail(c *gin.Context) {
 	user, err := h.adminUseCase.GetUserDetail(c.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "get user detail", err)
@@ -147,7 +148,7 @@ func (h *AdminHandler) ToggleAdmin(c *gin.Context) {
 	user, err := h.adminUseCase.ToggleAdmin(c.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "toggle admin", err)
@@ -272,5 +273,5 @@ func (h *AdminHandler) abortWithInternalServerError(c *gin.Context, operation st
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AIGenerationHandler handles HTTP requests for feedback on AI generation alternatives
+type AIGenerationHandler struct {
+	aiGenerationUseCase usecases.AIGenerationUseCase
+	logger              *zap.Logger
+}
+
+// NewAIGenerationHandler creates a new instance of AIGenerationHandler
+func NewAIGenerationHandler(aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *AIGenerationHandler {
+	return &AIGenerationHandler{
+		aiGenerationUseCase: aiGenerationUseCase,
+		logger:              logger,
+	}
+}
+
+// SubmitFeedback godoc
+// @Summary      Send feedback on AI alternatives
+// @Description  Marks the alternative the user kept and the ones they rejected. Sending feedback again replaces it.
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        generation_id  path      string                           true  "Generation ID returned by the generator"
+// @Param        body           body      dto.AIGenerationFeedbackRequest  true  "Chosen and rejected alternatives"
+// @Success      200            {object}  dto.AIGenerationFeedbackResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Generation not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/ai-generations/{generation_id}/feedback [post]
+// @Security     BearerAuth
+func (h *AIGenerationHandler) SubmitFeedback(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	generationID, err := uuid.Parse(c.Param("generation_id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid generation ID format"))
+		return
+	}
+
+	var req dto.AIGenerationFeedbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.aiGenerationUseCase.SubmitFeedback(c.Request.Context(), userID, generationID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeGenerationNotFound)
+		case apperrors.CodeOf(err) != "":
+			transporthttp.HandleCodeError(c, apperrors.CodeOf(err), "")
+		default:
+			h.abortWithInternalServerError(c, "submit AI generation feedback", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetStats godoc
+// @Summary      Get AI generation feedback statistics
+// @Description  Returns, per generator, how many generations received feedback and which alternative positions users keep. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Success      200  {object}  dto.AIGenerationStatsResponse
+// @Failure      401  {object}  dto.ErrorResponse  "X-User-ID header required"
+// @Failure      403  {object}  dto.ErrorResponse  "Admin access required"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/ai-generations/stats [get]
+// @Security     BearerAuth
+// @Security     UserIDHeader
+func (h *AIGenerationHandler) GetStats(c *gin.Context) {
+	stats, err := h.aiGenerationUseCase.GetStats(c.Request.Context())
+	if err != nil {
+		h.abortWithInternalServerError(c, "get AI generation stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+func (h *AIGenerationHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *AIGenerationHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("AI generation handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
+
+// recordAIGeneration stores the alternatives of a generation so the user can send feedback on them
+// and fills in the generation ID. A failure is logged and the response is returned without an ID.
+func recordAIGeneration(c *gin.Context, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger, feature models.AIGenerationFeature, input string, alternatives *dto.AIAlternatives) {
+	if aiGenerationUseCase == nil {
+		return
+	}
+	userID, ok := c.Get("user_id")
+	if !ok {
+		return
+	}
+	id, ok := userID.(uuid.UUID)
+	if !ok {
+		return
+	}
+
+	generation, err := aiGenerationUseCase.Record(c.Request.Context(), id, feature, input, alternatives.Alternatives)
+	if err != nil {
+		if logger != nil {
+			logger.Warn("Failed to record AI generation", zap.String("feature", string(feature)), zap.Error(err))
+		}
+		return
+	}
+	alternatives.GenerationID = &generation.ID
+}
@@ -0,0 +1,356 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ApplicationHandler handles HTTP requests for the job application tracker
+type ApplicationHandler struct {
+	applicationUseCase usecases.ApplicationUseCase
+	logger             *zap.Logger
+}
+
+// NewApplicationHandler creates a new instance of ApplicationHandler
+func NewApplicationHandler(applicationUseCase usecases.ApplicationUseCase, logger *zap.Logger) *ApplicationHandler {
+	return &ApplicationHandler{
+		applicationUseCase: applicationUseCase,
+		logger:             logger,
+	}
+}
+
+// CreateApplication godoc
+// @Summary      Create job application
+// @Description  Tracks a new job application. Status defaults to applied; the initial status is recorded in the history
+// @Tags         applications
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.ApplicationRequest  true  "Job application"
+// @Success      201   {object}  dto.ApplicationResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications [post]
+// @Security     BearerAuth
+func (h *ApplicationHandler) CreateApplication(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.applicationUseCase.CreateApplication(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "create application", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListApplications godoc
+// @Summary      List job applications
+// @Description  Returns the authenticated user's job applications, most recently updated first
+// @Tags         applications
+// @Produce      json
+// @Param        status  query     string  false  "Filter by status"  Enums(wishlist, applied, screening, interviewing, offer, accepted, rejected, withdrawn)
+// @Success      200     {array}   dto.ApplicationResponse
+// @Failure      400     {object}  dto.ErrorResponseValidation  "Invalid status"
+// @Failure      500     {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications [get]
+// @Security     BearerAuth
+func (h *ApplicationHandler) ListApplications(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.applicationUseCase.ListApplications(c.Request.Context(), userID, c.Query("status"))
+	if err != nil {
+		h.handleUseCaseError(c, "list applications", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApplicationStats godoc
+// @Summary      Job application stats
+// @Description  Returns counts per status and response, interview and offer rates over the applications sent
+// @Tags         applications
+// @Produce      json
+// @Success      200  {object}  dto.ApplicationStatsResponse
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/stats [get]
+// @Security     BearerAuth
+func (h *ApplicationHandler) GetApplicationStats(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.applicationUseCase.GetStats(c.Request.Context(), userID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "get application stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListFollowUpsDue godoc
+// @Summary      List due follow-ups
+// @Description  Returns the open applications whose follow-up date has passed
+// @Tags         applications
+// @Produce      json
+// @Success      200  {array}   dto.ApplicationResponse
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/follow-ups [get]
+// @Security     BearerAuth
+func (h *ApplicationHandler) ListFollowUpsDue(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.applicationUseCase.ListFollowUpsDue(c.Request.Context(), userID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list follow-ups due", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetApplication godoc
+// @Summary      Get job application
+// @Description  Returns a job application with its contacts
+// @Tags         applications
+// @Produce      json
+// @Param        application_id  path      string  true  "Application ID"
+// @Success      200             {object}  dto.ApplicationResponse
+// @Failure      400             {object}  dto.ErrorResponseValidation  "Invalid application ID format"
+// @Failure      404             {object}  dto.ErrorResponse  "Application not found"
+// @Failure      500             {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/{application_id} [get]
+// @Security     BearerAuth
+func (h *ApplicationHandler) GetApplication(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := h.parseApplicationID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.applicationUseCase.GetApplication(c.Request.Context(), userID, applicationID)
+	if err != nil {
+		h.handleUseCaseError(c, "get application", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateApplication godoc
+// @Summary      Update job application
+// @Description  Updates a job application and replaces its contacts. The status is changed through PATCH /api/v1/applications/{application_id}/status
+// @Tags         applications
+// @Accept       json
+// @Produce      json
+// @Param        application_id  path      string                  true  "Application ID"
+// @Param        body            body      dto.ApplicationRequest  true  "Job application"
+// @Success      200             {object}  dto.ApplicationResponse
+// @Failure      400             {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404             {object}  dto.ErrorResponse  "Application not found"
+// @Failure      500             {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/{application_id} [put]
+// @Security     BearerAuth
+func (h *ApplicationHandler) UpdateApplication(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := h.parseApplicationID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.applicationUseCase.UpdateApplication(c.Request.Context(), userID, applicationID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update application", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteApplication godoc
+// @Summary      Delete job application
+// @Description  Deletes a job application with its contacts and status history
+// @Tags         applications
+// @Param        application_id  path  string  true  "Application ID"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid application ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Application not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/{application_id} [delete]
+// @Security     BearerAuth
+func (h *ApplicationHandler) DeleteApplication(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := h.parseApplicationID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.applicationUseCase.DeleteApplication(c.Request.Context(), userID, applicationID); err != nil {
+		h.handleUseCaseError(c, "delete application", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateApplicationStatus godoc
+// @Summary      Change job application status
+// @Description  Moves the application through the pipeline and records the transition. Closing it (accepted, rejected, withdrawn) cancels the pending follow-up unless a new one is given
+// @Tags         applications
+// @Accept       json
+// @Produce      json
+// @Param        application_id  path      string                              true  "Application ID"
+// @Param        body            body      dto.UpdateApplicationStatusRequest  true  "New status"
+// @Success      200             {object}  dto.ApplicationResponse
+// @Failure      400             {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404             {object}  dto.ErrorResponse  "Application not found"
+// @Failure      409             {object}  dto.ErrorResponse  "Application already has this status"
+// @Failure      500             {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/{application_id}/status [patch]
+// @Security     BearerAuth
+func (h *ApplicationHandler) UpdateApplicationStatus(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := h.parseApplicationID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateApplicationStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.applicationUseCase.UpdateStatus(c.Request.Context(), userID, applicationID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update application status", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListApplicationHistory godoc
+// @Summary      Job application status history
+// @Description  Returns the status transitions of a job application, oldest first
+// @Tags         applications
+// @Produce      json
+// @Param        application_id  path      string  true  "Application ID"
+// @Success      200             {array}   dto.ApplicationStatusChangeResponse
+// @Failure      400             {object}  dto.ErrorResponseValidation  "Invalid application ID format"
+// @Failure      404             {object}  dto.ErrorResponse  "Application not found"
+// @Failure      500             {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/applications/{application_id}/history [get]
+// @Security     BearerAuth
+func (h *ApplicationHandler) ListApplicationHistory(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := h.parseApplicationID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.applicationUseCase.ListStatusHistory(c.Request.Context(), userID, applicationID)
+	if err != nil {
+		h.handleUseCaseError(c, "list application history", apperrors.CodeApplicationNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleUseCaseError maps not-found errors to 404, tracker rule violations to their code
+// and anything else to 500.
+func (h *ApplicationHandler) handleUseCaseError(c *gin.Context, operation string, notFoundCode apperrors.Code, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, notFoundCode)
+	case apperrors.CodeOf(err) != "":
+		transporthttp.HandleCodeError(c, apperrors.CodeOf(err), "")
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *ApplicationHandler) parseApplicationID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("application_id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid application ID format"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *ApplicationHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *ApplicationHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Application handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
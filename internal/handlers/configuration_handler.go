@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -49,7 +51,7 @@ func (h *ConfigurationHandler) GetConfigurationByUserID(c *gin.Context) {
 	configuration, err := h.configurationUseCase.GetConfigurationByUserID(c.Request.Context(), userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "configuration not found for this user")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeConfigurationNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "get configuration by user id", err)
@@ -61,7 +63,7 @@ func (h *ConfigurationHandler) GetConfigurationByUserID(c *gin.Context) {
 
 // UpdateConfiguration godoc
 // @Summary      Update configuration by user ID
-// @Description  Updates the configuration for the given user ID
+// @Description  Updates the configuration for the given user ID. Setting newsletter to true sends a confirmation email: the subscription stays pending until the link is opened (double opt-in)
 // @Tags         configuration
 // @Accept       json
 // @Produce      json
@@ -71,6 +73,7 @@ func (h *ConfigurationHandler) GetConfigurationByUserID(c *gin.Context) {
 // @Failure      400      {object}  dto.ErrorResponseValidation  "Invalid user ID format or validation error"
 // @Failure      404      {object}  dto.ErrorResponse  "Configuration not found"
 // @Failure      500      {object}  dto.ErrorResponseServer  "Internal server error"
+// @Failure      503      {object}  dto.ErrorResponse  "Newsletter is not configured"
 // @Router       /api/v1/configuration/{user_id} [patch]
 // @Security     BearerAuth
 func (h *ConfigurationHandler) UpdateConfiguration(c *gin.Context) {
@@ -90,7 +93,11 @@ func (h *ConfigurationHandler) UpdateConfiguration(c *gin.Context) {
 	configuration, err := h.configurationUseCase.UpdateConfiguration(c.Request.Context(), userID, &req)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "configuration not found for this user")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeConfigurationNotFound)
+			return
+		}
+		if errors.Is(err, usecases.ErrNewsletterUnavailable) {
+			transporthttp.HandleCodeError(c, apperrors.CodeNewsletterUnavailable, "")
 			return
 		}
 		h.abortWithInternalServerError(c, "update configuration", err)
@@ -123,7 +130,7 @@ func (h *ConfigurationHandler) DeleteConfiguration(c *gin.Context) {
 
 	if err := h.configurationUseCase.DeleteConfiguration(c.Request.Context(), userID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "configuration not found for this user")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeConfigurationNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "delete configuration", err)
@@ -133,6 +140,28 @@ func (h *ConfigurationHandler) DeleteConfiguration(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Configuration deleted successfully"})
 }
 
+// ListLocales godoc
+// @Summary      List supported languages
+// @Description  Returns the languages accepted by the language fields of the API, with their BCP-47 tag and display names
+// @Tags         configuration
+// @Produce      json
+// @Success      200  {array}  dto.LocaleResponse
+// @Router       /api/v1/locales [get]
+func (h *ConfigurationHandler) ListLocales(c *gin.Context) {
+	locales := locale.All()
+	resp := make([]dto.LocaleResponse, 0, len(locales))
+	for _, l := range locales {
+		resp = append(resp, dto.LocaleResponse{
+			Tag:        l.Tag,
+			Code:       l.Code,
+			Name:       l.Name,
+			NativeName: l.NativeName,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
 func (h *ConfigurationHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Configuration handler failed",
@@ -141,5 +170,5 @@ func (h *ConfigurationHandler) abortWithInternalServerError(c *gin.Context, oper
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ConsultantHandler handles HTTP requests for the consultant workspace and client reviews
+type ConsultantHandler struct {
+	consultantUseCase usecases.ConsultantUseCase
+	logger            *zap.Logger
+}
+
+// NewConsultantHandler creates a new instance of ConsultantHandler
+func NewConsultantHandler(consultantUseCase usecases.ConsultantUseCase, logger *zap.Logger) *ConsultantHandler {
+	return &ConsultantHandler{
+		consultantUseCase: consultantUseCase,
+		logger:            logger,
+	}
+}
+
+// CreateClient godoc
+// @Summary      Create client profile
+// @Description  Creates a client profile managed by the authenticated consultant. Curriculums are created for it with client_id on POST /api/v1/curriculums
+// @Tags         consultant
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.ClientProfileRequest  true  "Client profile"
+// @Success      201   {object}  dto.ClientProfileResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/clients [post]
+// @Security     BearerAuth
+func (h *ConsultantHandler) CreateClient(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ClientProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.consultantUseCase.CreateClient(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.abortWithInternalServerError(c, "create client profile", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListClients godoc
+// @Summary      List client profiles
+// @Description  Returns the client profiles managed by the authenticated consultant
+// @Tags         consultant
+// @Produce      json
+// @Success      200  {array}   dto.ClientProfileResponse
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/clients [get]
+// @Security     BearerAuth
+func (h *ConsultantHandler) ListClients(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.consultantUseCase.ListClients(c.Request.Context(), userID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list client profiles", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetClient godoc
+// @Summary      Get client profile
+// @Description  Returns a client profile with the curriculums managed for it and their review status
+// @Tags         consultant
+// @Produce      json
+// @Param        client_id  path      string  true  "Client profile ID"
+// @Success      200        {object}  dto.ClientProfileResponse
+// @Failure      400        {object}  dto.ErrorResponseValidation  "Invalid client ID format"
+// @Failure      404        {object}  dto.ErrorResponse  "Client profile not found"
+// @Failure      500        {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/clients/{client_id} [get]
+// @Security     BearerAuth
+func (h *ConsultantHandler) GetClient(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	clientID, ok := h.parseUUIDParam(c, "client_id", "invalid client ID format")
+	if !ok {
+		return
+	}
+
+	resp, err := h.consultantUseCase.GetClient(c.Request.Context(), userID, clientID)
+	if err != nil {
+		h.handleUseCaseError(c, "get client profile", apperrors.CodeClientProfileNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateClient godoc
+// @Summary      Update client profile
+// @Description  Updates a client profile's name, email and notes. The email decides who can review shared curriculums
+// @Tags         consultant
+// @Accept       json
+// @Produce      json
+// @Param        client_id  path      string                    true  "Client profile ID"
+// @Param        body       body      dto.ClientProfileRequest  true  "Client profile"
+// @Success      200        {object}  dto.ClientProfileResponse
+// @Failure      400        {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404        {object}  dto.ErrorResponse  "Client profile not found"
+// @Failure      500        {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/clients/{client_id} [put]
+// @Security     BearerAuth
+func (h *ConsultantHandler) UpdateClient(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	clientID, ok := h.parseUUIDParam(c, "client_id", "invalid client ID format")
+	if !ok {
+		return
+	}
+
+	var req dto.ClientProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.consultantUseCase.UpdateClient(c.Request.Context(), userID, clientID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update client profile", apperrors.CodeClientProfileNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteClient godoc
+// @Summary      Delete client profile
+// @Description  Deletes a client profile that has no curriculums left
+// @Tags         consultant
+// @Produce      json
+// @Param        client_id  path      string  true  "Client profile ID"
+// @Success      200        {object}  dto.MessageResponse
+// @Failure      400        {object}  dto.ErrorResponseValidation  "Invalid client ID"
+// @Failure      404        {object}  dto.ErrorResponse  "Client profile not found"
+// @Failure      409        {object}  dto.ErrorResponse  "Client still has curriculums"
+// @Failure      500        {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/clients/{client_id} [delete]
+// @Security     BearerAuth
+func (h *ConsultantHandler) DeleteClient(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	clientID, ok := h.parseUUIDParam(c, "client_id", "invalid client ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.consultantUseCase.DeleteClient(c.Request.Context(), userID, clientID); err != nil {
+		h.handleUseCaseError(c, "delete client profile", apperrors.CodeClientProfileNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Client profile deleted successfully"})
+}
+
+// UpdateCurriculumStatus godoc
+// @Summary      Share curriculum for review
+// @Description  Consultant moves a client curriculum to in_review (visible to the client) or back to draft
+// @Tags         consultant
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                             true  "Curriculum ID"
+// @Param        body           body      dto.UpdateCurriculumStatusRequest  true  "Status"
+// @Success      200            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error or invalid status"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      409            {object}  dto.ErrorResponse  "Curriculum already has the status or has no client profile"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/status [patch]
+// @Security     BearerAuth
+func (h *ConsultantHandler) UpdateCurriculumStatus(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCurriculumStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.consultantUseCase.UpdateCurriculumStatus(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update curriculum status", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListReviews godoc
+// @Summary      List curriculums shared with me
+// @Description  Returns curriculums a consultant shared with the authenticated user's email, in review or approved
+// @Tags         consultant
+// @Produce      json
+// @Success      200  {array}   dto.CurriculumResponse
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/reviews [get]
+// @Security     BearerAuth
+func (h *ConsultantHandler) ListReviews(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.consultantUseCase.ListReviews(c.Request.Context(), userID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list reviews", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ApproveCurriculum godoc
+// @Summary      Approve shared curriculum
+// @Description  Client approves a curriculum that is in review
+// @Tags         consultant
+// @Produce      json
+// @Param        curriculum_id  path      string  true  "Curriculum ID"
+// @Success      200            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      409            {object}  dto.ErrorResponse  "Curriculum is not awaiting review"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/reviews/{curriculum_id}/approve [post]
+// @Security     BearerAuth
+func (h *ConsultantHandler) ApproveCurriculum(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.consultantUseCase.ApproveCurriculum(c.Request.Context(), userID, curriculumID)
+	if err != nil {
+		h.handleUseCaseError(c, "approve curriculum", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RequestCurriculumChanges godoc
+// @Summary      Request changes to shared curriculum
+// @Description  Client sends a curriculum in review back to the consultant as draft with a comment
+// @Tags         consultant
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                               true  "Curriculum ID"
+// @Param        body           body      dto.RequestCurriculumChangesRequest  true  "Comment"
+// @Success      200            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      409            {object}  dto.ErrorResponse  "Curriculum is not awaiting review"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/reviews/{curriculum_id}/request-changes [post]
+// @Security     BearerAuth
+func (h *ConsultantHandler) RequestCurriculumChanges(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.RequestCurriculumChangesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.consultantUseCase.RequestCurriculumChanges(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "request curriculum changes", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleUseCaseError maps not-found errors to 404, review flow violations to their code
+// and anything else to 500.
+func (h *ConsultantHandler) handleUseCaseError(c *gin.Context, operation string, notFoundCode apperrors.Code, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, notFoundCode)
+	case apperrors.CodeOf(err) != "":
+		transporthttp.HandleCodeError(c, apperrors.CodeOf(err), "")
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *ConsultantHandler) parseUUIDParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New(message))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *ConsultantHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *ConsultantHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Consultant handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CoverLetterHandler handles HTTP requests for stored cover letters
+type CoverLetterHandler struct {
+	coverLetterUseCase usecases.CoverLetterUseCase
+	logger             *zap.Logger
+}
+
+// NewCoverLetterHandler creates a new instance of CoverLetterHandler
+func NewCoverLetterHandler(coverLetterUseCase usecases.CoverLetterUseCase, logger *zap.Logger) *CoverLetterHandler {
+	return &CoverLetterHandler{
+		coverLetterUseCase: coverLetterUseCase,
+		logger:             logger,
+	}
+}
+
+// ListCoverLetters godoc
+// @Summary      List cover letters
+// @Description  Returns the authenticated user's cover letters, newest first
+// @Tags         cover-letters
+// @Produce      json
+// @Param        curriculum_id  query     string  false  "Only letters generated from this curriculum"
+// @Success      200            {array}   dto.CoverLetterResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID format"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/cover-letters [get]
+// @Security     BearerAuth
+func (h *CoverLetterHandler) ListCoverLetters(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var curriculumID *uuid.UUID
+	if raw := c.Query("curriculum_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+			return
+		}
+		curriculumID = &id
+	}
+
+	resp, err := h.coverLetterUseCase.ListCoverLetters(c.Request.Context(), userID, curriculumID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list cover letters", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetCoverLetter godoc
+// @Summary      Get cover letter
+// @Description  Returns a stored cover letter
+// @Tags         cover-letters
+// @Produce      json
+// @Param        cover_letter_id  path      string  true  "Cover letter ID"
+// @Success      200              {object}  dto.CoverLetterResponse
+// @Failure      400              {object}  dto.ErrorResponseValidation  "Invalid cover letter ID format"
+// @Failure      404              {object}  dto.ErrorResponse  "Cover letter not found"
+// @Failure      500              {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/cover-letters/{cover_letter_id} [get]
+// @Security     BearerAuth
+func (h *CoverLetterHandler) GetCoverLetter(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	coverLetterID, ok := h.parseCoverLetterID(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.coverLetterUseCase.GetCoverLetter(c.Request.Context(), userID, coverLetterID)
+	if err != nil {
+		h.handleUseCaseError(c, "get cover letter", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateCoverLetter godoc
+// @Summary      Update cover letter
+// @Description  Saves the user's edits to a cover letter's title, company, position and content
+// @Tags         cover-letters
+// @Accept       json
+// @Produce      json
+// @Param        cover_letter_id  path      string                        true  "Cover letter ID"
+// @Param        body             body      dto.UpdateCoverLetterRequest  true  "Edited cover letter"
+// @Success      200              {object}  dto.CoverLetterResponse
+// @Failure      400              {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404              {object}  dto.ErrorResponse  "Cover letter not found"
+// @Failure      500              {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/cover-letters/{cover_letter_id} [put]
+// @Security     BearerAuth
+func (h *CoverLetterHandler) UpdateCoverLetter(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	coverLetterID, ok := h.parseCoverLetterID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCoverLetterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.coverLetterUseCase.UpdateCoverLetter(c.Request.Context(), userID, coverLetterID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update cover letter", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteCoverLetter godoc
+// @Summary      Delete cover letter
+// @Description  Deletes a stored cover letter
+// @Tags         cover-letters
+// @Param        cover_letter_id  path  string  true  "Cover letter ID"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid cover letter ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Cover letter not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/cover-letters/{cover_letter_id} [delete]
+// @Security     BearerAuth
+func (h *CoverLetterHandler) DeleteCoverLetter(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	coverLetterID, ok := h.parseCoverLetterID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.coverLetterUseCase.DeleteCoverLetter(c.Request.Context(), userID, coverLetterID); err != nil {
+		h.handleUseCaseError(c, "delete cover letter", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ExportCoverLetter godoc
+// @Summary      Export cover letter
+// @Description  Downloads the cover letter as plain text or as a printable HTML page
+// @Tags         cover-letters
+// @Produce      plain
+// @Produce      html
+// @Param        cover_letter_id  path      string  true   "Cover letter ID"
+// @Param        format           query     string  false  "Export format"  Enums(txt, html)  default(txt)
+// @Success      200              {string}  string  "Cover letter file"
+// @Failure      400              {object}  dto.ErrorResponseValidation  "Invalid format"
+// @Failure      404              {object}  dto.ErrorResponse  "Cover letter not found"
+// @Failure      500              {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/cover-letters/{cover_letter_id}/export [get]
+// @Security     BearerAuth
+func (h *CoverLetterHandler) ExportCoverLetter(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	coverLetterID, ok := h.parseCoverLetterID(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.coverLetterUseCase.ExportCoverLetter(c.Request.Context(), userID, coverLetterID, c.Query("format"))
+	if err != nil {
+		h.handleUseCaseError(c, "export cover letter", err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	c.Data(http.StatusOK, export.ContentType, export.Body)
+}
+
+// handleUseCaseError maps not-found errors to 404, invalid content or formats to their code
+// and anything else to 500.
+func (h *CoverLetterHandler) handleUseCaseError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, apperrors.CodeCoverLetterNotFound)
+	case apperrors.CodeOf(err) != "":
+		transporthttp.HandleCodeError(c, apperrors.CodeOf(err), "")
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *CoverLetterHandler) parseCoverLetterID(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("cover_letter_id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid cover letter ID format"))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *CoverLetterHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *CoverLetterHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Cover letter handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/validation"
@@ -33,14 +35,14 @@ func NewCurriculumHandler(curriculumUseCase usecases.CurriculumUseCase, userUseC
 
 // CreateCurriculum godoc
 // @Summary      Create curriculum
-// @Description  Creates a new curriculum for a user
+// @Description  Creates a new curriculum for a user. Consultants can pass client_id to create it on behalf of one of their client profiles
 // @Tags         curriculum
 // @Accept       json
 // @Produce      json
 // @Param        body  body      dto.CreateCurriculumRequest  true  "Create payload"
 // @Success      201   {object}  dto.CurriculumResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error or invalid user ID"
-// @Failure      404   {object}  dto.ErrorResponse  "User not found"
+// @Failure      404   {object}  dto.ErrorResponse  "User or client profile not found"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/curriculums [post]
 // @Security     BearerAuth
@@ -53,15 +55,7 @@ func (h *CurriculumHandler) CreateCurriculum(c *gin.Context) {
 
 	// Validate phone number using our custom validation
 	if !validation.IsValidPhone(req.Phone) {
-		c.JSON(http.StatusBadRequest, transporthttp.ValidationErrorResponse{
-			Message: "Invalid input data",
-			Errors: []transporthttp.ValidationError{
-				{
-					Field:   "phone",
-					Message: "Invalid phone number",
-				},
-			},
-		})
+		transporthttp.HandleFieldError(c, "phone", "Invalid phone number")
 		return
 	}
 
@@ -76,7 +70,7 @@ func (h *CurriculumHandler) CreateCurriculum(c *gin.Context) {
 	_, err = h.userUseCase.GetUserByID(c.Request.Context(), userUUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "verify user for curriculum creation", err)
@@ -90,6 +84,10 @@ func (h *CurriculumHandler) CreateCurriculum(c *gin.Context) {
 			transporthttp.HandleValidationError(c, err)
 			return
 		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeClientProfileNotFound)
+			return
+		}
 		h.abortWithInternalServerError(c, "create curriculum", err)
 		return
 	}
@@ -114,14 +112,14 @@ func (h *CurriculumHandler) GetCurriculumByID(c *gin.Context) {
 	idStr := c.Param("curriculum_id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		transporthttp.HandleValidationError(c, errors.New("invalid curriculum ID format"))
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
 		return
 	}
 
 	curriculum, err := h.curriculumUseCase.GetCurriculumByID(c.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "curriculum not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "get curriculum by id", err)
@@ -159,7 +157,7 @@ func (h *CurriculumHandler) GetAllCurriculums(c *gin.Context) {
 	_, err = h.userUseCase.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "verify user for curriculum list", err)
@@ -208,7 +206,7 @@ func (h *CurriculumHandler) GetCurriculumCountByUserID(c *gin.Context) {
 	_, err = h.userUseCase.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "verify user for curriculum count", err)
@@ -248,7 +246,7 @@ func (h *CurriculumHandler) GetCreationCountByUserID(c *gin.Context) {
 	_, err = h.userUseCase.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "verify user for creation count", err)
@@ -281,14 +279,14 @@ func (h *CurriculumHandler) GetCurriculumBody(c *gin.Context) {
 	curriculumIDStr := c.Param("curriculum_id")
 	curriculumID, err := uuid.Parse(curriculumIDStr)
 	if err != nil {
-		transporthttp.HandleValidationError(c, errors.New("invalid curriculum ID format"))
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
 		return
 	}
 
 	curriculumBody, err := h.curriculumUseCase.GetCurriculumBody(c.Request.Context(), curriculumID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "curriculum not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "get curriculum body", err)
@@ -315,13 +313,13 @@ func (h *CurriculumHandler) DeleteCurriculum(c *gin.Context) {
 	idStr := c.Param("curriculum_id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		transporthttp.HandleValidationError(c, errors.New("invalid curriculum ID format"))
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
 		return
 	}
 
 	if err := h.curriculumUseCase.DeleteCurriculum(c.Request.Context(), id); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "curriculum not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "delete curriculum", err)
@@ -331,6 +329,96 @@ func (h *CurriculumHandler) DeleteCurriculum(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Curriculum deleted successfully"})
 }
 
+// DuplicateCurriculum godoc
+// @Summary      Duplicate curriculum
+// @Description  Copies one of the caller's curriculums, including works and educations, into a new draft linked to its source
+// @Tags         curriculum
+// @Produce      json
+// @Param        curriculum_id  path      string  true  "Curriculum ID"
+// @Success      201            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID format"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/duplicate [post]
+// @Security     BearerAuth
+func (h *CurriculumHandler) DuplicateCurriculum(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	curriculum, err := h.curriculumUseCase.DuplicateCurriculum(c.Request.Context(), userID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "duplicate curriculum", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, curriculum)
+}
+
+// CheckATS godoc
+// @Summary      Check curriculum for ATS problems
+// @Description  Runs deterministic ATS rules on one of the caller's curriculums (contact fields, phone and email validity, summary length, work dates and gaps, section names) and returns rule-level findings with severities. Messages follow the request language; rule is a stable key. Does not use AI quota
+// @Tags         curriculum
+// @Produce      json
+// @Param        curriculum_id  path      string  true  "Curriculum ID"
+// @Success      200            {object}  dto.ATSCheckResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID format"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/ats-check [get]
+// @Security     BearerAuth
+func (h *CurriculumHandler) CheckATS(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	report, err := h.curriculumUseCase.CheckATS(c.Request.Context(), userID, id, i18n.FromContext(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "check ats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func (h *CurriculumHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
 func (h *CurriculumHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Curriculum handler failed",
@@ -339,5 +427,16 @@ func (h *CurriculumHandler) abortWithInternalServerError(c *gin.Context, operati
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
+
+// parseCurriculumIDParam parses the curriculum_id path parameter, reporting an invalid one
+// as INVALID_CURRICULUM_ID.
+func parseCurriculumIDParam(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return uuid.Nil, false
+	}
+	return id, true
 }
@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// sharePasswordHeader carries the password of a protected share link.
+const sharePasswordHeader = "X-Share-Password"
+
+// CurriculumShareHandler handles HTTP requests for curriculum share links and the public link page
+type CurriculumShareHandler struct {
+	shareUseCase  usecases.CurriculumShareUseCase
+	countryHeader string
+	logger        *zap.Logger
+}
+
+// NewCurriculumShareHandler creates a new instance of CurriculumShareHandler.
+// countryHeader is the trusted geo-IP header of the CDN; empty records views without country.
+func NewCurriculumShareHandler(shareUseCase usecases.CurriculumShareUseCase, countryHeader string, logger *zap.Logger) *CurriculumShareHandler {
+	return &CurriculumShareHandler{
+		shareUseCase:  shareUseCase,
+		countryHeader: countryHeader,
+		logger:        logger,
+	}
+}
+
+// CreateShareLink godoc
+// @Summary      Create share link
+// @Description  Creates a public link (/p/{slug}) to one of the caller's curriculums, optionally password protected and expiring
+// @Tags         curriculum-share
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                      true  "Curriculum ID"
+// @Param        body           body      dto.CreateShareLinkRequest  true  "Share link options"
+// @Success      201            {object}  dto.ShareLinkResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/share-links [post]
+// @Security     BearerAuth
+func (h *CurriculumShareHandler) CreateShareLink(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateShareLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.shareUseCase.CreateShareLink(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "create share link", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListShareLinks godoc
+// @Summary      List share links
+// @Description  Lists the share links of one of the caller's curriculums, including revoked and expired ones
+// @Tags         curriculum-share
+// @Produce      json
+// @Param        curriculum_id  path      string  true  "Curriculum ID"
+// @Success      200            {array}   dto.ShareLinkResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID format"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/share-links [get]
+// @Security     BearerAuth
+func (h *CurriculumShareHandler) ListShareLinks(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.shareUseCase.ListShareLinks(c.Request.Context(), userID, curriculumID)
+	if err != nil {
+		h.handleUseCaseError(c, "list share links", apperrors.CodeCurriculumNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RevokeShareLink godoc
+// @Summary      Revoke share link
+// @Description  Revokes a share link; opening it afterwards returns 410
+// @Tags         curriculum-share
+// @Produce      json
+// @Param        curriculum_id  path      string  true  "Curriculum ID"
+// @Param        link_id        path      string  true  "Share link ID"
+// @Success      200            {object}  dto.MessageResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid ID format"
+// @Failure      404            {object}  dto.ErrorResponse  "Share link not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/share-links/{link_id} [delete]
+// @Security     BearerAuth
+func (h *CurriculumShareHandler) RevokeShareLink(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+	linkID, ok := h.parseUUIDParam(c, "link_id", "invalid share link ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.shareUseCase.RevokeShareLink(c.Request.Context(), userID, curriculumID, linkID); err != nil {
+		h.handleUseCaseError(c, "revoke share link", apperrors.CodeShareLinkNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Share link revoked successfully"})
+}
+
+// GetShareLinkViews godoc
+// @Summary      Get share link views
+// @Description  Returns view totals by country and referrer and the most recent view events of a share link
+// @Tags         curriculum-share
+// @Produce      json
+// @Param        curriculum_id  path      string  true   "Curriculum ID"
+// @Param        link_id        path      string  true   "Share link ID"
+// @Param        limit          query     int     false  "Number of recent views (default 50, max 200)"
+// @Success      200            {object}  dto.ShareLinkViewsResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid ID format"
+// @Failure      404            {object}  dto.ErrorResponse  "Share link not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/share-links/{link_id}/views [get]
+// @Security     BearerAuth
+func (h *CurriculumShareHandler) GetShareLinkViews(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	curriculumID, ok := parseCurriculumIDParam(c)
+	if !ok {
+		return
+	}
+	linkID, ok := h.parseUUIDParam(c, "link_id", "invalid share link ID format")
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil {
+			transporthttp.HandleValidationError(c, errors.New("invalid limit"))
+			return
+		}
+		limit = parsed
+	}
+
+	resp, err := h.shareUseCase.GetShareLinkViews(c.Request.Context(), userID, curriculumID, linkID, limit)
+	if err != nil {
+		h.handleUseCaseError(c, "get share link views", apperrors.CodeShareLinkNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ViewSharedCurriculum godoc
+// @Summary      Open public curriculum link
+// @Description  Public, unauthenticated. Returns the rendered curriculum behind a share link and records the view. Protected links need the X-Share-Password header. Use format=text for the plain text version
+// @Tags         curriculum-share
+// @Produce      json
+// @Produce      plain
+// @Param        slug              path      string  true   "Share link slug"
+// @Param        X-Share-Password  header    string  false  "Password of a protected link"
+// @Param        format            query     string  false  "json (default) or text"
+// @Success      200               {object}  dto.PublicCurriculumResponse
+// @Failure      401               {object}  dto.ErrorResponse  "Password required or invalid"
+// @Failure      404               {object}  dto.ErrorResponse  "Link not found"
+// @Failure      410               {object}  dto.ErrorResponse  "Link revoked or expired"
+// @Failure      500               {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /p/{slug} [get]
+func (h *CurriculumShareHandler) ViewSharedCurriculum(c *gin.Context) {
+	viewer := usecases.ShareViewer{
+		Password: c.GetHeader(sharePasswordHeader),
+		Referrer: c.Request.Referer(),
+		Country:  h.viewerCountry(c),
+	}
+
+	resp, err := h.shareUseCase.ViewSharedCurriculum(c.Request.Context(), c.Param("slug"), viewer)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			transporthttp.HandleCodeError(c, apperrors.CodeShareLinkNotFound, "")
+		case errors.Is(err, usecases.ErrShareLinkUnavailable):
+			transporthttp.HandleCodeError(c, apperrors.CodeShareLinkUnavailable, "")
+		case errors.Is(err, usecases.ErrShareLinkPasswordRequired):
+			transporthttp.HandleCodeError(c, apperrors.CodeShareLinkPasswordRequired, "")
+		case errors.Is(err, usecases.ErrShareLinkInvalidPassword):
+			transporthttp.HandleCodeError(c, apperrors.CodeShareLinkInvalidPassword, "")
+		default:
+			h.abortWithInternalServerError(c, "view shared curriculum", err)
+		}
+		return
+	}
+
+	// Shared pages must not be indexed or cached by intermediaries: links can be revoked.
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+
+	if c.Query("format") == "text" {
+		c.String(http.StatusOK, resp.Content)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// viewerCountry returns the viewer's country code as resolved from the client IP by the
+// CDN in front of the API. Only the configured header is read, since any other can be set by
+// the client; it is empty when no header is configured or the CDN could not resolve the IP.
+func (h *CurriculumShareHandler) viewerCountry(c *gin.Context) string {
+	if h.countryHeader == "" {
+		return ""
+	}
+	country := strings.ToUpper(strings.TrimSpace(c.GetHeader(h.countryHeader)))
+	// Cloudflare uses XX for unknown and T1 for Tor exit nodes.
+	if len(country) != 2 || country == "XX" || country == "T1" {
+		return ""
+	}
+	for _, r := range country {
+		if r < 'A' || r > 'Z' {
+			return ""
+		}
+	}
+	return country
+}
+
+// handleUseCaseError maps not-found errors to 404 and anything else to 500.
+func (h *CurriculumShareHandler) handleUseCaseError(c *gin.Context, operation string, notFoundCode apperrors.Code, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		transporthttp.HandleUseCaseError(c, err, notFoundCode)
+		return
+	}
+	h.abortWithInternalServerError(c, operation, err)
+}
+
+func (h *CurriculumShareHandler) parseUUIDParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New(message))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *CurriculumShareHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *CurriculumShareHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Curriculum share handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
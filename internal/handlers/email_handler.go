@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -12,28 +14,32 @@ import (
 
 // EmailHandler handles HTTP requests for authentication email operations
 type EmailHandler struct {
-	emailUseCase usecases.EmailUseCase
-	logger       *zap.Logger
+	emailUseCase         usecases.EmailUseCase
+	configurationUseCase usecases.ConfigurationUseCase
+	logger               *zap.Logger
 }
 
 // NewEmailHandler creates a new instance of EmailHandler
-func NewEmailHandler(emailUseCase usecases.EmailUseCase, logger *zap.Logger) *EmailHandler {
+func NewEmailHandler(emailUseCase usecases.EmailUseCase, configurationUseCase usecases.ConfigurationUseCase, logger *zap.Logger) *EmailHandler {
 	return &EmailHandler{
-		emailUseCase: emailUseCase,
-		logger:       logger,
+		emailUseCase:         emailUseCase,
+		configurationUseCase: configurationUseCase,
+		logger:               logger,
 	}
 }
 
 // SendEmail godoc
 // @Summary      Send authentication email
-// @Description  Sends an authentication email with session token link
+// @Description  Sends an authentication email with session token link. The email is written in the request language, else the user's configured language, else the Accept-Language header.
 // @Tags         email
 // @Accept       json
 // @Produce      json
 // @Param        body  body      dto.SendEmailRequest   true  "Email payload"
+// @Param        Accept-Language  header  string  false  "Fallback language of the email"
 // @Success      200   {object}  dto.SendEmailResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Failure      503   {object}  dto.ErrorResponseServer  "No email provider configured"
 // @Router       /api/v1/send-email [post]
 // @Security     BearerAuth
 func (h *EmailHandler) SendEmail(c *gin.Context) {
@@ -43,10 +49,16 @@ func (h *EmailHandler) SendEmail(c *gin.Context) {
 		return
 	}
 
+	if h.emailUseCase == nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeEmailUnavailable, "")
+		return
+	}
+
 	h.logger.Info("Processing email request")
 
 	// Send the authentication email
-	err := h.emailUseCase.SendSessionTokenEmail(req.Email, req.Name, req.URLToken)
+	recipient := usecases.EmailRecipient{Email: req.Email, Name: req.Name, Language: h.emailLanguage(c, &req)}
+	err := h.emailUseCase.SendSessionTokenEmail(recipient, req.URLToken)
 	if err != nil {
 		h.abortWithInternalServerError(c, "send session token email", err)
 		return
@@ -62,6 +74,20 @@ func (h *EmailHandler) SendEmail(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// emailLanguage picks the language of the authentication email: the one in the request, then
+// the configured language of the user owning the address, then the request language.
+func (h *EmailHandler) emailLanguage(c *gin.Context, req *dto.SendEmailRequest) string {
+	if req.Language != "" {
+		return req.Language
+	}
+	if h.configurationUseCase != nil {
+		if language, err := h.configurationUseCase.GetLanguageByEmail(c.Request.Context(), req.Email); err == nil {
+			return language
+		}
+	}
+	return i18n.FromContext(c)
+}
+
 func (h *EmailHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Email handler failed",
@@ -70,5 +96,5 @@ func (h *EmailHandler) abortWithInternalServerError(c *gin.Context, operation st
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -13,14 +14,16 @@ import (
 // GenerateAcademicAIHandler handles HTTP requests for AI filtering operations
 type GenerateAcademicAIHandler struct {
 	generateAcademicAIUseCase usecases.GenerateAcademicAIUseCase
-	logger                   *zap.Logger
+	aiGenerationUseCase       usecases.AIGenerationUseCase
+	logger                    *zap.Logger
 }
 
 // NewGenerateAcademicAIHandler creates a new instance of GenerateAcademicAIHandler
-func NewGenerateAcademicAIHandler(generateAcademicAIUseCase usecases.GenerateAcademicAIUseCase, logger *zap.Logger) *GenerateAcademicAIHandler {
+func NewGenerateAcademicAIHandler(generateAcademicAIUseCase usecases.GenerateAcademicAIUseCase, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *GenerateAcademicAIHandler {
 	return &GenerateAcademicAIHandler{
 		generateAcademicAIUseCase: generateAcademicAIUseCase,
-		logger:                   logger,
+		aiGenerationUseCase:       aiGenerationUseCase,
+		logger:                    logger,
 	}
 }
 
@@ -33,6 +36,7 @@ func NewGenerateAcademicAIHandler(generateAcademicAIUseCase usecases.GenerateAca
 // @Param        body  body      dto.GenerateAcademicAIRequest   true  "Content to process"
 // @Success      200   {object}  dto.GenerateAcademicAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "More alternatives than the plan allows"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-academic-ai [post]
 // @Security     BearerAuth
@@ -49,6 +53,36 @@ func (h *GenerateAcademicAIHandler) FilterContent(c *gin.Context) {
 		return
 	}
 
+	recordAIGeneration(c, h.aiGenerationUseCase, h.logger, models.AIGenerationFeatureAcademic, req.Content, &aiResponse.AIAlternatives)
+
+	c.JSON(http.StatusOK, aiResponse)
+}
+
+// GenerateStructured godoc
+// @Summary      Generate typed academic activities with AI
+// @Description  Generates academic activities as typed items with type (subject, project, research, extracurricular, achievement) and description. v1 returns the same list as free text
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.GenerateAcademicAIRequest  true  "Content to process"
+// @Success      200   {object}  dto.GenerateAcademicAIV2Response
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v2/generate-academic-ai [post]
+// @Security     BearerAuth
+func (h *GenerateAcademicAIHandler) GenerateStructured(c *gin.Context) {
+	var req dto.GenerateAcademicAIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	aiResponse, err := h.generateAcademicAIUseCase.GenerateStructured(c.Request.Context(), &req)
+	if err != nil {
+		h.abortWithInternalServerError(c, "generate structured academic content", err)
+		return
+	}
+
 	c.JSON(http.StatusOK, aiResponse)
 }
 
@@ -60,5 +94,5 @@ func (h *GenerateAcademicAIHandler) abortWithInternalServerError(c *gin.Context,
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -1,14 +1,18 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
-	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // GenerateAnalyzeAIHandler handles HTTP requests for AI filtering operations
@@ -27,40 +31,51 @@ func NewGenerateAnalyzeAIHandler(generateAnalyzeAIUseCase usecases.GenerateAnaly
 
 // FilterContent godoc
 // @Summary      Analyze curriculum with AI
-// @Description  Analyzes curriculum content and returns score, improvement points, ATS compatibility, and recommendations
+// @Description  Analyzes curriculum content and returns score, improvement points, ATS compatibility, and recommendations. ats_checks holds the deterministic ATS rule findings
 // @Tags         Generate AI
 // @Accept       json
 // @Produce      json
 // @Param        body  body      dto.GenerateAnalyzeAIRequest   true  "Curriculum content to analyze"
 // @Success      200   {object}  dto.GenerateAnalyzeAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-analyze-ai [post]
 // @Security     BearerAuth
 func (h *GenerateAnalyzeAIHandler) FilterContent(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
 	idParam := c.Param("id")
 	curriculumID, err := uuid.Parse(idParam)
 	if err != nil {
-		transporthttp.HandleValidationError(c, errors.NewAppError("invalid curriculum id"))
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
 		return
 	}
 
 	// Get language parameter from query string (default: "pt")
 	language := c.DefaultQuery("lang", "pt")
 
-	// Validate language code
-	validLanguages := map[string]bool{
-		"pt": true,
-		"en": true,
-		"es": true,
-	}
-	if !validLanguages[language] {
-		transporthttp.HandleValidationError(c, errors.NewAppError("invalid language code. Supported: pt, en, es"))
+	// Validate language code against the locale registry
+	if !locale.IsSupported(language) {
+		transporthttp.HandleCodeErrorf(c, apperrors.CodeInvalidLanguage, "invalid language code. Supported: %s", strings.Join(locale.Codes(), ", "))
 		return
 	}
 
-	aiResponse, err := h.generateAnalyzeAIUseCase.FilterContent(c.Request.Context(), curriculumID, language)
+	aiResponse, err := h.generateAnalyzeAIUseCase.FilterContent(c.Request.Context(), userID, curriculumID, language)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
 		h.abortWithInternalServerError(c, "analyze curriculum", err)
 		return
 	}
@@ -76,5 +91,5 @@ func (h *GenerateAnalyzeAIHandler) abortWithInternalServerError(c *gin.Context,
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
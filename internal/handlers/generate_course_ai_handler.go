@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -13,14 +14,16 @@ import (
 // GenerateCoursesAIHandler handles HTTP requests for AI filtering operations
 type GenerateCoursesAIHandler struct {
 	generateCoursesAIUseCase usecases.GenerateCoursesAIUseCase
-	logger                  *zap.Logger
+	aiGenerationUseCase      usecases.AIGenerationUseCase
+	logger                   *zap.Logger
 }
 
 // NewGenerateCoursesAIHandler creates a new instance of GenerateCoursesAIHandler
-func NewGenerateCoursesAIHandler(generateCoursesAIUseCase usecases.GenerateCoursesAIUseCase, logger *zap.Logger) *GenerateCoursesAIHandler {
+func NewGenerateCoursesAIHandler(generateCoursesAIUseCase usecases.GenerateCoursesAIUseCase, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *GenerateCoursesAIHandler {
 	return &GenerateCoursesAIHandler{
 		generateCoursesAIUseCase: generateCoursesAIUseCase,
-		logger:                  logger,
+		aiGenerationUseCase:      aiGenerationUseCase,
+		logger:                   logger,
 	}
 }
 
@@ -33,6 +36,7 @@ func NewGenerateCoursesAIHandler(generateCoursesAIUseCase usecases.GenerateCours
 // @Param        body  body      dto.GenerateCoursesAIRequest   true  "Content to process"
 // @Success      200   {object}  dto.GenerateCoursesAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "More alternatives than the plan allows"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-courses-ai [post]
 // @Security     BearerAuth
@@ -49,6 +53,36 @@ func (h *GenerateCoursesAIHandler) FilterContent(c *gin.Context) {
 		return
 	}
 
+	recordAIGeneration(c, h.aiGenerationUseCase, h.logger, models.AIGenerationFeatureCourses, req.Content, &aiResponse.AIAlternatives)
+
+	c.JSON(http.StatusOK, aiResponse)
+}
+
+// GenerateStructured godoc
+// @Summary      Generate typed courses with AI
+// @Description  Generates courses and certifications as typed items with type, provider and level. v1 returns the same list as free text
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.GenerateCoursesAIRequest  true  "Content to process"
+// @Success      200   {object}  dto.GenerateCoursesAIV2Response
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v2/generate-courses-ai [post]
+// @Security     BearerAuth
+func (h *GenerateCoursesAIHandler) GenerateStructured(c *gin.Context) {
+	var req dto.GenerateCoursesAIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	aiResponse, err := h.generateCoursesAIUseCase.GenerateStructured(c.Request.Context(), &req)
+	if err != nil {
+		h.abortWithInternalServerError(c, "generate structured courses", err)
+		return
+	}
+
 	c.JSON(http.StatusOK, aiResponse)
 }
 
@@ -60,5 +94,5 @@ func (h *GenerateCoursesAIHandler) abortWithInternalServerError(c *gin.Context,
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
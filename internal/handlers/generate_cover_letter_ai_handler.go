@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GenerateCoverLetterAIHandler handles HTTP requests for generating cover letters
+type GenerateCoverLetterAIHandler struct {
+	generateCoverLetterAIUseCase usecases.GenerateCoverLetterAIUseCase
+	logger                       *zap.Logger
+}
+
+// NewGenerateCoverLetterAIHandler creates a new instance of GenerateCoverLetterAIHandler
+func NewGenerateCoverLetterAIHandler(generateCoverLetterAIUseCase usecases.GenerateCoverLetterAIUseCase, logger *zap.Logger) *GenerateCoverLetterAIHandler {
+	return &GenerateCoverLetterAIHandler{
+		generateCoverLetterAIUseCase: generateCoverLetterAIUseCase,
+		logger:                       logger,
+	}
+}
+
+// GenerateCoverLetter godoc
+// @Summary      Generate cover letter
+// @Description  Uses AI to write a cover letter from the curriculum for the job description, in the requested tone and language. The letter is stored and can be edited and exported through /api/v1/cover-letters
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                          true  "Curriculum ID"
+// @Param        body           body      dto.GenerateCoverLetterRequest  true  "Job description, tone and language"
+// @Success      201            {object}  dto.CoverLetterResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum or application not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/cover-letters [post]
+// @Security     BearerAuth
+func (h *GenerateCoverLetterAIHandler) GenerateCoverLetter(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	curriculumID, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	var req dto.GenerateCoverLetterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	letter, err := h.generateCoverLetterAIUseCase.GenerateCoverLetter(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumOrApplicationNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "generate cover letter", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, letter)
+}
+
+func (h *GenerateCoverLetterAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Generate cover letter AI handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GenerateInterviewPrepAIHandler handles HTTP requests for generating interview practice sets
+type GenerateInterviewPrepAIHandler struct {
+	generateInterviewPrepAIUseCase usecases.GenerateInterviewPrepAIUseCase
+	logger                         *zap.Logger
+}
+
+// NewGenerateInterviewPrepAIHandler creates a new instance of GenerateInterviewPrepAIHandler
+func NewGenerateInterviewPrepAIHandler(generateInterviewPrepAIUseCase usecases.GenerateInterviewPrepAIUseCase, logger *zap.Logger) *GenerateInterviewPrepAIHandler {
+	return &GenerateInterviewPrepAIHandler{
+		generateInterviewPrepAIUseCase: generateInterviewPrepAIUseCase,
+		logger:                         logger,
+	}
+}
+
+// GenerateInterviewPrep godoc
+// @Summary      Generate interview practice set
+// @Description  Uses AI to predict behavioral and technical interview questions for the job description, each with a suggested STAR answer based on one of the curriculum's works. The set is stored under /api/v1/interview-prep
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                            true  "Curriculum ID"
+// @Param        body           body      dto.GenerateInterviewPrepRequest  true  "Job description and options"
+// @Success      201            {object}  dto.InterviewPracticeSetResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error or curriculum without work experience"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum or application not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/interview-prep [post]
+// @Security     BearerAuth
+func (h *GenerateInterviewPrepAIHandler) GenerateInterviewPrep(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	curriculumID, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	var req dto.GenerateInterviewPrepRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	set, err := h.generateInterviewPrepAIUseCase.GenerateInterviewPrep(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumOrApplicationNotFound)
+		case errors.Is(err, usecases.ErrNoWorkExperience):
+			transporthttp.HandleCodeError(c, apperrors.CodeNoWorkExperience, "")
+		default:
+			h.abortWithInternalServerError(c, "generate interview prep", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, set)
+}
+
+func (h *GenerateInterviewPrepAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Generate interview prep AI handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
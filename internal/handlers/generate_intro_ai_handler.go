@@ -1,26 +1,33 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // GenerateIntroAIHandler handles HTTP requests for AI filtering operations
 type GenerateIntroAIHandler struct {
 	generateIntroAIUseCase usecases.GenerateIntroAIUseCase
-	logger               *zap.Logger
+	aiGenerationUseCase    usecases.AIGenerationUseCase
+	logger                 *zap.Logger
 }
 
 // NewGenerateIntroAIHandler creates a new instance of GenerateIntroAIHandler
-func NewGenerateIntroAIHandler(generateIntroAIUseCase usecases.GenerateIntroAIUseCase, logger *zap.Logger) *GenerateIntroAIHandler {
+func NewGenerateIntroAIHandler(generateIntroAIUseCase usecases.GenerateIntroAIUseCase, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *GenerateIntroAIHandler {
 	return &GenerateIntroAIHandler{
 		generateIntroAIUseCase: generateIntroAIUseCase,
-		logger:               logger,
+		aiGenerationUseCase:    aiGenerationUseCase,
+		logger:                 logger,
 	}
 }
 
@@ -33,6 +40,8 @@ func NewGenerateIntroAIHandler(generateIntroAIUseCase usecases.GenerateIntroAIUs
 // @Param        body  body      dto.GenerateIntroAIRequest   true  "Content to process"
 // @Success      200   {object}  dto.GenerateIntroAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "More alternatives than the plan allows"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-intro-ai [post]
 // @Security     BearerAuth
@@ -43,15 +52,50 @@ func (h *GenerateIntroAIHandler) FilterContent(c *gin.Context) {
 		return
 	}
 
-	aiResponse, err := h.generateIntroAIUseCase.FilterContent(c.Request.Context(), &req)
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	aiResponse, err := h.generateIntroAIUseCase.FilterContent(c.Request.Context(), userID, &req)
 	if err != nil {
-		h.abortWithInternalServerError(c, "generate intro content", err)
+		h.handleUseCaseError(c, "generate intro content", err)
 		return
 	}
 
+	recordAIGeneration(c, h.aiGenerationUseCase, h.logger, models.AIGenerationFeatureIntro, req.Content, &aiResponse.AIAlternatives)
+
 	c.JSON(http.StatusOK, aiResponse)
 }
 
+// handleUseCaseError maps an unknown curriculum_id to 404 and a malformed one to 400.
+func (h *GenerateIntroAIHandler) handleUseCaseError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+	case errors.Is(err, usecases.ErrInvalidCurriculumID):
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, err.Error())
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *GenerateIntroAIHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
 func (h *GenerateIntroAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Generate intro AI handler failed",
@@ -60,5 +104,5 @@ func (h *GenerateIntroAIHandler) abortWithInternalServerError(c *gin.Context, op
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
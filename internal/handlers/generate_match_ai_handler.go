@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GenerateMatchAIHandler handles HTTP requests for job description match scoring
+type GenerateMatchAIHandler struct {
+	generateMatchAIUseCase usecases.GenerateMatchAIUseCase
+	logger                 *zap.Logger
+}
+
+// NewGenerateMatchAIHandler creates a new instance of GenerateMatchAIHandler
+func NewGenerateMatchAIHandler(generateMatchAIUseCase usecases.GenerateMatchAIUseCase, logger *zap.Logger) *GenerateMatchAIHandler {
+	return &GenerateMatchAIHandler{
+		generateMatchAIUseCase: generateMatchAIUseCase,
+		logger:                 logger,
+	}
+}
+
+// MatchJobDescription godoc
+// @Summary      Match curriculum against a job description
+// @Description  Returns a keyword match score, matched and missing keywords and a keyword coverage table computed without AI, plus AI-suggested rewrites of work descriptions
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                      true   "Curriculum ID"
+// @Param        lang  query     string                      false  "Language of the suggestions: pt, en, es, fr, de, it, nl" default(pt)
+// @Param        body  body      dto.GenerateMatchAIRequest  true   "Job description"
+// @Success      200   {object}  dto.GenerateMatchAIResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/generate-match-ai/{id} [post]
+// @Security     BearerAuth
+func (h *GenerateMatchAIHandler) MatchJobDescription(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	curriculumID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	language := c.DefaultQuery("lang", "pt")
+	if !locale.IsSupported(language) {
+		transporthttp.HandleCodeErrorf(c, apperrors.CodeInvalidLanguage, "invalid language code. Supported: %s", strings.Join(locale.Codes(), ", "))
+		return
+	}
+
+	var req dto.GenerateMatchAIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.generateMatchAIUseCase.MatchJobDescription(c.Request.Context(), userID, curriculumID, &req, language)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "match job description", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *GenerateMatchAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Generate match AI handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -13,14 +14,16 @@ import (
 // GenerateSkillAIHandler handles HTTP requests for AI skill generation operations
 type GenerateSkillAIHandler struct {
 	generateSkillAIUseCase usecases.GenerateSkillAIUseCase
-	logger               *zap.Logger
+	aiGenerationUseCase    usecases.AIGenerationUseCase
+	logger                 *zap.Logger
 }
 
 // NewGenerateSkillAIHandler creates a new instance of GenerateSkillAIHandler
-func NewGenerateSkillAIHandler(generateSkillAIUseCase usecases.GenerateSkillAIUseCase, logger *zap.Logger) *GenerateSkillAIHandler {
+func NewGenerateSkillAIHandler(generateSkillAIUseCase usecases.GenerateSkillAIUseCase, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *GenerateSkillAIHandler {
 	return &GenerateSkillAIHandler{
 		generateSkillAIUseCase: generateSkillAIUseCase,
-		logger:               logger,
+		aiGenerationUseCase:    aiGenerationUseCase,
+		logger:                 logger,
 	}
 }
 
@@ -33,6 +36,7 @@ func NewGenerateSkillAIHandler(generateSkillAIUseCase usecases.GenerateSkillAIUs
 // @Param        body  body      dto.GenerateSkillAIRequest   true  "Content to process"
 // @Success      200   {object}  dto.GenerateSkillAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "More alternatives than the plan allows"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-skill-ai [post]
 // @Security     BearerAuth
@@ -49,6 +53,36 @@ func (h *GenerateSkillAIHandler) FilterContent(c *gin.Context) {
 		return
 	}
 
+	recordAIGeneration(c, h.aiGenerationUseCase, h.logger, models.AIGenerationFeatureSkill, req.Content, &aiResponse.AIAlternatives)
+
+	c.JSON(http.StatusOK, aiResponse)
+}
+
+// GenerateStructured godoc
+// @Summary      Generate typed skills with AI
+// @Description  Generates up to 10 related skills as typed items with category (hard, soft) and proficiency (beginner, intermediate, advanced, expert). v1 returns the same list as free text
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.GenerateSkillAIRequest  true  "Content to process"
+// @Success      200   {object}  dto.GenerateSkillAIV2Response
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v2/generate-skill-ai [post]
+// @Security     BearerAuth
+func (h *GenerateSkillAIHandler) GenerateStructured(c *gin.Context) {
+	var req dto.GenerateSkillAIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	aiResponse, err := h.generateSkillAIUseCase.GenerateStructured(c.Request.Context(), &req)
+	if err != nil {
+		h.abortWithInternalServerError(c, "generate structured skills", err)
+		return
+	}
+
 	c.JSON(http.StatusOK, aiResponse)
 }
 
@@ -60,5 +94,5 @@ func (h *GenerateSkillAIHandler) abortWithInternalServerError(c *gin.Context, op
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
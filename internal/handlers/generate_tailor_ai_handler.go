@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GenerateTailorAIHandler handles HTTP requests for tailoring curriculums to job postings
+type GenerateTailorAIHandler struct {
+	generateTailorAIUseCase usecases.GenerateTailorAIUseCase
+	logger                  *zap.Logger
+}
+
+// NewGenerateTailorAIHandler creates a new instance of GenerateTailorAIHandler
+func NewGenerateTailorAIHandler(generateTailorAIUseCase usecases.GenerateTailorAIUseCase, logger *zap.Logger) *GenerateTailorAIHandler {
+	return &GenerateTailorAIHandler{
+		generateTailorAIUseCase: generateTailorAIUseCase,
+		logger:                  logger,
+	}
+}
+
+// TailorCurriculum godoc
+// @Summary      Tailor curriculum to a job posting
+// @Description  Uses AI to create a new curriculum from the source with the intro and skills rewritten and the works reordered and reworded for the job description. The new curriculum is linked to its source
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                       true  "Source curriculum ID"
+// @Param        body           body      dto.TailorCurriculumRequest  true  "Job description"
+// @Success      201            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/tailor [post]
+// @Security     BearerAuth
+func (h *GenerateTailorAIHandler) TailorCurriculum(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	curriculumID, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	var req dto.TailorCurriculumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	curriculum, err := h.generateTailorAIUseCase.TailorCurriculum(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "tailor curriculum", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, curriculum)
+}
+
+func (h *GenerateTailorAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Generate tailor AI handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -1,26 +1,33 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // GenerateTaskAIHandler handles HTTP requests for AI filtering operations
 type GenerateTaskAIHandler struct {
 	generateTaskAIUseCase usecases.GenerateTaskAIUseCase
-	logger              *zap.Logger
+	aiGenerationUseCase   usecases.AIGenerationUseCase
+	logger                *zap.Logger
 }
 
 // NewGenerateTaskAIHandler creates a new instance of GenerateTaskAIHandler
-func NewGenerateTaskAIHandler(generateTaskAIUseCase usecases.GenerateTaskAIUseCase, logger *zap.Logger) *GenerateTaskAIHandler {
+func NewGenerateTaskAIHandler(generateTaskAIUseCase usecases.GenerateTaskAIUseCase, aiGenerationUseCase usecases.AIGenerationUseCase, logger *zap.Logger) *GenerateTaskAIHandler {
 	return &GenerateTaskAIHandler{
 		generateTaskAIUseCase: generateTaskAIUseCase,
-		logger:              logger,
+		aiGenerationUseCase:   aiGenerationUseCase,
+		logger:                logger,
 	}
 }
 
@@ -33,6 +40,8 @@ func NewGenerateTaskAIHandler(generateTaskAIUseCase usecases.GenerateTaskAIUseCa
 // @Param        body  body      dto.GenerateTaskAIRequest   true  "Content to process"
 // @Success      200   {object}  dto.GenerateTaskAIResponse
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "More alternatives than the plan allows"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
 // @Router       /api/v1/generate-task-ai [post]
 // @Security     BearerAuth
@@ -43,15 +52,84 @@ func (h *GenerateTaskAIHandler) FilterContent(c *gin.Context) {
 		return
 	}
 
-	aiResponse, err := h.generateTaskAIUseCase.FilterContent(c.Request.Context(), &req)
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	aiResponse, err := h.generateTaskAIUseCase.FilterContent(c.Request.Context(), userID, &req)
 	if err != nil {
-		h.abortWithInternalServerError(c, "generate tasks content", err)
+		h.handleUseCaseError(c, "generate tasks content", err)
 		return
 	}
 
+	recordAIGeneration(c, h.aiGenerationUseCase, h.logger, models.AIGenerationFeatureTask, req.Content, &aiResponse.AIAlternatives)
+
 	c.JSON(http.StatusOK, aiResponse)
 }
 
+// GenerateStructured godoc
+// @Summary      Generate typed task bullets with AI
+// @Description  Generates task bullets as typed items with the leading action verb and a suggested metric. v1 returns the same list as free text
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.GenerateTaskAIRequest  true  "Content to process"
+// @Success      200   {object}  dto.GenerateTaskAIV2Response
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404   {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v2/generate-task-ai [post]
+// @Security     BearerAuth
+func (h *GenerateTaskAIHandler) GenerateStructured(c *gin.Context) {
+	var req dto.GenerateTaskAIRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	aiResponse, err := h.generateTaskAIUseCase.GenerateStructured(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "generate structured tasks", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, aiResponse)
+}
+
+// handleUseCaseError maps an unknown curriculum_id to 404 and a malformed one to 400.
+func (h *GenerateTaskAIHandler) handleUseCaseError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+	case errors.Is(err, usecases.ErrInvalidCurriculumID):
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, err.Error())
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *GenerateTaskAIHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
 func (h *GenerateTaskAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Generate task AI handler failed",
@@ -60,5 +138,5 @@ func (h *GenerateTaskAIHandler) abortWithInternalServerError(c *gin.Context, ope
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -1,36 +1,40 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // GenerateTranslationAIHandler handles HTTP requests for AI filtering operations
 type GenerateTranslationAIHandler struct {
 	generateTranslationAIUseCase usecases.GenerateTranslationAIUseCase
-	logger                     *zap.Logger
+	logger                       *zap.Logger
 }
 
 // NewGenerateTranslationAIHandler creates a new instance of GenerateTranslationAIHandler
 func NewGenerateTranslationAIHandler(generateTranslationAIUseCase usecases.GenerateTranslationAIUseCase, logger *zap.Logger) *GenerateTranslationAIHandler {
 	return &GenerateTranslationAIHandler{
 		generateTranslationAIUseCase: generateTranslationAIUseCase,
-		logger:                     logger,
+		logger:                       logger,
 	}
 }
 
 // FilterContent godoc
 // @Summary      Translate curriculum with AI
-// @Description  Translates curriculum content to the target language (pt, en, es)
+// @Description  Translates curriculum content to the target language (pt, en, es, fr, de, it, nl)
 // @Tags         Generate AI
 // @Accept       json
 // @Produce      json
-// @Param        body  body      dto.GenerateTranslationAIRequestDoc  true  "Curriculum to translate + target_language (pt, en, es)"
+// @Param        body  body      dto.GenerateTranslationAIRequestDoc  true  "Curriculum to translate + target_language (pt, en, es, fr, de, it, nl)"
 // @Success      200   {object}  dto.CurriculumResponse  "Translated curriculum (same structure as create curriculum)"
 // @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
 // @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
@@ -52,6 +56,57 @@ func (h *GenerateTranslationAIHandler) FilterContent(c *gin.Context) {
 	c.JSON(http.StatusOK, aiResponse)
 }
 
+// TranslateStoredCurriculum godoc
+// @Summary      Translate a stored curriculum
+// @Description  Uses AI to create a new curriculum translated to the target language (pt, en, es, fr, de, it, nl). Only the intro, work positions and descriptions, and education degrees and descriptions are translated; everything else, including dates, is copied. The new curriculum is linked to its source
+// @Tags         Generate AI
+// @Accept       json
+// @Produce      json
+// @Param        curriculum_id  path      string                          true  "Source curriculum ID"
+// @Param        body           body      dto.TranslateCurriculumRequest  true  "Target language"
+// @Success      201            {object}  dto.CurriculumResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404            {object}  dto.ErrorResponse  "Curriculum not found"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/{curriculum_id}/translate [post]
+// @Security     BearerAuth
+func (h *GenerateTranslationAIHandler) TranslateStoredCurriculum(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	curriculumID, err := uuid.Parse(c.Param("curriculum_id"))
+	if err != nil {
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+		return
+	}
+
+	var req dto.TranslateCurriculumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	curriculum, err := h.generateTranslationAIUseCase.TranslateStoredCurriculum(c.Request.Context(), userID, curriculumID, &req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeCurriculumNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "translate stored curriculum", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, curriculum)
+}
+
 func (h *GenerateTranslationAIHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
 	if h.logger != nil {
 		h.logger.Error("Generate translation AI handler failed",
@@ -60,5 +115,5 @@ func (h *GenerateTranslationAIHandler) abortWithInternalServerError(c *gin.Conte
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// InterviewPrepHandler handles HTTP requests for stored interview practice sets
+type InterviewPrepHandler struct {
+	interviewPrepUseCase usecases.InterviewPrepUseCase
+	logger               *zap.Logger
+}
+
+// NewInterviewPrepHandler creates a new instance of InterviewPrepHandler
+func NewInterviewPrepHandler(interviewPrepUseCase usecases.InterviewPrepUseCase, logger *zap.Logger) *InterviewPrepHandler {
+	return &InterviewPrepHandler{
+		interviewPrepUseCase: interviewPrepUseCase,
+		logger:               logger,
+	}
+}
+
+// ListPracticeSets godoc
+// @Summary      List interview practice sets
+// @Description  Returns the authenticated user's interview practice sets with their questions, newest first
+// @Tags         interview-prep
+// @Produce      json
+// @Param        curriculum_id  query     string  false  "Only sets generated from this curriculum"
+// @Success      200            {array}   dto.InterviewPracticeSetResponse
+// @Failure      400            {object}  dto.ErrorResponseValidation  "Invalid curriculum ID format"
+// @Failure      500            {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/interview-prep [get]
+// @Security     BearerAuth
+func (h *InterviewPrepHandler) ListPracticeSets(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var curriculumID *uuid.UUID
+	if raw := c.Query("curriculum_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidCurriculumID, "")
+			return
+		}
+		curriculumID = &id
+	}
+
+	resp, err := h.interviewPrepUseCase.ListPracticeSets(c.Request.Context(), userID, curriculumID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list interview practice sets", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetPracticeSet godoc
+// @Summary      Get interview practice set
+// @Description  Returns an interview practice set with its questions, STAR answers and notes
+// @Tags         interview-prep
+// @Produce      json
+// @Param        set_id  path      string  true  "Practice set ID"
+// @Success      200     {object}  dto.InterviewPracticeSetResponse
+// @Failure      400     {object}  dto.ErrorResponseValidation  "Invalid practice set ID format"
+// @Failure      404     {object}  dto.ErrorResponse  "Practice set not found"
+// @Failure      500     {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/interview-prep/{set_id} [get]
+// @Security     BearerAuth
+func (h *InterviewPrepHandler) GetPracticeSet(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	setID, ok := h.parseUUIDParam(c, "set_id", "invalid practice set ID format")
+	if !ok {
+		return
+	}
+
+	resp, err := h.interviewPrepUseCase.GetPracticeSet(c.Request.Context(), userID, setID)
+	if err != nil {
+		h.handleUseCaseError(c, apperrors.CodePracticeSetNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateQuestion godoc
+// @Summary      Update practice question notes
+// @Description  Saves the user's notes for a practice question and whether it was practiced
+// @Tags         interview-prep
+// @Accept       json
+// @Produce      json
+// @Param        set_id       path      string                              true  "Practice set ID"
+// @Param        question_id  path      string                              true  "Question ID"
+// @Param        body         body      dto.UpdateInterviewQuestionRequest  true  "Notes"
+// @Success      200          {object}  dto.InterviewQuestionResponse
+// @Failure      400          {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      404          {object}  dto.ErrorResponse  "Practice set or question not found"
+// @Failure      500          {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/interview-prep/{set_id}/questions/{question_id} [patch]
+// @Security     BearerAuth
+func (h *InterviewPrepHandler) UpdateQuestion(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	setID, ok := h.parseUUIDParam(c, "set_id", "invalid practice set ID format")
+	if !ok {
+		return
+	}
+	questionID, ok := h.parseUUIDParam(c, "question_id", "invalid question ID format")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateInterviewQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.interviewPrepUseCase.UpdateQuestion(c.Request.Context(), userID, setID, questionID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, apperrors.CodePracticeQuestionNotFound, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeletePracticeSet godoc
+// @Summary      Delete interview practice set
+// @Description  Deletes an interview practice set with its questions and notes
+// @Tags         interview-prep
+// @Param        set_id  path  string  true  "Practice set ID"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid practice set ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Practice set not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/interview-prep/{set_id} [delete]
+// @Security     BearerAuth
+func (h *InterviewPrepHandler) DeletePracticeSet(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	setID, ok := h.parseUUIDParam(c, "set_id", "invalid practice set ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.interviewPrepUseCase.DeletePracticeSet(c.Request.Context(), userID, setID); err != nil {
+		h.handleUseCaseError(c, apperrors.CodePracticeSetNotFound, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// handleUseCaseError maps not-found errors to 404 and anything else to 500.
+func (h *InterviewPrepHandler) handleUseCaseError(c *gin.Context, notFoundCode apperrors.Code, err error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		transporthttp.HandleUseCaseError(c, err, notFoundCode)
+		return
+	}
+	h.abortWithInternalServerError(c, c.Request.Method+" interview prep", err)
+}
+
+func (h *InterviewPrepHandler) parseUUIDParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New(message))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func (h *InterviewPrepHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *InterviewPrepHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Interview prep handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxLinkedInExportSize limits the uploaded archive. The basic LinkedIn export is well below it.
+const maxLinkedInExportSize = 20 << 20
+
+// LinkedInImportHandler handles HTTP requests for importing LinkedIn data exports
+type LinkedInImportHandler struct {
+	linkedInImportUseCase usecases.LinkedInImportUseCase
+	logger                *zap.Logger
+}
+
+// NewLinkedInImportHandler creates a new instance of LinkedInImportHandler
+func NewLinkedInImportHandler(linkedInImportUseCase usecases.LinkedInImportUseCase, logger *zap.Logger) *LinkedInImportHandler {
+	return &LinkedInImportHandler{
+		linkedInImportUseCase: linkedInImportUseCase,
+		logger:                logger,
+	}
+}
+
+// ImportLinkedIn godoc
+// @Summary      Import curriculum from LinkedIn
+// @Description  Creates a draft curriculum from the LinkedIn data export ZIP (Profile.csv, Positions.csv, Education.csv, Skills.csv, plus Languages.csv, Email Addresses.csv and PhoneNumbers.csv when present). Rows that cannot be mapped are listed in skipped
+// @Tags         curriculums
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file  formData  file  true  "LinkedIn data export ZIP (max 20 MB)"
+// @Success      201   {object}  dto.LinkedInImportResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Missing file or not a LinkedIn export"
+// @Failure      413   {object}  dto.ErrorResponse  "File too large"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/curriculums/import/linkedin [post]
+// @Security     BearerAuth
+func (h *LinkedInImportHandler) ImportLinkedIn(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	// Leave 1 MB on top of the file limit for the multipart envelope.
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxLinkedInExportSize+(1<<20))
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			transporthttp.HandleErrorf(c, http.StatusRequestEntityTooLarge, "file must be at most %d MB", maxLinkedInExportSize>>20)
+			return
+		}
+		transporthttp.HandleValidationError(c, errors.New("file is required: upload the LinkedIn export ZIP as multipart field \"file\""))
+		return
+	}
+	if fileHeader.Size > maxLinkedInExportSize {
+		transporthttp.HandleErrorf(c, http.StatusRequestEntityTooLarge, "file must be at most %d MB", maxLinkedInExportSize>>20)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.abortWithInternalServerError(c, "open uploaded file", err)
+		return
+	}
+	defer file.Close()
+
+	archive, err := io.ReadAll(file)
+	if err != nil {
+		h.abortWithInternalServerError(c, "read uploaded file", err)
+		return
+	}
+
+	resp, err := h.linkedInImportUseCase.ImportLinkedIn(c.Request.Context(), userID, archive)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidLinkedInExport):
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidLinkedInExport, err.Error())
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
+		default:
+			h.abortWithInternalServerError(c, "import LinkedIn export", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+func (h *LinkedInImportHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("LinkedIn import handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,304 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// NewsletterHandler handles HTTP requests for newsletter subscriptions and campaigns
+type NewsletterHandler struct {
+	newsletterUseCase usecases.NewsletterUseCase
+	logger            *zap.Logger
+}
+
+// NewNewsletterHandler creates a new instance of NewsletterHandler
+func NewNewsletterHandler(newsletterUseCase usecases.NewsletterUseCase, logger *zap.Logger) *NewsletterHandler {
+	return &NewsletterHandler{
+		newsletterUseCase: newsletterUseCase,
+		logger:            logger,
+	}
+}
+
+// GetSubscription godoc
+// @Summary      Get my newsletter subscription
+// @Description  Returns the newsletter status of the user: subscribed, pending (waiting for the confirmation link) or unsubscribed
+// @Tags         newsletter
+// @Produce      json
+// @Success      200  {object}  dto.NewsletterSubscriptionResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "User not authenticated"
+// @Failure      404  {object}  dto.ErrorResponse  "Configuration not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/newsletter/subscription [get]
+// @Security     BearerAuth
+func (h *NewsletterHandler) GetSubscription(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.newsletterUseCase.GetSubscription(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, "get newsletter subscription", err, apperrors.CodeConfigurationNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Subscribe godoc
+// @Summary      Subscribe to the newsletter
+// @Description  Sends a confirmation email; the subscription stays pending until its link is opened (double opt-in). Calling it again while pending sends a new link.
+// @Tags         newsletter
+// @Produce      json
+// @Success      200  {object}  dto.NewsletterSubscriptionResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "User not authenticated"
+// @Failure      404  {object}  dto.ErrorResponse  "Configuration not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Failure      503  {object}  dto.ErrorResponse  "Newsletter is not configured"
+// @Router       /api/v1/newsletter/subscription [post]
+// @Security     BearerAuth
+func (h *NewsletterHandler) Subscribe(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.newsletterUseCase.Subscribe(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, "subscribe to newsletter", err, apperrors.CodeConfigurationNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Unsubscribe godoc
+// @Summary      Unsubscribe from the newsletter
+// @Description  Opts the user out of the newsletter, including a subscription waiting for confirmation
+// @Tags         newsletter
+// @Produce      json
+// @Success      200  {object}  dto.NewsletterSubscriptionResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "User not authenticated"
+// @Failure      404  {object}  dto.ErrorResponse  "Configuration not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/newsletter/subscription [delete]
+// @Security     BearerAuth
+func (h *NewsletterHandler) Unsubscribe(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.newsletterUseCase.Unsubscribe(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, "unsubscribe from newsletter", err, apperrors.CodeConfigurationNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Confirm godoc
+// @Summary      Confirm newsletter subscription
+// @Description  Public endpoint behind the link of the confirmation email. Only the latest link of a subscription works, until it expires.
+// @Tags         newsletter
+// @Produce      json
+// @Param        token  query     string  true  "Confirmation token"
+// @Success      200    {object}  dto.NewsletterSubscriptionResponse
+// @Failure      400    {object}  dto.ErrorResponse  "Invalid or expired newsletter link"
+// @Failure      500    {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/newsletter/confirm [get]
+func (h *NewsletterHandler) Confirm(c *gin.Context) {
+	resp, err := h.newsletterUseCase.Confirm(c.Request.Context(), c.Query("token"))
+	if err != nil {
+		h.handleError(c, "confirm newsletter subscription", err, apperrors.CodeConfigurationNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UnsubscribeWithToken godoc
+// @Summary      Unsubscribe with a newsletter link
+// @Description  Public endpoint behind the unsubscribe link of newsletter emails. POST is the one-click unsubscribe of the List-Unsubscribe-Post header (RFC 8058); opening the link more than once is not an error.
+// @Tags         newsletter
+// @Produce      json
+// @Param        token  query     string  true  "Unsubscribe token"
+// @Success      200    {object}  dto.MessageResponse
+// @Failure      400    {object}  dto.ErrorResponse  "Invalid newsletter link"
+// @Failure      500    {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/newsletter/unsubscribe [get]
+// @Router       /api/v1/newsletter/unsubscribe [post]
+func (h *NewsletterHandler) UnsubscribeWithToken(c *gin.Context) {
+	if err := h.newsletterUseCase.UnsubscribeWithToken(c.Request.Context(), c.Query("token")); err != nil {
+		h.handleError(c, "unsubscribe from newsletter with link", err, apperrors.CodeConfigurationNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "You have been unsubscribed from the newsletter"})
+}
+
+// CreateCampaign godoc
+// @Summary      Create newsletter campaign
+// @Description  Stores a draft campaign; it is mailed to confirmed subscribers with the send endpoint. Requires admin user.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.CreateNewsletterCampaignRequest  true  "Campaign"
+// @Success      201   {object}  dto.NewsletterCampaignResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      401   {object}  dto.ErrorResponse  "Authentication required"
+// @Failure      403   {object}  dto.ErrorResponse  "Admin access required"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/newsletter/campaigns [post]
+// @Security     BearerAuth
+func (h *NewsletterHandler) CreateCampaign(c *gin.Context) {
+	adminID, ok := h.userIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateNewsletterCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.newsletterUseCase.CreateCampaign(c.Request.Context(), adminID, &req)
+	if err != nil {
+		h.abortWithInternalServerError(c, "create newsletter campaign", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListCampaigns godoc
+// @Summary      List newsletter campaigns
+// @Description  Returns all newsletter campaigns with their delivery and unsubscribe counts, newest first. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Success      200  {array}   dto.NewsletterCampaignResponse
+// @Failure      401  {object}  dto.ErrorResponse  "Authentication required"
+// @Failure      403  {object}  dto.ErrorResponse  "Admin access required"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/newsletter/campaigns [get]
+// @Security     BearerAuth
+func (h *NewsletterHandler) ListCampaigns(c *gin.Context) {
+	campaigns, err := h.newsletterUseCase.ListCampaigns(c.Request.Context())
+	if err != nil {
+		h.abortWithInternalServerError(c, "list newsletter campaigns", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, campaigns)
+}
+
+// GetCampaign godoc
+// @Summary      Get newsletter campaign
+// @Description  Returns a newsletter campaign with its delivery and unsubscribe counts. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Campaign ID"
+// @Success      200  {object}  dto.NewsletterCampaignResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid campaign ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Campaign not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/newsletter/campaigns/{id} [get]
+// @Security     BearerAuth
+func (h *NewsletterHandler) GetCampaign(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid campaign ID format"))
+		return
+	}
+
+	resp, err := h.newsletterUseCase.GetCampaign(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, "get newsletter campaign", err, apperrors.CodeNewsletterCampaignNotFound)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// SendCampaign godoc
+// @Summary      Send newsletter campaign
+// @Description  Starts mailing a draft campaign to the confirmed subscribers. Emails are sent in background batches; follow the progress in the campaign stats. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Campaign ID"
+// @Success      202  {object}  dto.NewsletterCampaignResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid campaign ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Campaign not found"
+// @Failure      409  {object}  dto.ErrorResponse  "Campaign was already sent"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Failure      503  {object}  dto.ErrorResponse  "Newsletter is not configured"
+// @Router       /api/v1/admin/newsletter/campaigns/{id}/send [post]
+// @Security     BearerAuth
+func (h *NewsletterHandler) SendCampaign(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid campaign ID format"))
+		return
+	}
+
+	resp, err := h.newsletterUseCase.SendCampaign(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, "send newsletter campaign", err, apperrors.CodeNewsletterCampaignNotFound)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, resp)
+}
+
+// userIDFromContext returns the authenticated user, answering 400 when it is missing
+func (h *NewsletterHandler) userIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// handleError maps newsletter use case errors to problem responses
+func (h *NewsletterHandler) handleError(c *gin.Context, operation string, err error, notFoundCode apperrors.Code) {
+	switch {
+	case errors.Is(err, usecases.ErrNewsletterUnavailable):
+		transporthttp.HandleCodeError(c, apperrors.CodeNewsletterUnavailable, "")
+	case errors.Is(err, usecases.ErrInvalidNewsletterToken):
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidNewsletterToken, "")
+	case errors.Is(err, usecases.ErrNewsletterCampaignNotDraft):
+		transporthttp.HandleCodeError(c, apperrors.CodeNewsletterCampaignNotDraft, "")
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, notFoundCode)
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *NewsletterHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Newsletter handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,388 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// OrganizationHandler handles HTTP requests for organizations, members, invitations and seats
+type OrganizationHandler struct {
+	organizationUseCase usecases.OrganizationUseCase
+	logger              *zap.Logger
+}
+
+// NewOrganizationHandler creates a new instance of OrganizationHandler
+func NewOrganizationHandler(organizationUseCase usecases.OrganizationUseCase, logger *zap.Logger) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationUseCase: organizationUseCase,
+		logger:              logger,
+	}
+}
+
+// CreateOrganization godoc
+// @Summary      Create organization
+// @Description  Creates an organization owned by the authenticated user. A user belongs to at most one organization
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.CreateOrganizationRequest  true  "Organization"
+// @Success      201   {object}  dto.OrganizationResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      409   {object}  dto.ErrorResponse  "Already in an organization"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations [post]
+// @Security     BearerAuth
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.CreateOrganization(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "create organization", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// GetMyOrganization godoc
+// @Summary      Get my organization
+// @Description  Returns the user's organization with its plan, seats, members and (for owners and admins) pending invitations
+// @Tags         organizations
+// @Produce      json
+// @Success      200  {object}  dto.OrganizationResponse
+// @Failure      404  {object}  dto.ErrorResponse  "Organization not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me [get]
+// @Security     BearerAuth
+func (h *OrganizationHandler) GetMyOrganization(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.organizationUseCase.GetMyOrganization(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeOrganizationNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "get my organization", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// InviteMember godoc
+// @Summary      Invite organization member
+// @Description  Invites an email address to the organization, reserving one seat. The token is only returned here. Requires owner or admin
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.InviteOrganizationMemberRequest  true  "Invitation"
+// @Success      201   {object}  dto.OrganizationInvitationResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404   {object}  dto.ErrorResponse  "Organization not found"
+// @Failure      409   {object}  dto.ErrorResponse  "No seats available or user already in an organization"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/invitations [post]
+// @Security     BearerAuth
+func (h *OrganizationHandler) InviteMember(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InviteOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.InviteMember(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "invite member", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// RevokeInvitation godoc
+// @Summary      Revoke organization invitation
+// @Description  Revokes a pending invitation and frees its seat. Requires owner or admin
+// @Tags         organizations
+// @Param        id   path  string  true  "Invitation ID"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid invitation ID"
+// @Failure      403  {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404  {object}  dto.ErrorResponse  "Invitation not found"
+// @Failure      410  {object}  dto.ErrorResponse  "Invitation not pending"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/invitations/{id} [delete]
+// @Security     BearerAuth
+func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	invitationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid invitation ID format"))
+		return
+	}
+
+	if err := h.organizationUseCase.RevokeInvitation(c.Request.Context(), userID, invitationID); err != nil {
+		h.handleUseCaseError(c, "revoke invitation", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AcceptInvitation godoc
+// @Summary      Accept organization invitation
+// @Description  Joins the organization using the invitation token. The invitation must be addressed to the user's email
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.AcceptOrganizationInvitationRequest  true  "Invitation token"
+// @Success      200   {object}  dto.OrganizationResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      403   {object}  dto.ErrorResponse  "Invitation sent to another email"
+// @Failure      404   {object}  dto.ErrorResponse  "Invitation not found"
+// @Failure      409   {object}  dto.ErrorResponse  "Already in an organization or no seats available"
+// @Failure      410   {object}  dto.ErrorResponse  "Invitation expired or already used"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/invitations/accept [post]
+// @Security     BearerAuth
+func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AcceptOrganizationInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.AcceptInvitation(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "accept invitation", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateMemberRole godoc
+// @Summary      Change member role
+// @Description  Switches a member between admin and member. The owner's role cannot be changed. Requires owner or admin
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        userId  path      string                               true  "Member user ID"
+// @Param        body    body      dto.UpdateOrganizationMemberRequest  true  "Role"
+// @Success      200     {object}  dto.OrganizationMemberResponse
+// @Failure      400     {object}  dto.ErrorResponseValidation  "Validation error or invalid role"
+// @Failure      403     {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404     {object}  dto.ErrorResponse  "Member not found"
+// @Failure      409     {object}  dto.ErrorResponse  "The owner's role cannot be changed"
+// @Failure      500     {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/members/{userId} [patch]
+// @Security     BearerAuth
+func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	memberUserID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid user ID format"))
+		return
+	}
+
+	var req dto.UpdateOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.UpdateMemberRole(c.Request.Context(), userID, memberUserID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update member role", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// RemoveMember godoc
+// @Summary      Remove organization member
+// @Description  Removes a member and frees their seat. Members may remove themselves to leave; the owner cannot be removed
+// @Tags         organizations
+// @Param        userId  path  string  true  "Member user ID"
+// @Success      204
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid user ID"
+// @Failure      403  {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404  {object}  dto.ErrorResponse  "Member not found"
+// @Failure      409  {object}  dto.ErrorResponse  "The owner cannot be removed"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/members/{userId} [delete]
+// @Security     BearerAuth
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	memberUserID, err := uuid.Parse(c.Param("userId"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid user ID format"))
+		return
+	}
+
+	if err := h.organizationUseCase.RemoveMember(c.Request.Context(), userID, memberUserID); err != nil {
+		h.handleUseCaseError(c, "remove member", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateCheckoutSession godoc
+// @Summary      Buy organization seats
+// @Description  Creates a Stripe checkout session billing the plan price once per seat. The plan quota is multiplied by the seats and pooled across members. Requires owner
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.CreateOrganizationCheckoutRequest  true  "Plan and seats"
+// @Success      200   {object}  dto.CreateCheckoutSessionResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error, invalid plan or seats below the members in use"
+// @Failure      403   {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404   {object}  dto.ErrorResponse  "Organization not found"
+// @Failure      409   {object}  dto.ErrorResponse  "Organization subscription already active"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/checkout [post]
+// @Security     BearerAuth
+func (h *OrganizationHandler) CreateCheckoutSession(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateOrganizationCheckoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.CreateCheckoutSession(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "create organization checkout", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateSeats godoc
+// @Summary      Change organization seats
+// @Description  Changes the number of paid seats; added seats are invoiced prorated immediately. Seats cannot drop below members plus pending invitations. Requires owner
+// @Tags         organizations
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.UpdateOrganizationSeatsRequest  true  "Seats"
+// @Success      200   {object}  dto.OrganizationResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error or seats below the members in use"
+// @Failure      403   {object}  dto.ErrorResponse  "Insufficient organization role"
+// @Failure      404   {object}  dto.ErrorResponse  "Organization not found"
+// @Failure      409   {object}  dto.ErrorResponse  "No active organization subscription"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/organizations/me/seats [patch]
+// @Security     BearerAuth
+func (h *OrganizationHandler) UpdateSeats(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateOrganizationSeatsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.organizationUseCase.UpdateSeats(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleUseCaseError(c, "update seats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleUseCaseError maps organization errors: 404 for missing records, 403 for
+// insufficient role, the code of business rule violations, and 500 for anything else.
+func (h *OrganizationHandler) handleUseCaseError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		transporthttp.HandleUseCaseError(c, err, apperrors.CodeNotFound)
+	case errors.Is(err, usecases.ErrOrganizationForbidden):
+		transporthttp.HandleCodeError(c, apperrors.CodeOrganizationRole, "")
+	case apperrors.CodeOf(err) != "":
+		transporthttp.HandleCodeError(c, apperrors.CodeOf(err), "")
+	default:
+		h.abortWithInternalServerError(c, operation, err)
+	}
+}
+
+func (h *OrganizationHandler) getUserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return uuid.Nil, false
+	}
+
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+func (h *OrganizationHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Organization handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ReferralHandler handles HTTP requests for referral codes and campaigns
+type ReferralHandler struct {
+	referralUseCase usecases.ReferralUseCase
+	logger          *zap.Logger
+}
+
+// NewReferralHandler creates a new instance of ReferralHandler
+func NewReferralHandler(referralUseCase usecases.ReferralUseCase, logger *zap.Logger) *ReferralHandler {
+	return &ReferralHandler{
+		referralUseCase: referralUseCase,
+		logger:          logger,
+	}
+}
+
+// GetMyReferral godoc
+// @Summary      Get my referral code
+// @Description  Returns the user's referral code, share link, referral results, bonus AI requests and the running campaign
+// @Tags         referrals
+// @Produce      json
+// @Success      200  {object}  dto.ReferralSummaryResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "User not authenticated"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/referrals/me [get]
+// @Security     BearerAuth
+func (h *ReferralHandler) GetMyReferral(c *gin.Context) {
+	ctxUserID, ok := c.Get("user_id")
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("user not authenticated"))
+		return
+	}
+	userID, ok := ctxUserID.(uuid.UUID)
+	if !ok {
+		transporthttp.HandleValidationError(c, errors.New("invalid user id in request context"))
+		return
+	}
+
+	resp, err := h.referralUseCase.GetMyReferral(c.Request.Context(), userID)
+	if err != nil {
+		h.abortWithInternalServerError(c, "get my referral", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// CreateCampaign godoc
+// @Summary      Create referral campaign
+// @Description  Creates an active referral campaign rewarding referrers with AI credits or a Stripe coupon. Requires admin user.
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.CreateReferralCampaignRequest  true  "Campaign"
+// @Success      201   {object}  dto.ReferralCampaignResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      401   {object}  dto.ErrorResponse  "Authentication required"
+// @Failure      403   {object}  dto.ErrorResponse  "Admin access required"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/referral-campaigns [post]
+// @Security     BearerAuth
+func (h *ReferralHandler) CreateCampaign(c *gin.Context) {
+	var req dto.CreateReferralCampaignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		transporthttp.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.referralUseCase.CreateCampaign(c.Request.Context(), &req)
+	if err != nil {
+		if code := apperrors.CodeOf(err); code != "" {
+			transporthttp.HandleCodeError(c, code, "")
+			return
+		}
+		h.abortWithInternalServerError(c, "create referral campaign", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListCampaigns godoc
+// @Summary      List referral campaigns
+// @Description  Returns all referral campaigns, newest first. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Success      200  {array}   dto.ReferralCampaignResponse
+// @Failure      401  {object}  dto.ErrorResponse  "Authentication required"
+// @Failure      403  {object}  dto.ErrorResponse  "Admin access required"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/referral-campaigns [get]
+// @Security     BearerAuth
+func (h *ReferralHandler) ListCampaigns(c *gin.Context) {
+	campaigns, err := h.referralUseCase.ListCampaigns(c.Request.Context())
+	if err != nil {
+		h.abortWithInternalServerError(c, "list referral campaigns", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, campaigns)
+}
+
+// DeactivateCampaign godoc
+// @Summary      Deactivate referral campaign
+// @Description  Stops the campaign from attributing new signups and granting rewards for unconverted ones. Requires admin user.
+// @Tags         admin
+// @Produce      json
+// @Param        id   path      string  true  "Campaign ID"
+// @Success      200  {object}  dto.ReferralCampaignResponse
+// @Failure      400  {object}  dto.ErrorResponseValidation  "Invalid campaign ID format"
+// @Failure      404  {object}  dto.ErrorResponse  "Campaign not found"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/admin/referral-campaigns/{id}/deactivate [patch]
+// @Security     BearerAuth
+func (h *ReferralHandler) DeactivateCampaign(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		transporthttp.HandleValidationError(c, errors.New("invalid campaign ID format"))
+		return
+	}
+
+	resp, err := h.referralUseCase.DeactivateCampaign(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeReferralCampaignNotFound)
+			return
+		}
+		h.abortWithInternalServerError(c, "deactivate referral campaign", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ReferralHandler) abortWithInternalServerError(c *gin.Context, operation string, err error) {
+	if h.logger != nil {
+		h.logger.Error("Referral handler failed",
+			zap.String("operation", operation),
+			zap.String("path", c.FullPath()),
+			zap.Error(err),
+		)
+	}
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
+}
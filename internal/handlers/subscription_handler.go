@@ -3,8 +3,10 @@ package handlers
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -53,7 +55,7 @@ func (h *SubscriptionHandler) CreateCheckoutSession(c *gin.Context) {
 
 	resp, err := h.subscriptionUseCase.CreateCheckoutSession(c.Request.Context(), userID, &req)
 	if err != nil {
-		transporthttp.HandleValidationError(c, err)
+		h.handleBillingError(c, "create checkout session", err)
 		return
 	}
 
@@ -74,13 +76,156 @@ func (h *SubscriptionHandler) CreatePortalSession(c *gin.Context) {
 
 	resp, err := h.subscriptionUseCase.CreatePortalSession(c.Request.Context(), userID, &req)
 	if err != nil {
+		h.handleBillingError(c, "create portal session", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListInvoices godoc
+// @Summary      List my invoices
+// @Description  Returns the user's invoices (amount, currency, status, hosted and PDF URLs, period), newest first, from the local history synced by Stripe webhooks
+// @Tags         subscriptions
+// @Produce      json
+// @Param        limit  query     int  false  "Max invoices to return (1-100)"  default(24)
+// @Success      200    {object}  dto.InvoicesResponse
+// @Failure      400    {object}  dto.ErrorResponseValidation  "Invalid limit"
+// @Failure      500    {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/subscriptions/invoices [get]
+// @Security     BearerAuth
+func (h *SubscriptionHandler) ListInvoices(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		n, err := strconv.Atoi(limitStr)
+		if err != nil || n < 1 || n > 100 {
+			transporthttp.HandleValidationError(c, errors.New("limit must be an integer between 1 and 100"))
+			return
+		}
+		limit = n
+	}
+
+	resp, err := h.subscriptionUseCase.ListInvoices(c.Request.Context(), userID, limit)
+	if err != nil {
+		h.abortWithInternalServerError(c, "list invoices", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// StartTrial godoc
+// @Summary      Start free trial
+// @Description  Grants the configured trial plan once per user, without a card. Not available after a paid subscription
+// @Tags         subscriptions
+// @Produce      json
+// @Success      200  {object}  dto.SubscriptionResponse
+// @Failure      409  {object}  dto.ErrorResponse  "Trial already used or a paid subscription exists"
+// @Failure      500  {object}  dto.ErrorResponseServer  "Internal server error"
+// @Failure      503  {object}  dto.ErrorResponse  "Free trial is disabled"
+// @Router       /api/v1/subscriptions/trial [post]
+// @Security     BearerAuth
+func (h *SubscriptionHandler) StartTrial(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.subscriptionUseCase.StartTrial(c.Request.Context(), userID)
+	if err != nil {
+		if code := apperrors.CodeOf(err); code != "" {
+			transporthttp.HandleCodeError(c, code, "")
+			return
+		}
+		h.abortWithInternalServerError(c, "start trial", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PreviewChangePlan godoc
+// @Summary      Preview a plan change
+// @Description  Returns the prorated amount for an upgrade or the next invoice for a downgrade scheduled at period end
+// @Tags         subscriptions
+// @Produce      json
+// @Param        plan  query     string  true  "Target plan (simple, medium, ultra)"
+// @Success      200   {object}  dto.ChangePlanPreviewResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      409   {object}  dto.ErrorResponse  "No active paid subscription or already on this plan"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/subscriptions/change-plan/preview [get]
+// @Security     BearerAuth
+func (h *SubscriptionHandler) PreviewChangePlan(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	plan := c.Query("plan")
+	if plan == "" {
+		transporthttp.HandleValidationError(c, errors.New("plan query parameter is required"))
+		return
+	}
+
+	resp, err := h.subscriptionUseCase.PreviewPlanChange(c.Request.Context(), userID, plan)
+	if err != nil {
+		h.handleBillingError(c, "preview plan change", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ChangePlan godoc
+// @Summary      Change subscription plan
+// @Description  Upgrades are applied immediately with proration; downgrades are scheduled for the end of the current period
+// @Tags         subscriptions
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.ChangePlanRequest  true  "Target plan"
+// @Success      200   {object}  dto.ChangePlanResponse
+// @Failure      400   {object}  dto.ErrorResponseValidation  "Validation error"
+// @Failure      409   {object}  dto.ErrorResponse  "No active paid subscription or already on this plan"
+// @Failure      500   {object}  dto.ErrorResponseServer  "Internal server error"
+// @Router       /api/v1/subscriptions/change-plan [post]
+// @Security     BearerAuth
+func (h *SubscriptionHandler) ChangePlan(c *gin.Context) {
+	userID, ok := h.getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		transporthttp.HandleValidationError(c, err)
 		return
 	}
 
+	resp, err := h.subscriptionUseCase.ChangePlan(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.handleBillingError(c, "change plan", err)
+		return
+	}
+
 	c.JSON(http.StatusOK, resp)
 }
 
+// handleBillingError reports checkout, portal and plan change rule violations by their
+// code. Stripe and database failures are logged and returned as 500 without their message.
+func (h *SubscriptionHandler) handleBillingError(c *gin.Context, operation string, err error) {
+	if code := apperrors.CodeOf(err); code != "" {
+		transporthttp.HandleCodeError(c, code, "")
+		return
+	}
+	h.abortWithInternalServerError(c, operation, err)
+}
+
 func (h *SubscriptionHandler) StripeWebhook(c *gin.Context) {
 	signature := c.GetHeader("Stripe-Signature")
 	if signature == "" {
@@ -139,5 +284,5 @@ func (h *SubscriptionHandler) abortWithInternalServerError(c *gin.Context, opera
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
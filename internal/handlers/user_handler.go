@@ -6,6 +6,7 @@ import (
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
@@ -52,7 +53,7 @@ func (h *UserHandler) GetUserByID(c *gin.Context) {
 	user, err := h.userUseCase.GetUserByID(c.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "get user by id", err)
@@ -114,7 +115,7 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 	user, err := h.userUseCase.UpdateUser(c.Request.Context(), id, &req)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		if errors.Is(err, apperrors.ErrUserAlreadyExists) {
@@ -145,6 +146,9 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		transporthttp.HandleValidationError(c, err)
 		return
 	}
+	if req.Language == "" {
+		req.Language = i18n.FromContext(c)
+	}
 
 	user, err := h.userUseCase.CreateUser(c.Request.Context(), &req)
 	if err != nil {
@@ -182,7 +186,7 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 
 	if err := h.userUseCase.DeleteUser(c.Request.Context(), id); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			transporthttp.HandleUseCaseError(c, err, "user not found")
+			transporthttp.HandleUseCaseError(c, err, apperrors.CodeUserNotFound)
 			return
 		}
 		h.abortWithInternalServerError(c, "delete user", err)
@@ -200,5 +204,5 @@ func (h *UserHandler) abortWithInternalServerError(c *gin.Context, operation str
 			zap.Error(err),
 		)
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	transporthttp.HandleError(c, http.StatusInternalServerError, "Internal server error")
 }
@@ -0,0 +1,81 @@
+package i18n
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ContextKeyLocale is the gin context key holding the resolved language code of the request.
+const ContextKeyLocale = "locale"
+
+// contextKeyResolver is the gin context key holding the LanguageResolver set by Middleware.
+const contextKeyResolver = "locale_resolver"
+
+// LanguageResolver returns the preferred language (code or tag) of an authenticated user.
+type LanguageResolver func(ctx context.Context, userID uuid.UUID) (string, error)
+
+// Middleware makes resolve available to FromContext. The user's language is only looked
+// up when a message is actually translated, since authentication runs after this
+// middleware and most responses carry no translatable text.
+func Middleware(resolve LanguageResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if resolve != nil {
+			c.Set(contextKeyResolver, resolve)
+		}
+		c.Next()
+	}
+}
+
+// FromContext returns the language of the request: the authenticated user's configured
+// language, then the Accept-Language header, then the source language.
+func FromContext(c *gin.Context) string {
+	if lang := c.GetString(ContextKeyLocale); lang != "" {
+		return lang
+	}
+
+	if lang := userLanguage(c); lang != "" {
+		c.Set(ContextKeyLocale, lang)
+		return lang
+	}
+
+	if lang := ParseAcceptLanguage(c.GetHeader("Accept-Language")); lang != "" {
+		return lang
+	}
+	return SourceCode
+}
+
+// userLanguage returns the configured language of the authenticated user, or "" when the
+// request is anonymous or the language cannot be resolved.
+func userLanguage(c *gin.Context) string {
+	value, ok := c.Get(contextKeyResolver)
+	if !ok {
+		return ""
+	}
+	resolve, ok := value.(LanguageResolver)
+	if !ok {
+		return ""
+	}
+
+	raw, _ := c.Get("user_id")
+	var userID uuid.UUID
+	switch v := raw.(type) {
+	case uuid.UUID:
+		userID = v
+	case string:
+		parsed, err := uuid.Parse(v)
+		if err != nil {
+			return ""
+		}
+		userID = parsed
+	default:
+		return ""
+	}
+
+	lang, err := resolve(c.Request.Context(), userID)
+	if err != nil {
+		return ""
+	}
+	return Match(lang)
+}
@@ -0,0 +1,123 @@
+// Package i18n translates user-facing API messages and emails. English is the source
+// language: messages are written in English at the call site and used as keys into the
+// per-language catalogs embedded from catalogs/*.json, so a message missing from a
+// catalog is still returned in English.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+)
+
+// SourceCode is the language messages are written in at the call site.
+const SourceCode = "en"
+
+//go:embed catalogs/*.json
+var catalogFiles embed.FS
+
+// catalogs maps a language code ("pt") to its translations keyed by the English message.
+var catalogs = loadCatalogs()
+
+func loadCatalogs() map[string]map[string]string {
+	entries, err := catalogFiles.ReadDir("catalogs")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: read catalogs: %v", err))
+	}
+
+	loaded := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		data, err := catalogFiles.ReadFile(path.Join("catalogs", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: read catalog %s: %v", entry.Name(), err))
+		}
+		messages := make(map[string]string)
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: parse catalog %s: %v", entry.Name(), err))
+		}
+		loaded[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+	return loaded
+}
+
+// Languages returns the codes of the languages messages can be translated to, the source
+// language included.
+func Languages() []string {
+	codes := []string{SourceCode}
+	for code := range catalogs {
+		if code != SourceCode {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes[1:])
+	return codes
+}
+
+// Match returns the code of the translated language matching value (a code or tag such as
+// "pt-BR"), or "" when value is empty or has no catalog.
+func Match(value string) string {
+	code := locale.CodeOf(value)
+	if code == SourceCode {
+		return code
+	}
+	if _, ok := catalogs[code]; ok {
+		return code
+	}
+	return ""
+}
+
+// T returns message translated to lang. The message is returned unchanged when lang is the
+// source language, has no catalog or the catalog has no entry for it.
+func T(lang, message string) string {
+	if translated, ok := catalogs[Match(lang)][message]; ok && translated != "" {
+		return translated
+	}
+	return message
+}
+
+// Tf translates format to lang and formats it with args. The translated format must keep
+// the verbs of the original, in the same order.
+func Tf(lang, format string, args ...any) string {
+	return fmt.Sprintf(T(lang, format), args...)
+}
+
+// ParseAcceptLanguage returns the code of the preferred translated language of an
+// Accept-Language header, honouring q-values, or "" when none of them is available.
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		code string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		code := Match(strings.TrimSpace(tag))
+		if code == "" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{code: code, q: q})
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].code
+}
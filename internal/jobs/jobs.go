@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupJobs wires and starts the background jobs. They stop when ctx is canceled.
+func SetupJobs(ctx context.Context, db *gorm.DB, logger *zap.Logger, cfg *config.Config) {
+	subscriptionRepo := repositories.NewSubscriptionRepository(db, logger)
+	userRepo := repositories.NewUserRepository(db, logger)
+
+	// Reminder emails are optional: without email configuration the jobs still expire trials.
+	emailUseCase, err := usecases.NewEmailUseCase(repositories.NewOutboxEmailRepository(db, logger), cfg.Email, cfg.App.URL, logger)
+	if err != nil {
+		logger.Warn("Email use case unavailable, reminder emails disabled", zap.Error(err))
+		emailUseCase = nil
+	}
+
+	lifecycleUseCase := usecases.NewSubscriptionLifecycleUseCase(subscriptionRepo, userRepo, emailUseCase, cfg.Subscription, logger)
+	applicationUseCase := usecases.NewApplicationUseCase(
+		repositories.NewApplicationRepository(db, logger),
+		repositories.NewCurriculumRepository(db, logger),
+		userRepo,
+		emailUseCase,
+		logger,
+	)
+
+	NewScheduler(cfg.Subscription.JobsInterval, logger,
+		Job{Name: "subscription_trial_ending_reminders", Run: lifecycleUseCase.SendTrialEndingReminders},
+		Job{Name: "subscription_payment_failed_reminders", Run: lifecycleUseCase.SendPaymentFailedReminders},
+		Job{Name: "subscription_expire_trials", Run: lifecycleUseCase.ExpireTrials},
+		Job{Name: "application_follow_up_reminders", Run: applicationUseCase.SendFollowUpReminders},
+	).Start(ctx)
+
+	// Failed emails are retried and newsletter campaigns sent in batches on their own, shorter interval
+	if emailUseCase != nil {
+		newsletterUseCase := usecases.NewNewsletterUseCase(
+			repositories.NewNewsletterRepository(db, logger),
+			userRepo,
+			emailUseCase,
+			cache.NewCacheService(redis.GetClient(), logger),
+			cfg.Newsletter,
+			logger,
+		)
+
+		NewScheduler(cfg.Email.RetryInterval, logger,
+			Job{Name: "email_outbox_retry", Run: emailUseCase.RetryOutbox},
+			Job{Name: "newsletter_queued_deliveries", Run: newsletterUseCase.SyncQueuedDeliveries},
+			Job{Name: "newsletter_campaign_batches", Run: newsletterUseCase.SendCampaignBatches},
+		).Start(ctx)
+	}
+}
@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a named unit of periodic work.
+type Job struct {
+	Name string
+	Run  func(ctx context.Context) (int, error)
+}
+
+// Scheduler runs a set of jobs on a fixed interval until its context is canceled.
+type Scheduler struct {
+	interval time.Duration
+	jobs     []Job
+	logger   *zap.Logger
+}
+
+// NewScheduler creates a new Scheduler
+func NewScheduler(interval time.Duration, logger *zap.Logger, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		jobs:     jobs,
+		logger:   logger,
+	}
+}
+
+// Start runs every job once immediately and then on each tick, in a background goroutine.
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.runAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.runAll(ctx)
+			}
+		}
+	}()
+
+	s.logger.Info("Job scheduler started",
+		zap.Duration("interval", s.interval),
+		zap.Int("jobs", len(s.jobs)),
+	)
+}
+
+func (s *Scheduler) runAll(ctx context.Context) {
+	for _, job := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.run(ctx, job)
+	}
+}
+
+// run executes a single job, isolating panics so one failing job does not stop the others.
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("Job panicked", zap.String("job", job.Name), zap.Any("panic", r))
+		}
+	}()
+
+	start := time.Now()
+	processed, err := job.Run(ctx)
+	if err != nil {
+		s.logger.Error("Job failed",
+			zap.String("job", job.Name),
+			zap.Int("processed", processed),
+			zap.Error(err),
+		)
+		return
+	}
+	if processed > 0 {
+		s.logger.Info("Job completed",
+			zap.String("job", job.Name),
+			zap.Int("processed", processed),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+}
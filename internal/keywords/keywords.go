@@ -0,0 +1,185 @@
+// Package keywords extracts keywords from job descriptions and measures how well a
+// curriculum covers them. Everything here is deterministic and does not call the AI layer.
+package keywords
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Keyword is a term extracted from a job description.
+// Key is the normalized form used for matching; Term is how it first appeared.
+type Keyword struct {
+	Term  string
+	Key   string
+	Count int
+}
+
+// Section is a named block of curriculum text (intro, skills, works, ...).
+type Section struct {
+	Name string
+	Text string
+}
+
+// Coverage tells where and how often a keyword appears in the curriculum.
+type Coverage struct {
+	Keyword  Keyword
+	Mentions int
+	Sections []string
+}
+
+// Matched reports whether the curriculum mentions the keyword at all.
+func (c Coverage) Matched() bool {
+	return c.Mentions > 0
+}
+
+// token is a normalized word with its original spelling.
+type token struct {
+	key     string
+	surface string
+}
+
+// Extract returns up to limit keywords from text, most frequent first. Stopwords and
+// numbers are dropped. Two-word phrases are kept when they occur at least twice.
+func Extract(text string, limit int) []Keyword {
+	tokens := tokenize(text)
+
+	type entry struct {
+		keyword Keyword
+		first   int
+	}
+	entries := map[string]*entry{}
+	add := func(key, surface string, pos int) {
+		if e, ok := entries[key]; ok {
+			e.keyword.Count++
+			return
+		}
+		entries[key] = &entry{keyword: Keyword{Term: surface, Key: key, Count: 1}, first: pos}
+	}
+
+	for i, t := range tokens {
+		add(t.key, t.surface, i)
+	}
+	for i := 0; i+1 < len(tokens); i++ {
+		add(tokens[i].key+" "+tokens[i+1].key, tokens[i].surface+" "+tokens[i+1].surface, i)
+	}
+
+	result := make([]*entry, 0, len(entries))
+	for _, e := range entries {
+		if strings.Contains(e.keyword.Key, " ") && e.keyword.Count < 2 {
+			continue
+		}
+		result = append(result, e)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].keyword.Count != result[j].keyword.Count {
+			return result[i].keyword.Count > result[j].keyword.Count
+		}
+		return result[i].first < result[j].first
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	keywords := make([]Keyword, 0, len(result))
+	for _, e := range result {
+		keywords = append(keywords, e.keyword)
+	}
+	return keywords
+}
+
+// Cover counts each keyword in the curriculum sections, keeping the keywords' order.
+func Cover(keywords []Keyword, sections []Section) []Coverage {
+	counts := make([]map[string]int, len(sections))
+	for i, section := range sections {
+		counts[i] = countTerms(section.Text)
+	}
+
+	coverage := make([]Coverage, 0, len(keywords))
+	for _, kw := range keywords {
+		c := Coverage{Keyword: kw, Sections: []string{}}
+		for i, section := range sections {
+			if n := counts[i][kw.Key]; n > 0 {
+				c.Mentions += n
+				c.Sections = append(c.Sections, section.Name)
+			}
+		}
+		coverage = append(coverage, c)
+	}
+	return coverage
+}
+
+// Score is the share of keyword occurrences (weighted by how often the job description
+// repeats them) that the curriculum covers, from 0 to 100.
+func Score(coverage []Coverage) float64 {
+	var total, matched int
+	for _, c := range coverage {
+		total += c.Keyword.Count
+		if c.Matched() {
+			matched += c.Keyword.Count
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(matched*1000/total) / 10
+}
+
+// countTerms counts the single words and two-word phrases of text by normalized key.
+func countTerms(text string) map[string]int {
+	tokens := tokenize(text)
+	counts := make(map[string]int, len(tokens)*2)
+	for i, t := range tokens {
+		counts[t.key]++
+		if i+1 < len(tokens) {
+			counts[t.key+" "+tokens[i+1].key]++
+		}
+	}
+	return counts
+}
+
+// tokenize splits text into lowercase words, keeping characters used in technology
+// names (C++, C#, Node.js, .NET), and drops stopwords and numbers.
+func tokenize(text string) []token {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '.' && r != '-'
+	})
+
+	tokens := make([]token, 0, len(fields))
+	for _, field := range fields {
+		surface := strings.Trim(field, "-")
+		surface = strings.TrimRight(surface, ".")
+		lower := strings.ToLower(surface)
+		if len([]rune(lower)) < 2 || isNumber(lower) || stopwords[lower] {
+			continue
+		}
+		tokens = append(tokens, token{key: stem(lower), surface: surface})
+	}
+	return tokens
+}
+
+// stem removes a plural "s" so "APIs" matches "API" and "requisitos" matches "requisito".
+// Names with symbols (Node.js, CI/CD) are left alone.
+func stem(word string) string {
+	if len(word) <= 3 || !strings.HasSuffix(word, "s") || strings.HasSuffix(word, "ss") {
+		return word
+	}
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			return word
+		}
+	}
+	return word[:len(word)-1]
+}
+
+func isNumber(word string) bool {
+	for _, r := range word {
+		if !unicode.IsDigit(r) && r != '.' && r != '+' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
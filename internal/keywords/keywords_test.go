@@ -0,0 +1,227 @@
+package keywords
+
+import (
+	"reflect"
+	"testing"
+)
+
+func keyCounts(keywords []Keyword) map[string]int {
+	counts := make(map[string]int, len(keywords))
+	for _, kw := range keywords {
+		counts[kw.Key] = kw.Count
+	}
+	return counts
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[string]int
+	}{
+		{
+			name: "empty text",
+			text: "",
+			want: map[string]int{},
+		},
+		{
+			name: "stopwords and numbers are dropped",
+			text: "We are looking for the best Golang engineer with 5 years of experience in 2024",
+			want: map[string]int{"best": 1, "golang": 1, "engineer": 1},
+		},
+		{
+			name: "portuguese and spanish stopwords are dropped",
+			text: "Buscamos desenvolvedor com experiência em Python y conocimientos del equipo",
+			want: map[string]int{"desenvolvedor": 1, "python": 1},
+		},
+		{
+			name: "bigram seen once is dropped",
+			text: "Kafka streaming",
+			want: map[string]int{"kafka": 1, "streaming": 1},
+		},
+		{
+			name: "bigram seen twice is kept",
+			text: "Event sourcing matters. Event sourcing wins.",
+			want: map[string]int{
+				"event": 2, "sourcing": 2, "matter": 1, "win": 1,
+				"event sourcing": 2,
+			},
+		},
+		{
+			name: "bigrams skip over stopwords",
+			text: "React and Redux, React with Redux",
+			want: map[string]int{"react": 2, "redux": 2, "react redux": 2},
+		},
+		{
+			name: "technology names keep their symbols",
+			text: "C++, C#, Node.js and .NET.",
+			want: map[string]int{"c++": 1, "c#": 1, "node.js": 1, ".net": 1},
+		},
+		{
+			name: "plurals share a key",
+			text: "REST APIs and a GraphQL API",
+			want: map[string]int{"rest": 1, "api": 2, "graphql": 1},
+		},
+		{
+			name: "hyphens around words are trimmed",
+			text: "- front-end -",
+			want: map[string]int{"front-end": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keyCounts(Extract(tt.text, 0))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractOrderAndLimit(t *testing.T) {
+	text := "Rust Rust Rust Go Go Java"
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []Keyword
+	}{
+		{
+			name:  "no limit",
+			limit: 0,
+			want: []Keyword{
+				{Term: "Rust", Key: "rust", Count: 3},
+				{Term: "Rust Rust", Key: "rust rust", Count: 2},
+				{Term: "Go", Key: "go", Count: 2},
+				{Term: "Java", Key: "java", Count: 1},
+			},
+		},
+		{
+			name:  "limit keeps the most frequent",
+			limit: 2,
+			want: []Keyword{
+				{Term: "Rust", Key: "rust", Count: 3},
+				{Term: "Rust Rust", Key: "rust rust", Count: 2},
+			},
+		},
+		{
+			name:  "limit above result size",
+			limit: 10,
+			want: []Keyword{
+				{Term: "Rust", Key: "rust", Count: 3},
+				{Term: "Rust Rust", Key: "rust rust", Count: 2},
+				{Term: "Go", Key: "go", Count: 2},
+				{Term: "Java", Key: "java", Count: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Extract(text, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract(%q, %d) = %+v, want %+v", text, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{word: "apis", want: "api"},
+		{word: "requisitos", want: "requisito"},
+		{word: "microservices", want: "microservice"},
+		{word: "class", want: "class"},
+		{word: "aws", want: "aws"},
+		{word: "gas", want: "gas"},
+		{word: "node.js", want: "node.js"},
+		{word: "ci/cds", want: "ci/cds"},
+		{word: "golang", want: "golang"},
+		{word: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := stem(tt.word); got != tt.want {
+				t.Errorf("stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCover(t *testing.T) {
+	keywords := []Keyword{
+		{Term: "Go", Key: "go", Count: 2},
+		{Term: "APIs", Key: "api", Count: 1},
+		{Term: "event sourcing", Key: "event sourcing", Count: 2},
+		{Term: "Kafka", Key: "kafka", Count: 1},
+	}
+	sections := []Section{
+		{Name: "intro", Text: "Go developer building APIs."},
+		{Name: "skills", Text: "Go, API design, event sourcing"},
+		{Name: "works", Text: ""},
+	}
+
+	want := []Coverage{
+		{Keyword: keywords[0], Mentions: 2, Sections: []string{"intro", "skills"}},
+		{Keyword: keywords[1], Mentions: 2, Sections: []string{"intro", "skills"}},
+		{Keyword: keywords[2], Mentions: 1, Sections: []string{"skills"}},
+		{Keyword: keywords[3], Mentions: 0, Sections: []string{}},
+	}
+
+	got := Cover(keywords, sections)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Cover() = %+v, want %+v", got, want)
+	}
+	if got[3].Matched() {
+		t.Errorf("Matched() = true for a keyword with no mentions")
+	}
+	if !got[0].Matched() {
+		t.Errorf("Matched() = false for a keyword with mentions")
+	}
+}
+
+func TestCoverEmpty(t *testing.T) {
+	if got := Cover(nil, []Section{{Name: "intro", Text: "Go"}}); len(got) != 0 {
+		t.Errorf("Cover(nil) = %+v, want empty", got)
+	}
+
+	got := Cover([]Keyword{{Term: "Go", Key: "go", Count: 1}}, nil)
+	want := []Coverage{{Keyword: Keyword{Term: "Go", Key: "go", Count: 1}, Sections: []string{}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Cover(no sections) = %+v, want %+v", got, want)
+	}
+}
+
+func TestScore(t *testing.T) {
+	coverage := func(count, mentions int) Coverage {
+		return Coverage{Keyword: Keyword{Count: count}, Mentions: mentions}
+	}
+
+	tests := []struct {
+		name     string
+		coverage []Coverage
+		want     float64
+	}{
+		{name: "nil coverage", coverage: nil, want: 0},
+		{name: "zero total count", coverage: []Coverage{coverage(0, 3)}, want: 0},
+		{name: "nothing matched", coverage: []Coverage{coverage(2, 0), coverage(1, 0)}, want: 0},
+		{name: "everything matched", coverage: []Coverage{coverage(2, 1), coverage(1, 5)}, want: 100},
+		{name: "weighted by job description count", coverage: []Coverage{coverage(3, 1), coverage(1, 0)}, want: 75},
+		{name: "mentions do not add weight", coverage: []Coverage{coverage(1, 10), coverage(1, 0)}, want: 50},
+		{name: "truncated to one decimal", coverage: []Coverage{coverage(1, 1), coverage(2, 0)}, want: 33.3},
+		{name: "two thirds", coverage: []Coverage{coverage(2, 1), coverage(1, 0)}, want: 66.6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Score(tt.coverage); got != tt.want {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
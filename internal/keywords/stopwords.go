@@ -0,0 +1,41 @@
+package keywords
+
+// stopwords are common English, Portuguese and Spanish words and job posting filler
+// that never make useful keywords.
+var stopwords = toSet(
+	// English
+	"a", "about", "above", "after", "all", "also", "an", "and", "any", "are", "as", "at",
+	"be", "been", "being", "both", "but", "by", "can", "could", "do", "does", "each", "etc",
+	"for", "from", "has", "have", "having", "he", "her", "his", "how", "if", "in", "into",
+	"is", "it", "its", "job", "just", "least", "like", "looking", "may", "more", "most",
+	"must", "nice", "no", "not", "of", "on", "or", "other", "our", "out", "over", "own",
+	"plus", "preferred", "required", "role", "same", "she", "should", "so", "some", "such",
+	"than", "that", "the", "their", "them", "then", "there", "these", "they", "this",
+	"those", "through", "to", "too", "under", "up", "us", "very", "was", "we", "were",
+	"what", "when", "where", "which", "while", "who", "whom", "why", "will", "with",
+	"within", "would", "year", "years", "you", "your", "team", "work", "working", "strong",
+	"good", "great", "ability", "able", "experience", "knowledge", "skills", "including",
+	"responsibilities", "requirements", "candidate", "company", "join", "help", "new",
+	// Portuguese
+	"ao", "aos", "as", "às", "com", "como", "da", "das", "de", "do", "dos", "e", "é", "em",
+	"entre", "era", "essa", "esse", "esta", "está", "este", "eu", "isso", "já", "lhe", "mais",
+	"mas", "me", "mesmo", "muito", "na", "nas", "não", "nem", "no", "nos", "nós", "num",
+	"numa", "o", "os", "ou", "para", "pela", "pelas", "pelo", "pelos", "por", "qual",
+	"quando", "que", "quem", "se", "sem", "ser", "seu", "seus", "só", "sua", "suas",
+	"também", "te", "tem", "ter", "um", "uma", "umas", "uns", "você", "vaga", "anos",
+	"experiência", "conhecimento", "conhecimentos", "desejável", "requisitos", "empresa",
+	"equipe", "trabalho", "atividades", "responsabilidades", "será", "buscamos",
+	// Spanish
+	"al", "con", "del", "el", "ella", "en", "es", "esta", "este", "hay", "la", "las", "lo",
+	"los", "más", "para", "pero", "por", "que", "sin", "sobre", "su", "sus", "un", "una",
+	"unas", "unos", "y", "años", "experiencia", "conocimiento", "conocimientos", "puesto",
+	"empresa", "equipo", "trabajo", "buscamos",
+)
+
+func toSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
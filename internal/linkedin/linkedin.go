@@ -0,0 +1,399 @@
+// Package linkedin reads the data export archive LinkedIn provides under
+// Settings > Data privacy > Get a copy of your data. Only the files needed to build a
+// curriculum are read; rows that cannot be used are reported as issues instead of failing
+// the whole import.
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Export file names inside the archive. LinkedIn sometimes nests them in a folder.
+const (
+	FileProfile        = "Profile.csv"
+	FilePositions      = "Positions.csv"
+	FileEducation      = "Education.csv"
+	FileSkills         = "Skills.csv"
+	FileLanguages      = "Languages.csv"
+	FileEmailAddresses = "Email Addresses.csv"
+	FilePhoneNumbers   = "PhoneNumbers.csv"
+)
+
+// maxFileSize caps how much of each CSV is read, so a crafted archive cannot expand
+// into an unbounded amount of memory.
+const maxFileSize = 5 << 20
+
+// ErrNotAnExport is returned when the archive contains none of the expected files.
+var ErrNotAnExport = errors.New("archive is not a LinkedIn data export: Profile.csv, Positions.csv, Education.csv and Skills.csv are all missing")
+
+// Profile is the single row of Profile.csv.
+type Profile struct {
+	FirstName string
+	LastName  string
+	Headline  string
+	Summary   string
+	Websites  []string
+}
+
+// FullName joins first and last name.
+func (p Profile) FullName() string {
+	return strings.TrimSpace(strings.TrimSpace(p.FirstName) + " " + strings.TrimSpace(p.LastName))
+}
+
+// Position is a row of Positions.csv. FinishedOn is nil for the current position.
+type Position struct {
+	Company     string
+	Title       string
+	Description string
+	Location    string
+	StartedOn   time.Time
+	FinishedOn  *time.Time
+}
+
+// Education is a row of Education.csv. EndDate is nil while studying; Degree is empty for
+// courses listed without one.
+type Education struct {
+	School    string
+	Degree    string
+	Notes     string
+	StartDate time.Time
+	EndDate   *time.Time
+}
+
+// Language is a row of Languages.csv.
+type Language struct {
+	Name        string
+	Proficiency string
+}
+
+// Issue is a row, or a whole file, that could not be mapped. Row is the line number in the
+// CSV (the header is line 1) and is 0 for file-level issues.
+type Issue struct {
+	File   string
+	Row    int
+	Reason string
+}
+
+// Export is the parsed content of the archive.
+type Export struct {
+	Profile    Profile
+	Email      string
+	Phone      string
+	Positions  []Position
+	Educations []Education
+	Skills     []string
+	Languages  []Language
+	Files      []string
+	Issues     []Issue
+}
+
+// Parse reads a LinkedIn data export ZIP. It fails only when the archive cannot be opened
+// or holds none of the curriculum files; everything else ends up in Export.Issues.
+func Parse(data []byte) (*Export, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ZIP archive: %w", err)
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := strings.ToLower(path.Base(f.Name))
+		if _, seen := files[name]; !seen {
+			files[name] = f
+		}
+	}
+
+	export := &Export{}
+	readers := []struct {
+		name  string
+		parse func(*Export, []record)
+	}{
+		{FileProfile, parseProfile},
+		{FilePositions, parsePositions},
+		{FileEducation, parseEducations},
+		{FileSkills, parseSkills},
+		{FileLanguages, parseLanguages},
+		{FileEmailAddresses, parseEmailAddresses},
+		{FilePhoneNumbers, parsePhoneNumbers},
+	}
+	for _, reader := range readers {
+		f, ok := files[strings.ToLower(reader.name)]
+		if !ok {
+			continue
+		}
+		records, err := readCSV(f)
+		if err != nil {
+			export.Issues = append(export.Issues, Issue{File: reader.name, Reason: err.Error()})
+			continue
+		}
+		export.Files = append(export.Files, reader.name)
+		reader.parse(export, records)
+	}
+
+	if !export.hasAny(FileProfile, FilePositions, FileEducation, FileSkills) {
+		return nil, ErrNotAnExport
+	}
+	return export, nil
+}
+
+func (e *Export) hasAny(names ...string) bool {
+	for _, file := range e.Files {
+		for _, name := range names {
+			if file == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *Export) addIssue(file string, row int, format string, args ...any) {
+	e.Issues = append(e.Issues, Issue{File: file, Row: row, Reason: fmt.Sprintf(format, args...)})
+}
+
+// record is a CSV row keyed by normalized header name.
+type record struct {
+	line   int
+	fields map[string]string
+}
+
+func (r record) get(column string) string {
+	return strings.TrimSpace(r.fields[normalizeHeader(column)])
+}
+
+// readCSV reads the file into records keyed by header. LinkedIn prefixes some exports with
+// free-text notes before the header, so lines are skipped until one looks like a header.
+func readCSV(f *zip.File) ([]record, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(content) > maxFileSize {
+		return nil, fmt.Errorf("file is larger than %d MB", maxFileSize>>20)
+	}
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var header []string
+	var records []record
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if header == nil {
+			if len(row) > 1 || (len(row) == 1 && row[0] == "Name") {
+				header = make([]string, len(row))
+				for i, column := range row {
+					header[i] = normalizeHeader(column)
+				}
+			}
+			continue
+		}
+
+		if isBlankRow(row) {
+			continue
+		}
+		fields := make(map[string]string, len(header))
+		for i, value := range row {
+			if i < len(header) {
+				fields[header[i]] = value
+			}
+		}
+		records = append(records, record{line: line, fields: fields})
+	}
+	if header == nil {
+		return nil, errors.New("file has no header row")
+	}
+	return records, nil
+}
+
+func normalizeHeader(column string) string {
+	return strings.ToLower(strings.TrimSpace(column))
+}
+
+func isBlankRow(row []string) bool {
+	for _, value := range row {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+var urlPattern = regexp.MustCompile(`https?://[^\s,\]\[]+`)
+
+func parseProfile(e *Export, records []record) {
+	if len(records) == 0 {
+		e.addIssue(FileProfile, 0, "file has no profile row")
+		return
+	}
+	r := records[0]
+	e.Profile = Profile{
+		FirstName: r.get("First Name"),
+		LastName:  r.get("Last Name"),
+		Headline:  r.get("Headline"),
+		Summary:   r.get("Summary"),
+		Websites:  urlPattern.FindAllString(r.get("Websites"), -1),
+	}
+}
+
+func parsePositions(e *Export, records []record) {
+	for _, r := range records {
+		company, title := r.get("Company Name"), r.get("Title")
+		if company == "" || title == "" {
+			e.addIssue(FilePositions, r.line, "missing company name or title")
+			continue
+		}
+		startedOn, ok := parseDate(r.get("Started On"))
+		if !ok {
+			e.addIssue(FilePositions, r.line, "%s at %s: missing or unreadable start date %q", title, company, r.get("Started On"))
+			continue
+		}
+		finishedOn, ok := parseOptionalDate(r.get("Finished On"))
+		if !ok {
+			e.addIssue(FilePositions, r.line, "%s at %s: unreadable end date %q", title, company, r.get("Finished On"))
+			continue
+		}
+		e.Positions = append(e.Positions, Position{
+			Company:     company,
+			Title:       title,
+			Description: r.get("Description"),
+			Location:    r.get("Location"),
+			StartedOn:   startedOn,
+			FinishedOn:  finishedOn,
+		})
+	}
+}
+
+func parseEducations(e *Export, records []record) {
+	for _, r := range records {
+		school := r.get("School Name")
+		if school == "" {
+			e.addIssue(FileEducation, r.line, "missing school name")
+			continue
+		}
+		startDate, ok := parseDate(r.get("Start Date"))
+		if !ok {
+			e.addIssue(FileEducation, r.line, "%s: missing or unreadable start date %q", school, r.get("Start Date"))
+			continue
+		}
+		endDate, ok := parseOptionalDate(r.get("End Date"))
+		if !ok {
+			e.addIssue(FileEducation, r.line, "%s: unreadable end date %q", school, r.get("End Date"))
+			continue
+		}
+		e.Educations = append(e.Educations, Education{
+			School:    school,
+			Degree:    r.get("Degree Name"),
+			Notes:     r.get("Notes"),
+			StartDate: startDate,
+			EndDate:   endDate,
+		})
+	}
+}
+
+func parseSkills(e *Export, records []record) {
+	for _, r := range records {
+		if name := r.get("Name"); name != "" {
+			e.Skills = append(e.Skills, name)
+		}
+	}
+}
+
+func parseLanguages(e *Export, records []record) {
+	for _, r := range records {
+		name := r.get("Name")
+		if name == "" {
+			e.addIssue(FileLanguages, r.line, "missing language name")
+			continue
+		}
+		e.Languages = append(e.Languages, Language{Name: name, Proficiency: r.get("Proficiency")})
+	}
+}
+
+// parseEmailAddresses keeps the primary address, or the first one when none is marked primary.
+func parseEmailAddresses(e *Export, records []record) {
+	for _, r := range records {
+		email := r.get("Email Address")
+		if email == "" {
+			continue
+		}
+		if e.Email == "" || strings.EqualFold(r.get("Primary"), "yes") {
+			e.Email = email
+		}
+	}
+}
+
+func parsePhoneNumbers(e *Export, records []record) {
+	for _, r := range records {
+		if number := r.get("Number"); number != "" {
+			e.Phone = number
+			return
+		}
+	}
+}
+
+// dateLayouts are the formats seen in LinkedIn exports across locales and export versions.
+var dateLayouts = []string{
+	"Jan 2006",
+	"January 2006",
+	"Jan 2, 2006",
+	"2006-01-02",
+	"2006-01",
+	"01/2006",
+	"1/2006",
+	"2006",
+}
+
+// parseDate reads a LinkedIn date. Dates with only a year or month resolve to the first day.
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseOptionalDate is parseDate for end dates, where an empty value means "present".
+func parseOptionalDate(value string) (*time.Time, bool) {
+	if strings.TrimSpace(value) == "" {
+		return nil, true
+	}
+	t, ok := parseDate(value)
+	if !ok {
+		return nil, false
+	}
+	return &t, true
+}
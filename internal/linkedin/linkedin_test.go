@@ -0,0 +1,371 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type archiveFile struct {
+	name    string
+	content string
+}
+
+func buildArchive(t *testing.T, files ...archiveFile) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			t.Fatalf("create %s: %v", f.name, err)
+		}
+		if _, err := fw.Write([]byte(f.content)); err != nil {
+			t.Fatalf("write %s: %v", f.name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func monthPtr(year int, m time.Month) *time.Time {
+	t := month(year, m)
+	return &t
+}
+
+const (
+	profileCSV = "First Name,Last Name,Headline,Summary,Websites\n" +
+		"Jane,Doe,Backend Engineer,Go developer,\"[PORTFOLIO:https://jane.dev],[BLOG:http://blog.jane.dev/posts]\"\n"
+
+	positionsCSV = "Company Name,Title,Description,Location,Started On,Finished On\n" +
+		"Acme,Senior Engineer,\"Built APIs\nLed the team\",Remote,Mar 2021,\n" +
+		"Globex,Engineer,,São Paulo,Jan 2018,Feb 2021\n" +
+		",Intern,,,Jan 2017,Dec 2017\n" +
+		"Initech,Analyst,,,sometime,\n" +
+		"Umbrella,Consultant,,,2016,later\n"
+
+	educationCSV = "School Name,Start Date,End Date,Notes,Degree Name,Activities\n" +
+		"USP,2010,2014,Thesis on compilers,BSc Computer Science,\n" +
+		"Coursera,2019-05,,,,\n" +
+		",2008,2009,,High school,\n" +
+		"MIT,never,,,MSc,\n"
+
+	skillsCSV = "Name\nGo\nKubernetes\n\n  \nSQL\n"
+
+	languagesCSV = "Name,Proficiency\n" +
+		"English,Full professional proficiency\n" +
+		",Native\n" +
+		"Portuguese,\n"
+
+	emailsCSV = "Email Address,Confirmed,Primary,Updated On\n" +
+		"old@example.com,Yes,No,1/2/20\n" +
+		"jane@example.com,Yes,Yes,1/2/20\n"
+
+	phonesCSV = "Extension,Number,Type\n" +
+		",,Mobile\n" +
+		",+55 11 91234-5678,Mobile\n"
+)
+
+func TestParseFullExport(t *testing.T) {
+	data := buildArchive(t,
+		archiveFile{name: "Basic_LinkedInDataExport_10-18-2026/", content: ""},
+		archiveFile{name: "Basic_LinkedInDataExport_10-18-2026/Profile.csv", content: profileCSV},
+		archiveFile{name: "Basic_LinkedInDataExport_10-18-2026/Positions.csv", content: positionsCSV},
+		archiveFile{name: "Basic_LinkedInDataExport_10-18-2026/Education.csv", content: educationCSV},
+		archiveFile{name: "Basic_LinkedInDataExport_10-18-2026/nested/skills.csv", content: skillsCSV},
+		archiveFile{name: "Languages.csv", content: languagesCSV},
+		archiveFile{name: "Email Addresses.csv", content: emailsCSV},
+		archiveFile{name: "PhoneNumbers.csv", content: phonesCSV},
+		archiveFile{name: "Connections.csv", content: "First Name,Last Name\nJohn,Roe\n"},
+	)
+
+	export, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	wantProfile := Profile{
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Headline:  "Backend Engineer",
+		Summary:   "Go developer",
+		Websites:  []string{"https://jane.dev", "http://blog.jane.dev/posts"},
+	}
+	if !reflect.DeepEqual(export.Profile, wantProfile) {
+		t.Errorf("Profile = %+v, want %+v", export.Profile, wantProfile)
+	}
+	if got := export.Profile.FullName(); got != "Jane Doe" {
+		t.Errorf("FullName() = %q, want %q", got, "Jane Doe")
+	}
+
+	wantPositions := []Position{
+		{Company: "Acme", Title: "Senior Engineer", Description: "Built APIs\nLed the team", Location: "Remote", StartedOn: month(2021, time.March)},
+		{Company: "Globex", Title: "Engineer", Location: "São Paulo", StartedOn: month(2018, time.January), FinishedOn: monthPtr(2021, time.February)},
+	}
+	if !reflect.DeepEqual(export.Positions, wantPositions) {
+		t.Errorf("Positions = %+v, want %+v", export.Positions, wantPositions)
+	}
+
+	wantEducations := []Education{
+		{School: "USP", Degree: "BSc Computer Science", Notes: "Thesis on compilers", StartDate: month(2010, time.January), EndDate: monthPtr(2014, time.January)},
+		{School: "Coursera", StartDate: month(2019, time.May)},
+	}
+	if !reflect.DeepEqual(export.Educations, wantEducations) {
+		t.Errorf("Educations = %+v, want %+v", export.Educations, wantEducations)
+	}
+
+	if want := []string{"Go", "Kubernetes", "SQL"}; !reflect.DeepEqual(export.Skills, want) {
+		t.Errorf("Skills = %v, want %v", export.Skills, want)
+	}
+	wantLanguages := []Language{
+		{Name: "English", Proficiency: "Full professional proficiency"},
+		{Name: "Portuguese"},
+	}
+	if !reflect.DeepEqual(export.Languages, wantLanguages) {
+		t.Errorf("Languages = %+v, want %+v", export.Languages, wantLanguages)
+	}
+	if export.Email != "jane@example.com" {
+		t.Errorf("Email = %q, want %q", export.Email, "jane@example.com")
+	}
+	if export.Phone != "+55 11 91234-5678" {
+		t.Errorf("Phone = %q, want %q", export.Phone, "+55 11 91234-5678")
+	}
+
+	wantFiles := []string{FileProfile, FilePositions, FileEducation, FileSkills, FileLanguages, FileEmailAddresses, FilePhoneNumbers}
+	if !reflect.DeepEqual(export.Files, wantFiles) {
+		t.Errorf("Files = %v, want %v", export.Files, wantFiles)
+	}
+
+	// Acme's description spans lines 2 and 3, so the following rows start at line 4.
+	wantIssues := []Issue{
+		{File: FilePositions, Row: 5, Reason: "missing company name or title"},
+		{File: FilePositions, Row: 6, Reason: `Analyst at Initech: missing or unreadable start date "sometime"`},
+		{File: FilePositions, Row: 7, Reason: `Consultant at Umbrella: unreadable end date "later"`},
+		{File: FileEducation, Row: 4, Reason: "missing school name"},
+		{File: FileEducation, Row: 5, Reason: `MIT: missing or unreadable start date "never"`},
+		{File: FileLanguages, Row: 3, Reason: "missing language name"},
+	}
+	if !reflect.DeepEqual(export.Issues, wantIssues) {
+		t.Errorf("Issues = %+v, want %+v", export.Issues, wantIssues)
+	}
+}
+
+func TestParseProfileHeader(t *testing.T) {
+	const bom = "\xef\xbb\xbf"
+	const preamble = "Notes:\n\"This file, generated by LinkedIn, lists your profile\"\n\n"
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "plain", content: profileCSV},
+		{name: "byte order mark", content: bom + profileCSV},
+		{name: "preamble lines", content: preamble + profileCSV},
+		{name: "byte order mark and preamble", content: bom + preamble + profileCSV},
+		{name: "windows line endings", content: strings.ReplaceAll(profileCSV, "\n", "\r\n")},
+		{name: "padded headers", content: strings.Replace(profileCSV, "First Name,Last Name", " First Name , last name ", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := Parse(buildArchive(t, archiveFile{name: FileProfile, content: tt.content}))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := export.Profile.FullName(); got != "Jane Doe" {
+				t.Errorf("FullName() = %q, want %q", got, "Jane Doe")
+			}
+			if export.Profile.Headline != "Backend Engineer" {
+				t.Errorf("Headline = %q, want %q", export.Profile.Headline, "Backend Engineer")
+			}
+			if len(export.Issues) != 0 {
+				t.Errorf("Issues = %+v, want none", export.Issues)
+			}
+		})
+	}
+}
+
+func TestParseFileIssues(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     []archiveFile
+		wantFiles []string
+		want      []Issue
+	}{
+		{
+			name: "profile without rows",
+			files: []archiveFile{
+				{name: FileProfile, content: "First Name,Last Name\n"},
+			},
+			wantFiles: []string{FileProfile},
+			want:      []Issue{{File: FileProfile, Reason: "file has no profile row"}},
+		},
+		{
+			name: "file without header",
+			files: []archiveFile{
+				{name: FileProfile, content: profileCSV},
+				{name: FileSkills, content: "Go\nSQL\n"},
+			},
+			wantFiles: []string{FileProfile},
+			want:      []Issue{{File: FileSkills, Reason: "file has no header row"}},
+		},
+		{
+			name: "file above the size cap",
+			files: []archiveFile{
+				{name: FileProfile, content: profileCSV},
+				{name: FileSkills, content: "Name\n" + strings.Repeat("a", maxFileSize)},
+			},
+			wantFiles: []string{FileProfile},
+			want:      []Issue{{File: FileSkills, Reason: "file is larger than 5 MB"}},
+		},
+		{
+			name: "file at the size cap",
+			files: []archiveFile{
+				{name: FileProfile, content: profileCSV},
+				{name: FileSkills, content: "Name\n" + strings.Repeat("a", maxFileSize-len("Name\n")-1) + "\n"},
+			},
+			wantFiles: []string{FileProfile, FileSkills},
+			want:      nil,
+		},
+		{
+			name: "first copy of a duplicated file wins",
+			files: []archiveFile{
+				{name: "a/" + FileProfile, content: profileCSV},
+				{name: "b/" + FileProfile, content: "First Name,Last Name\n"},
+			},
+			wantFiles: []string{FileProfile},
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := Parse(buildArchive(t, tt.files...))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(export.Files, tt.wantFiles) {
+				t.Errorf("Files = %v, want %v", export.Files, tt.wantFiles)
+			}
+			if !reflect.DeepEqual(export.Issues, tt.want) {
+				t.Errorf("Issues = %+v, want %+v", export.Issues, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNotAnExport(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []archiveFile
+	}{
+		{name: "empty archive", files: nil},
+		{name: "unrelated files", files: []archiveFile{{name: "readme.txt", content: "hello"}}},
+		{name: "only optional files", files: []archiveFile{
+			{name: FileLanguages, content: languagesCSV},
+			{name: FileEmailAddresses, content: emailsCSV},
+		}},
+		{name: "only a folder named like an export file", files: []archiveFile{{name: "Profile.csv/", content: ""}}},
+		{name: "curriculum file that cannot be read", files: []archiveFile{{name: FileProfile, content: "no header here\n"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(buildArchive(t, tt.files...))
+			if !errors.Is(err, ErrNotAnExport) {
+				t.Errorf("Parse() error = %v, want %v", err, ErrNotAnExport)
+			}
+		})
+	}
+}
+
+func TestParseInvalidArchive(t *testing.T) {
+	_, err := Parse([]byte("not a zip file"))
+	if err == nil {
+		t.Fatal("Parse() error = nil, want an error")
+	}
+	if errors.Is(err, ErrNotAnExport) {
+		t.Errorf("Parse() error = %v, want an archive error", err)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Time
+		wantOK bool
+	}{
+		{value: "Mar 2021", want: month(2021, time.March), wantOK: true},
+		{value: "March 2021", want: month(2021, time.March), wantOK: true},
+		{value: "Mar 5, 2021", want: time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC), wantOK: true},
+		{value: "2021-03-05", want: time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC), wantOK: true},
+		{value: "2021-03", want: month(2021, time.March), wantOK: true},
+		{value: "03/2021", want: month(2021, time.March), wantOK: true},
+		{value: "3/2021", want: month(2021, time.March), wantOK: true},
+		{value: "2021", want: month(2021, time.January), wantOK: true},
+		{value: "  Mar 2021 ", want: month(2021, time.March), wantOK: true},
+		{value: "", wantOK: false},
+		{value: "   ", wantOK: false},
+		{value: "2021/03", wantOK: false},
+		{value: "Marzo 2021", wantOK: false},
+		{value: "13/2021", wantOK: false},
+		{value: "Present", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseDate(tt.value)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("parseDate(%q) = (%v, %v), want (%v, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseDateCoversEveryLayout(t *testing.T) {
+	reference := time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)
+	for _, layout := range dateLayouts {
+		t.Run(layout, func(t *testing.T) {
+			value := reference.Format(layout)
+			got, ok := parseDate(value)
+			if !ok {
+				t.Fatalf("parseDate(%q) failed", value)
+			}
+			if got.Year() != reference.Year() {
+				t.Errorf("parseDate(%q) = %v, want year %d", value, got, reference.Year())
+			}
+		})
+	}
+}
+
+func TestParseOptionalDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   *time.Time
+		wantOK bool
+	}{
+		{value: "", want: nil, wantOK: true},
+		{value: "  ", want: nil, wantOK: true},
+		{value: "Feb 2021", want: monthPtr(2021, time.February), wantOK: true},
+		{value: "Present", want: nil, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseOptionalDate(tt.value)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseOptionalDate(%q) = (%v, %v), want (%v, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
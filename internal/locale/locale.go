@@ -0,0 +1,94 @@
+// Package locale is the registry of languages the API supports. AI prompts, request
+// validation and user configuration all resolve languages through it, so adding a
+// language only takes a new entry here.
+package locale
+
+import (
+	"strings"
+)
+
+// Locale describes one supported language.
+type Locale struct {
+	// Tag is the BCP-47 tag stored in user configuration, e.g. "pt-BR".
+	Tag string
+	// Code is the primary language subtag accepted by the API, e.g. "pt".
+	Code string
+	// Name is the English display name.
+	Name string
+	// NativeName is the name of the language in itself.
+	NativeName string
+	// PromptName is how AI prompts refer to the language.
+	PromptName string
+}
+
+// DefaultCode is the language used when none is given or the given one is not supported.
+const DefaultCode = "en"
+
+var registry = []Locale{
+	{Tag: "pt-BR", Code: "pt", Name: "Portuguese", NativeName: "Português", PromptName: "Portuguese"},
+	{Tag: "en-US", Code: "en", Name: "English", NativeName: "English", PromptName: "English"},
+	{Tag: "es-ES", Code: "es", Name: "Spanish", NativeName: "Español", PromptName: "Spanish"},
+	{Tag: "fr-FR", Code: "fr", Name: "French", NativeName: "Français", PromptName: "French"},
+	{Tag: "de-DE", Code: "de", Name: "German", NativeName: "Deutsch", PromptName: "German"},
+	{Tag: "it-IT", Code: "it", Name: "Italian", NativeName: "Italiano", PromptName: "Italian"},
+	{Tag: "nl-NL", Code: "nl", Name: "Dutch", NativeName: "Nederlands", PromptName: "Dutch"},
+}
+
+// All returns the supported locales in registry order.
+func All() []Locale {
+	all := make([]Locale, len(registry))
+	copy(all, registry)
+	return all
+}
+
+// Codes returns the language codes accepted by the API, e.g. "pt", "en".
+func Codes() []string {
+	codes := make([]string, 0, len(registry))
+	for _, l := range registry {
+		codes = append(codes, l.Code)
+	}
+	return codes
+}
+
+// Lookup finds the locale of a language code or tag. Matching is case-insensitive, accepts
+// "_" as separator and uses the primary subtag only, so "pt", "pt-BR", "pt-pt" and "PT_br"
+// all resolve to Portuguese.
+func Lookup(value string) (Locale, bool) {
+	base, _, _ := strings.Cut(strings.ReplaceAll(strings.TrimSpace(value), "_", "-"), "-")
+	base = strings.ToLower(base)
+	for _, l := range registry {
+		if l.Code == base {
+			return l, true
+		}
+	}
+	return Locale{}, false
+}
+
+// IsSupported reports whether value is a code or tag of a supported language.
+func IsSupported(value string) bool {
+	_, ok := Lookup(value)
+	return ok
+}
+
+// Default returns the locale of DefaultCode.
+func Default() Locale {
+	l, _ := Lookup(DefaultCode)
+	return l
+}
+
+// Resolve returns the locale of value, or the default locale when value is empty or not supported.
+func Resolve(value string) Locale {
+	if l, ok := Lookup(value); ok {
+		return l
+	}
+	return Default()
+}
+
+// CodeOf returns the language code of value ("pt-BR" -> "pt"), or "" when value is empty
+// or not supported.
+func CodeOf(value string) string {
+	if l, ok := Lookup(value); ok {
+		return l.Code
+	}
+	return ""
+}
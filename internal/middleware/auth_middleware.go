@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/gin-gonic/gin"
@@ -21,26 +22,26 @@ func StaticTokenMiddleware(staticToken string) gin.HandlerFunc {
 
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "authorization header required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "")
 			return
 		}
 
 		// Check if the header starts with "Bearer "
 		if !strings.HasPrefix(authHeader, "Bearer ") {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "invalid authorization header format")
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidAuthHeader, "")
 			return
 		}
 
 		// Extract the token
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		if tokenString == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "authorization token required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "authorization token required")
 			return
 		}
 
 		// Validate the token against the static token
 		if tokenString != staticToken {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "invalid token")
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidToken, "")
 			return
 		}
 
@@ -64,12 +65,12 @@ func StaticTokenHeaderMiddleware(staticToken string) gin.HandlerFunc {
 
 		headerToken := c.GetHeader(StaticTokenHeaderName)
 		if headerToken == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "X-Static-Token header required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "X-Static-Token header required")
 			return
 		}
 
 		if headerToken != staticToken {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "invalid static token")
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidToken, "invalid static token")
 			return
 		}
 
@@ -83,18 +84,18 @@ func SessionMiddleware(sessionRepo repositories.SessionRepository) gin.HandlerFu
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "authorization header required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "")
 			return
 		}
 
 		if !strings.HasPrefix(authHeader, "Bearer ") {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "invalid authorization header format")
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidAuthHeader, "")
 			return
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		if tokenString == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "authorization token required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "authorization token required")
 			return
 		}
 
@@ -109,7 +110,7 @@ func SessionMiddleware(sessionRepo repositories.SessionRepository) gin.HandlerFu
 			return
 		}
 		if session == nil {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "invalid or expired session")
+			transporthttp.HandleCodeError(c, apperrors.CodeSessionExpired, "")
 			return
 		}
 
@@ -139,13 +140,13 @@ func AdminMiddleware(userRepo repositories.UserRepository) gin.HandlerFunc {
 
 		userIDStr := c.GetHeader("X-User-ID")
 		if userIDStr == "" {
-			transporthttp.HandleError(c, http.StatusUnauthorized, "X-User-ID header required")
+			transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "X-User-ID header required")
 			return
 		}
 
 		userID, err := uuid.Parse(userIDStr)
 		if err != nil {
-			transporthttp.HandleError(c, http.StatusBadRequest, "invalid user ID format")
+			transporthttp.HandleCodeError(c, apperrors.CodeInvalidUserID, "")
 			return
 		}
 
@@ -156,12 +157,12 @@ func AdminMiddleware(userRepo repositories.UserRepository) gin.HandlerFunc {
 func ensureAdminOrAbort(c *gin.Context, userRepo repositories.UserRepository, userID uuid.UUID) {
 	user, err := userRepo.GetByID(c.Request.Context(), userID)
 	if err != nil || user == nil {
-		transporthttp.HandleError(c, http.StatusNotFound, "user not found")
+		transporthttp.HandleCodeError(c, apperrors.CodeUserNotFound, "")
 		return
 	}
 
 	if !user.Admin {
-		transporthttp.HandleError(c, http.StatusForbidden, "admin access required")
+		transporthttp.HandleCodeError(c, apperrors.CodeAdminRequired, "")
 		return
 	}
 
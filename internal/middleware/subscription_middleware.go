@@ -1,23 +1,52 @@
 package middleware
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
+// quotaWarningPercent is the share of the monthly AI quota after which the user is emailed.
+const quotaWarningPercent = 80
+
 func RequireSubscriptionPlan(
 	subscriptionUseCase usecases.SubscriptionUseCase,
 	redisClient *redis.Client,
 	quotaByPlan map[models.SubscriptionPlan]config.PlanQuota,
+) gin.HandlerFunc {
+	return requireSubscriptionPlan(subscriptionUseCase, redisClient, quotaByPlan, false)
+}
+
+// RequireSubscriptionPlanWithAlternatives is RequireSubscriptionPlan for the generators that
+// return several alternatives. It also rejects requests asking for more alternatives than the
+// plan allows. Routes that ignore the "alternatives" field must not use it.
+func RequireSubscriptionPlanWithAlternatives(
+	subscriptionUseCase usecases.SubscriptionUseCase,
+	redisClient *redis.Client,
+	quotaByPlan map[models.SubscriptionPlan]config.PlanQuota,
+) gin.HandlerFunc {
+	return requireSubscriptionPlan(subscriptionUseCase, redisClient, quotaByPlan, true)
+}
+
+func requireSubscriptionPlan(
+	subscriptionUseCase usecases.SubscriptionUseCase,
+	redisClient *redis.Client,
+	quotaByPlan map[models.SubscriptionPlan]config.PlanQuota,
+	checkAlternatives bool,
 ) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID, ok := parseUserIDHeader(c)
@@ -25,11 +54,12 @@ func RequireSubscriptionPlan(
 			return
 		}
 
-		plan, err := subscriptionUseCase.GetEntitlement(c.Request.Context(), userID)
+		entitlement, err := subscriptionUseCase.ResolveEntitlement(c.Request.Context(), userID)
 		if err != nil {
 			transporthttp.HandleError(c, http.StatusInternalServerError, err.Error())
 			return
 		}
+		plan := entitlement.Plan
 
 		quota, hasQuota := quotaByPlan[plan]
 		if !hasQuota {
@@ -37,6 +67,13 @@ func RequireSubscriptionPlan(
 			return
 		}
 
+		// Checked before the usage counter or a bonus request is charged, so a rejected
+		// request costs nothing.
+		if maxAlternatives := max(quota.MaxAlternatives, 1); checkAlternatives && requestedAIAlternatives(c) > maxAlternatives {
+			transporthttp.HandleCodeErrorf(c, apperrors.CodeAlternativesLimit, "your plan allows up to %d alternatives per generation", maxAlternatives)
+			return
+		}
+
 		if quota.MonthlyRequests < 0 {
 			c.Next()
 			return
@@ -47,7 +84,15 @@ func RequireSubscriptionPlan(
 			return
 		}
 
-		key := buildMonthlyUsageKey(userID, "ai_requests")
+		// Organization plans pool quota x seats across all members under one counter.
+		limit := quota.MonthlyRequests
+		usageScope := userID.String()
+		if entitlement.OrganizationID != nil {
+			limit *= int64(max(entitlement.Seats, 1))
+			usageScope = "org:" + entitlement.OrganizationID.String()
+		}
+
+		key := buildMonthlyUsageKey(usageScope, "ai_requests")
 		count, err := redisClient.Incr(c.Request.Context(), key).Result()
 		if err != nil {
 			transporthttp.HandleError(c, http.StatusInternalServerError, fmt.Sprintf("increment usage counter: %v", err))
@@ -60,15 +105,63 @@ func RequireSubscriptionPlan(
 			return
 		}
 
-		if quota.MonthlyRequests == 0 || count > quota.MonthlyRequests {
-			transporthttp.HandleError(c, http.StatusPaymentRequired, "plan limit exceeded")
-			return
+		if warnAt := quotaWarningThreshold(limit); count == warnAt {
+			// INCR hands out each count once, so only one request a month crosses the threshold.
+			ctx := context.WithoutCancel(c.Request.Context())
+			go func() {
+				_ = subscriptionUseCase.NotifyQuotaNearlyExhausted(ctx, userID, plan, count, limit, expireAt)
+			}()
+		}
+
+		if limit == 0 || count > limit {
+			// Plan quota exhausted: fall back to bonus requests earned through referrals.
+			consumed, err := subscriptionUseCase.ConsumeBonusAIRequest(c.Request.Context(), userID)
+			if err != nil {
+				transporthttp.HandleError(c, http.StatusInternalServerError, fmt.Sprintf("consume bonus request: %v", err))
+				return
+			}
+			if !consumed {
+				transporthttp.HandleCodeError(c, apperrors.CodeQuotaExceeded, "")
+				return
+			}
 		}
 
 		c.Next()
 	}
 }
 
+// requestedAIAlternatives returns the "alternatives" member of a JSON request body, or 0 when
+// it is absent or unreadable (the handler then reports the body as invalid). The body is
+// restored so the handler can still bind it.
+func requestedAIAlternatives(c *gin.Context) int {
+	if c.Request.Body == nil || c.ContentType() != binding.MIMEJSON {
+		return 0
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0
+	}
+
+	var req struct {
+		Alternatives int `json:"alternatives"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return 0
+	}
+	return req.Alternatives
+}
+
+// quotaWarningThreshold returns the usage count that triggers the quota warning, or 0 when
+// the limit is too small for a warning before it is exhausted.
+func quotaWarningThreshold(limit int64) int64 {
+	threshold := (limit*quotaWarningPercent + 99) / 100
+	if threshold >= limit {
+		return 0
+	}
+	return threshold
+}
+
 func parseUserIDHeader(c *gin.Context) (uuid.UUID, bool) {
 	if ctxUserID, ok := c.Get("user_id"); ok {
 		if userID, ok := ctxUserID.(uuid.UUID); ok {
@@ -80,22 +173,22 @@ func parseUserIDHeader(c *gin.Context) (uuid.UUID, bool) {
 
 	userIDStr := c.GetHeader("X-User-ID")
 	if userIDStr == "" {
-		transporthttp.HandleError(c, http.StatusUnauthorized, "user not authenticated")
+		transporthttp.HandleCodeError(c, apperrors.CodeAuthRequired, "user not authenticated")
 		return uuid.Nil, false
 	}
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		transporthttp.HandleError(c, http.StatusBadRequest, "invalid user ID format")
+		transporthttp.HandleCodeError(c, apperrors.CodeInvalidUserID, "")
 		return uuid.Nil, false
 	}
 
 	return userID, true
 }
 
-func buildMonthlyUsageKey(userID uuid.UUID, feature string) string {
+func buildMonthlyUsageKey(scope string, feature string) string {
 	now := time.Now().UTC()
-	return fmt.Sprintf("usage:%s:%04d-%02d:%s", userID.String(), now.Year(), int(now.Month()), feature)
+	return fmt.Sprintf("usage:%s:%04d-%02d:%s", scope, now.Year(), int(now.Month()), feature)
 }
 
 func firstMomentOfNextMonth(t time.Time) time.Time {
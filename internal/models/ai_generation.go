@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AIGenerationFeature names the generator that produced an AIGeneration.
+type AIGenerationFeature string
+
+const (
+	AIGenerationFeatureIntro    AIGenerationFeature = "intro"
+	AIGenerationFeatureSkill    AIGenerationFeature = "skill"
+	AIGenerationFeatureTask     AIGenerationFeature = "task"
+	AIGenerationFeatureCourses  AIGenerationFeature = "courses"
+	AIGenerationFeatureAcademic AIGenerationFeature = "academic"
+)
+
+// AIGeneration records one call to an AI generator together with the alternatives it
+// returned, so the user's choice among them can be analysed per prompt.
+// ChosenPosition and FeedbackAt stay nil until the user sends feedback.
+type AIGeneration struct {
+	gorm.Model
+	ID              uuid.UUID                 `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:ai_generations"`
+	UserID          uuid.UUID                 `json:"user_id" gorm:"type:char(36);not null;index"`
+	Feature         AIGenerationFeature       `json:"feature" gorm:"size:30;not null;index"`
+	Input           string                    `json:"input" gorm:"type:text;not null"`
+	ChosenPosition  *int                      `json:"chosen_position,omitempty"`
+	FeedbackComment string                    `json:"feedback_comment,omitempty" gorm:"type:text"`
+	FeedbackAt      *time.Time                `json:"feedback_at,omitempty"`
+	Alternatives    []AIGenerationAlternative `json:"alternatives" gorm:"foreignKey:GenerationID"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (g *AIGeneration) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == uuid.Nil {
+		g.ID = uuid.New()
+	}
+	return nil
+}
+
+// AIGenerationAlternative is one of the variants returned by a generation.
+// Position is zero-based and matches the order of the API response.
+type AIGenerationAlternative struct {
+	gorm.Model
+	ID           uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:ai_generation_alternatives"`
+	GenerationID uuid.UUID `json:"generation_id" gorm:"type:char(36);not null;index"`
+	Position     int       `json:"position" gorm:"not null;default:0"`
+	Content      string    `json:"content" gorm:"type:text;not null"`
+	Rejected     bool      `json:"rejected" gorm:"not null;default:false"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (a *AIGenerationAlternative) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ApplicationStatus is a stage of the job application pipeline.
+type ApplicationStatus string
+
+const (
+	ApplicationStatusWishlist     ApplicationStatus = "wishlist"
+	ApplicationStatusApplied      ApplicationStatus = "applied"
+	ApplicationStatusScreening    ApplicationStatus = "screening"
+	ApplicationStatusInterviewing ApplicationStatus = "interviewing"
+	ApplicationStatusOffer        ApplicationStatus = "offer"
+	ApplicationStatusAccepted     ApplicationStatus = "accepted"
+	ApplicationStatusRejected     ApplicationStatus = "rejected"
+	ApplicationStatusWithdrawn    ApplicationStatus = "withdrawn"
+)
+
+// ApplicationStatuses lists the pipeline stages in order.
+var ApplicationStatuses = []ApplicationStatus{
+	ApplicationStatusWishlist,
+	ApplicationStatusApplied,
+	ApplicationStatusScreening,
+	ApplicationStatusInterviewing,
+	ApplicationStatusOffer,
+	ApplicationStatusAccepted,
+	ApplicationStatusRejected,
+	ApplicationStatusWithdrawn,
+}
+
+func (s ApplicationStatus) IsValid() bool {
+	for _, status := range ApplicationStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClosed reports whether the application reached a final outcome.
+func (s ApplicationStatus) IsClosed() bool {
+	return s == ApplicationStatusAccepted || s == ApplicationStatusRejected || s == ApplicationStatusWithdrawn
+}
+
+// Application is a job the user applied to (or plans to), tracked through the pipeline.
+// FollowUpReminderSentAt is cleared whenever FollowUpAt changes so each date is reminded once.
+type Application struct {
+	gorm.Model
+	ID                     uuid.UUID            `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:applications"`
+	UserID                 uuid.UUID            `json:"user_id" gorm:"type:char(36);not null;index"`
+	CurriculumID           *uuid.UUID           `json:"curriculum_id,omitempty" gorm:"type:char(36);index"`
+	Company                string               `json:"company" gorm:"size:255;not null"`
+	Position               string               `json:"position" gorm:"size:255;not null"`
+	JobURL                 string               `json:"job_url,omitempty" gorm:"size:2048"`
+	Location               string               `json:"location,omitempty" gorm:"size:255"`
+	Status                 ApplicationStatus    `json:"status" gorm:"size:20;not null;default:'applied';index"`
+	AppliedAt              *time.Time           `json:"applied_at,omitempty"`
+	StatusChangedAt        time.Time            `json:"status_changed_at" gorm:"not null"`
+	FollowUpAt             *time.Time           `json:"follow_up_at,omitempty" gorm:"index"`
+	FollowUpReminderSentAt *time.Time           `json:"follow_up_reminder_sent_at,omitempty"`
+	Notes                  string               `json:"notes,omitempty" gorm:"type:text"`
+	Contacts               []ApplicationContact `json:"contacts" gorm:"foreignKey:ApplicationID"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (a *Application) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ApplicationContact is a recruiter or interviewer related to an application.
+type ApplicationContact struct {
+	gorm.Model
+	ID            uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:application_contacts"`
+	ApplicationID uuid.UUID `json:"application_id" gorm:"type:char(36);not null;index"`
+	Name          string    `json:"name" gorm:"size:255;not null"`
+	Role          string    `json:"role,omitempty" gorm:"size:255"`
+	Email         string    `json:"email,omitempty" gorm:"size:255"`
+	Phone         string    `json:"phone,omitempty" gorm:"size:50"`
+	LinkedInURL   string    `json:"linkedin_url,omitempty" gorm:"size:512"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (c *ApplicationContact) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// ApplicationStatusChange records one move of an application through the pipeline.
+// FromStatus is empty for the status the application was created with.
+type ApplicationStatusChange struct {
+	gorm.Model
+	ID            uuid.UUID         `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:application_status_changes"`
+	ApplicationID uuid.UUID         `json:"application_id" gorm:"type:char(36);not null;index"`
+	FromStatus    ApplicationStatus `json:"from_status,omitempty" gorm:"size:20"`
+	ToStatus      ApplicationStatus `json:"to_status" gorm:"size:20;not null"`
+	Note          string            `json:"note,omitempty" gorm:"type:text"`
+	ChangedAt     time.Time         `json:"changed_at" gorm:"not null"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (c *ApplicationStatusChange) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ClientProfile is a person whose curriculums are managed by a consultant.
+// The client reviews shared curriculums by signing in with the profile's email.
+type ClientProfile struct {
+	gorm.Model
+	ID               uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:client_profiles"`
+	ConsultantUserID uuid.UUID `json:"consultant_user_id" gorm:"type:char(36);not null;index"`
+	Name             string    `json:"name" gorm:"size:255;not null"`
+	Email            string    `json:"email" gorm:"size:255;not null;index"`
+	Notes            string    `json:"notes,omitempty" gorm:"type:text"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (c *ClientProfile) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
@@ -1,17 +1,24 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Configuration struct {
 	gorm.Model
-	ID         uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:configuration"`
-	UserID     uuid.UUID `json:"user_id" gorm:"type:char(36);not null;uniqueIndex"`
-	User       User      `json:"user,omitempty" gorm:"foreignKey:UserID"`
-	Language   string    `json:"language" gorm:"size:10;not null;default:'en-us'"`
-	Newsletter bool      `json:"newsletter" gorm:"not null;default:false"`
+	ID       uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:configuration"`
+	UserID   uuid.UUID `json:"user_id" gorm:"type:char(36);not null;uniqueIndex"`
+	User     User      `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	Language string    `json:"language" gorm:"size:10;not null;default:'en-US'"`
+	// Newsletter is the user's opt-in; it only counts once confirmed by email (double opt-in).
+	// NewsletterRequestedAt identifies the pending confirmation link.
+	Newsletter               bool       `json:"newsletter" gorm:"not null;default:false"`
+	NewsletterRequestedAt    *time.Time `json:"newsletter_requested_at,omitempty"`
+	NewsletterConfirmedAt    *time.Time `json:"newsletter_confirmed_at,omitempty"`
+	NewsletterUnsubscribedAt *time.Time `json:"newsletter_unsubscribed_at,omitempty"`
 }
 
 // BeforeCreate will set a UUID rather than numeric ID
@@ -21,3 +28,15 @@ func (c *Configuration) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// NewsletterStatus returns the state of the user's newsletter subscription
+func (c *Configuration) NewsletterStatus() NewsletterStatus {
+	switch {
+	case c.Newsletter && c.NewsletterConfirmedAt != nil:
+		return NewsletterStatusSubscribed
+	case c.Newsletter:
+		return NewsletterStatusPending
+	default:
+		return NewsletterStatusUnsubscribed
+	}
+}
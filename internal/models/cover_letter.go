@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CoverLetter is a cover letter generated from a curriculum for a job posting.
+// Content starts as the AI draft and is edited by the user afterwards.
+type CoverLetter struct {
+	gorm.Model
+	ID             uuid.UUID  `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:cover_letters"`
+	UserID         uuid.UUID  `json:"user_id" gorm:"type:char(36);not null;index"`
+	CurriculumID   uuid.UUID  `json:"curriculum_id" gorm:"type:char(36);not null;index"`
+	ApplicationID  *uuid.UUID `json:"application_id,omitempty" gorm:"type:char(36);index"`
+	Title          string     `json:"title" gorm:"size:255;not null"`
+	Company        string     `json:"company,omitempty" gorm:"size:255"`
+	Position       string     `json:"position,omitempty" gorm:"size:255"`
+	JobDescription string     `json:"job_description" gorm:"type:text;not null"`
+	Tone           string     `json:"tone" gorm:"size:20;not null"`
+	Language       string     `json:"language,omitempty" gorm:"size:5"`
+	Content        string     `json:"content" gorm:"type:text;not null"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (l *CoverLetter) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
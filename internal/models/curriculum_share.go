@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CurriculumShareLink is a public, unauthenticated link to a curriculum served at /p/:slug.
+// PasswordHash is a bcrypt hash and is nil for links without a password.
+type CurriculumShareLink struct {
+	gorm.Model
+	ID           uuid.UUID    `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:curriculum_share_links"`
+	CurriculumID uuid.UUID    `json:"curriculum_id" gorm:"type:char(36);not null;index"`
+	UserID       uuid.UUID    `json:"user_id" gorm:"type:char(36);not null;index"`
+	Slug         string       `json:"slug" gorm:"size:32;not null;uniqueIndex"`
+	Label        string       `json:"label,omitempty" gorm:"size:255"`
+	PasswordHash *string      `json:"-" gorm:"size:255"`
+	ExpiresAt    *time.Time   `json:"expires_at,omitempty"`
+	RevokedAt    *time.Time   `json:"revoked_at,omitempty"`
+	ViewCount    int64        `json:"view_count" gorm:"not null;default:0"`
+	LastViewedAt *time.Time   `json:"last_viewed_at,omitempty"`
+	Curriculum   *Curriculums `json:"-" gorm:"foreignKey:CurriculumID"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (l *CurriculumShareLink) BeforeCreate(tx *gorm.DB) error {
+	if l.ID == uuid.Nil {
+		l.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the link can still be opened at the given time.
+func (l *CurriculumShareLink) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	return l.ExpiresAt == nil || now.Before(*l.ExpiresAt)
+}
+
+// HasPassword reports whether viewers must provide a password.
+func (l *CurriculumShareLink) HasPassword() bool {
+	return l.PasswordHash != nil && *l.PasswordHash != ""
+}
+
+// CurriculumShareView records one successful open of a share link.
+// Country is an ISO 3166-1 alpha-2 code resolved from the viewer's IP, empty when unknown.
+type CurriculumShareView struct {
+	gorm.Model
+	ID           uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:curriculum_share_views"`
+	ShareLinkID  uuid.UUID `json:"share_link_id" gorm:"type:char(36);not null;index:idx_share_views_link_viewed,priority:1"`
+	CurriculumID uuid.UUID `json:"curriculum_id" gorm:"type:char(36);not null;index"`
+	ViewedAt     time.Time `json:"viewed_at" gorm:"not null;index:idx_share_views_link_viewed,priority:2"`
+	Referrer     string    `json:"referrer,omitempty" gorm:"size:2048"`
+	Country      string    `json:"country,omitempty" gorm:"size:2"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (v *CurriculumShareView) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
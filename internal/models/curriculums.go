@@ -1,10 +1,24 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+type CurriculumStatus string
+
+const (
+	CurriculumStatusDraft    CurriculumStatus = "draft"
+	CurriculumStatusInReview CurriculumStatus = "in_review"
+	CurriculumStatusApproved CurriculumStatus = "approved"
+)
+
+func (s CurriculumStatus) IsValid() bool {
+	return s == CurriculumStatusDraft || s == CurriculumStatusInReview || s == CurriculumStatusApproved
+}
+
 type Curriculums struct {
 	ID uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:curriculums"`
 	gorm.Model
@@ -22,6 +36,19 @@ type Curriculums struct {
 	Educations    []Education `json:"educations" gorm:"foreignKey:CurriculumID"`
 	UserID        uuid.UUID   `json:"user_id" gorm:"type:char(36);not null;index" validate:"required"`
 	User          User        `json:"-" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;foreignKey:UserID;references:ID"`
+
+	// Consultant workspace: UserID is the consultant managing the curriculum on
+	// behalf of the client profile, who reviews it while Status is in_review.
+	ClientProfileID *uuid.UUID       `json:"client_profile_id,omitempty" gorm:"type:char(36);index"`
+	ClientProfile   *ClientProfile   `json:"-" gorm:"foreignKey:ClientProfileID;references:ID"`
+	Status          CurriculumStatus `json:"status" gorm:"size:20;not null;default:'draft';index"`
+	ReviewComment   string           `json:"review_comment,omitempty" gorm:"type:text"`
+	StatusChangedAt *time.Time       `json:"status_changed_at,omitempty"`
+
+	// Variants: duplicated or AI-tailored copies point at the curriculum they were made from.
+	// JobDescription holds the job posting a tailored variant was written for.
+	SourceCurriculumID *uuid.UUID `json:"source_curriculum_id,omitempty" gorm:"type:char(36);index"`
+	JobDescription     string     `json:"job_description,omitempty" gorm:"type:text"`
 }
 
 // BeforeCreate will set a UUID rather than numeric ID
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InterviewQuestionCategory separates behavioral from technical interview questions.
+type InterviewQuestionCategory string
+
+const (
+	InterviewQuestionBehavioral InterviewQuestionCategory = "behavioral"
+	InterviewQuestionTechnical  InterviewQuestionCategory = "technical"
+)
+
+func (c InterviewQuestionCategory) IsValid() bool {
+	return c == InterviewQuestionBehavioral || c == InterviewQuestionTechnical
+}
+
+// InterviewPracticeSet is a set of likely interview questions generated from a curriculum
+// for a job posting, kept so the user can practice and take notes.
+type InterviewPracticeSet struct {
+	gorm.Model
+	ID             uuid.UUID           `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:interview_practice_sets"`
+	UserID         uuid.UUID           `json:"user_id" gorm:"type:char(36);not null;index"`
+	CurriculumID   uuid.UUID           `json:"curriculum_id" gorm:"type:char(36);not null;index"`
+	ApplicationID  *uuid.UUID          `json:"application_id,omitempty" gorm:"type:char(36);index"`
+	Title          string              `json:"title" gorm:"size:255;not null"`
+	Company        string              `json:"company,omitempty" gorm:"size:255"`
+	Position       string              `json:"position,omitempty" gorm:"size:255"`
+	JobDescription string              `json:"job_description" gorm:"type:text;not null"`
+	Language       string              `json:"language,omitempty" gorm:"size:5"`
+	Questions      []InterviewQuestion `json:"questions" gorm:"foreignKey:PracticeSetID"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (s *InterviewPracticeSet) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// InterviewQuestion is one practice question with a suggested STAR answer.
+// WorkID points at the experience the answer draws on. Notes are written by the user
+// while practicing.
+type InterviewQuestion struct {
+	gorm.Model
+	ID            uuid.UUID                 `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:interview_questions"`
+	PracticeSetID uuid.UUID                 `json:"practice_set_id" gorm:"type:char(36);not null;index"`
+	SortOrder     int                       `json:"sort_order" gorm:"not null;default:0"`
+	Category      InterviewQuestionCategory `json:"category" gorm:"size:20;not null"`
+	Question      string                    `json:"question" gorm:"type:text;not null"`
+	WorkID        *uuid.UUID                `json:"work_id,omitempty" gorm:"type:char(36)"`
+	Situation     string                    `json:"situation" gorm:"type:text"`
+	Task          string                    `json:"task" gorm:"type:text"`
+	Action        string                    `json:"action" gorm:"type:text"`
+	Result        string                    `json:"result" gorm:"type:text"`
+	Notes         string                    `json:"notes,omitempty" gorm:"type:text"`
+	Practiced     bool                      `json:"practiced" gorm:"not null;default:false"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (q *InterviewQuestion) BeforeCreate(tx *gorm.DB) error {
+	if q.ID == uuid.Nil {
+		q.ID = uuid.New()
+	}
+	return nil
+}
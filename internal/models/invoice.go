@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InvoiceStatusPaid is the Stripe status of a paid invoice; it is final.
+const InvoiceStatusPaid = "paid"
+
+// Invoice is a local copy of a Stripe invoice, written from invoice webhooks so
+// the payment history can be served without calling Stripe.
+type Invoice struct {
+	gorm.Model
+	ID                   uuid.UUID  `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:invoices"`
+	UserID               uuid.UUID  `json:"user_id" gorm:"type:char(36);not null;index"`
+	StripeInvoiceID      string     `json:"stripe_invoice_id" gorm:"size:255;not null;uniqueIndex"`
+	StripeCustomerID     string     `json:"stripe_customer_id" gorm:"size:255;not null;index"`
+	StripeSubscriptionID *string    `json:"stripe_subscription_id,omitempty" gorm:"size:255;index"`
+	Number               string     `json:"number,omitempty" gorm:"size:100"`
+	Status               string     `json:"status" gorm:"size:20;not null"`
+	Currency             string     `json:"currency" gorm:"size:3;not null"`
+	AmountDue            int64      `json:"amount_due" gorm:"not null;default:0"`
+	AmountPaid           int64      `json:"amount_paid" gorm:"not null;default:0"`
+	Total                int64      `json:"total" gorm:"not null;default:0"`
+	HostedInvoiceURL     string     `json:"hosted_invoice_url,omitempty" gorm:"size:1024"`
+	InvoicePDF           string     `json:"invoice_pdf,omitempty" gorm:"size:1024"`
+	PeriodStart          *time.Time `json:"period_start,omitempty"`
+	PeriodEnd            *time.Time `json:"period_end,omitempty"`
+	IssuedAt             time.Time  `json:"issued_at" gorm:"not null;index"`
+	PaidAt               *time.Time `json:"paid_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (i *Invoice) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NewsletterStatus is the state of a user's newsletter subscription.
+type NewsletterStatus string
+
+const (
+	NewsletterStatusUnsubscribed NewsletterStatus = "unsubscribed"
+	// NewsletterStatusPending waits for the user to confirm the subscription by email.
+	NewsletterStatusPending    NewsletterStatus = "pending"
+	NewsletterStatusSubscribed NewsletterStatus = "subscribed"
+)
+
+// NewsletterCampaignStatus is the sending state of a NewsletterCampaign.
+type NewsletterCampaignStatus string
+
+const (
+	NewsletterCampaignStatusDraft NewsletterCampaignStatus = "draft"
+	// NewsletterCampaignStatusSending campaigns are sent in batches by the email jobs.
+	NewsletterCampaignStatusSending NewsletterCampaignStatus = "sending"
+	NewsletterCampaignStatusSent    NewsletterCampaignStatus = "sent"
+)
+
+// NewsletterCampaign is a newsletter email composed by an admin. It goes to every confirmed
+// subscriber, or only to those whose configured language is Language when it is set.
+type NewsletterCampaign struct {
+	gorm.Model
+	ID          uuid.UUID                `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:newsletter_campaigns"`
+	Subject     string                   `json:"subject" gorm:"size:255;not null"`
+	HTMLBody    string                   `json:"html_body" gorm:"type:mediumtext;not null"`
+	TextBody    string                   `json:"text_body,omitempty" gorm:"type:mediumtext"`
+	Language    string                   `json:"language,omitempty" gorm:"size:10"`
+	Status      NewsletterCampaignStatus `json:"status" gorm:"size:20;not null;default:'draft';index"`
+	CreatedByID uuid.UUID                `json:"created_by_id" gorm:"type:char(36);not null;index"`
+	StartedAt   *time.Time               `json:"started_at,omitempty"`
+	CompletedAt *time.Time               `json:"completed_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (c *NewsletterCampaign) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// NewsletterDeliveryStatus is the state of one campaign email.
+type NewsletterDeliveryStatus string
+
+const (
+	// NewsletterDeliveryStatusPending deliveries are being sent.
+	NewsletterDeliveryStatusPending NewsletterDeliveryStatus = "pending"
+	// NewsletterDeliveryStatusQueued emails were rejected on the first attempt and are retried
+	// by the email outbox; they become sent or failed with their outbox email.
+	NewsletterDeliveryStatusQueued NewsletterDeliveryStatus = "queued"
+	// NewsletterDeliveryStatusSent emails were accepted by the provider.
+	NewsletterDeliveryStatusSent   NewsletterDeliveryStatus = "sent"
+	NewsletterDeliveryStatusFailed NewsletterDeliveryStatus = "failed"
+)
+
+// NewsletterDelivery records a campaign email to one user. There is at most one per campaign
+// and user, so a campaign never mails a user twice. UnsubscribedAt is set when the user
+// unsubscribed through the link of this email. OutboxEmailID links a queued email to the
+// outbox message retrying it.
+type NewsletterDelivery struct {
+	gorm.Model
+	ID             uuid.UUID                `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:newsletter_deliveries"`
+	CampaignID     uuid.UUID                `json:"campaign_id" gorm:"type:char(36);not null;uniqueIndex:idx_newsletter_delivery_recipient"`
+	UserID         uuid.UUID                `json:"user_id" gorm:"type:char(36);not null;uniqueIndex:idx_newsletter_delivery_recipient;index"`
+	Status         NewsletterDeliveryStatus `json:"status" gorm:"size:20;not null;default:'pending';index"`
+	Error          string                   `json:"error,omitempty" gorm:"type:text"`
+	OutboxEmailID  *uuid.UUID               `json:"outbox_email_id,omitempty" gorm:"type:char(36);index"`
+	SentAt         *time.Time               `json:"sent_at,omitempty"`
+	UnsubscribedAt *time.Time               `json:"unsubscribed_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (d *NewsletterDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrganizationRole string
+
+const (
+	// OrganizationRoleOwner created the organization and manages its billing.
+	OrganizationRoleOwner OrganizationRole = "owner"
+	// OrganizationRoleAdmin manages members and invitations.
+	OrganizationRoleAdmin OrganizationRole = "admin"
+	// OrganizationRoleMember uses the organization's plan.
+	OrganizationRoleMember OrganizationRole = "member"
+)
+
+func (r OrganizationRole) IsValid() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleAdmin || r == OrganizationRoleMember
+}
+
+// CanManageMembers reports whether the role may invite, remove and change members.
+func (r OrganizationRole) CanManageMembers() bool {
+	return r == OrganizationRoleOwner || r == OrganizationRoleAdmin
+}
+
+// Organization groups users under one seat-based subscription. The plan quota
+// is multiplied by Seats and pooled across all members.
+type Organization struct {
+	gorm.Model
+	ID                   uuid.UUID          `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:organizations"`
+	Name                 string             `json:"name" gorm:"size:255;not null"`
+	OwnerUserID          uuid.UUID          `json:"owner_user_id" gorm:"type:char(36);not null;index"`
+	Plan                 SubscriptionPlan   `json:"plan" gorm:"size:20;not null;default:'free';index"`
+	Status               SubscriptionStatus `json:"status" gorm:"size:40;not null;default:'incomplete';index"`
+	Seats                int                `json:"seats" gorm:"not null;default:1"`
+	StripeCustomerID     *string            `json:"stripe_customer_id,omitempty" gorm:"size:255;index"`
+	StripeSubscriptionID *string            `json:"stripe_subscription_id,omitempty" gorm:"size:255;uniqueIndex"`
+	BillingInterval      string             `json:"billing_interval,omitempty" gorm:"size:10"`
+	Currency             string             `json:"currency,omitempty" gorm:"size:3"`
+	CurrentPeriodEnd     *time.Time         `json:"current_period_end,omitempty"`
+	CancelAtPeriodEnd    bool               `json:"cancel_at_period_end" gorm:"not null;default:false"`
+	CanceledAt           *time.Time         `json:"canceled_at,omitempty"`
+	PastDueSince         *time.Time         `json:"past_due_since,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (o *Organization) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
+
+// OrganizationMember links a user to an organization. A user belongs to at most
+// one organization, which keeps entitlement resolution unambiguous.
+type OrganizationMember struct {
+	gorm.Model
+	ID             uuid.UUID        `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:organization_members"`
+	OrganizationID uuid.UUID        `json:"organization_id" gorm:"type:char(36);not null;index"`
+	UserID         uuid.UUID        `json:"user_id" gorm:"type:char(36);not null;uniqueIndex"`
+	Role           OrganizationRole `json:"role" gorm:"size:20;not null"`
+	Organization   Organization     `json:"-" gorm:"foreignKey:OrganizationID;references:ID"`
+	User           User             `json:"-" gorm:"foreignKey:UserID;references:ID"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (m *OrganizationMember) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// OrganizationInvitation is a pending seat offered to an email address. Only the
+// SHA-256 hash of the token is stored; the plain token is returned once on creation.
+type OrganizationInvitation struct {
+	gorm.Model
+	ID              uuid.UUID        `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:organization_invitations"`
+	OrganizationID  uuid.UUID        `json:"organization_id" gorm:"type:char(36);not null;index"`
+	Email           string           `json:"email" gorm:"size:255;not null;index"`
+	Role            OrganizationRole `json:"role" gorm:"size:20;not null"`
+	TokenHash       string           `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	InvitedByUserID uuid.UUID        `json:"invited_by_user_id" gorm:"type:char(36);not null"`
+	ExpiresAt       time.Time        `json:"expires_at" gorm:"not null;index"`
+	AcceptedAt      *time.Time       `json:"accepted_at,omitempty"`
+	RevokedAt       *time.Time       `json:"revoked_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (i *OrganizationInvitation) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsPending reports whether the invitation can still be accepted.
+func (i *OrganizationInvitation) IsPending(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
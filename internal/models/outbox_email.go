@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OutboxEmailStatus is the delivery state of an OutboxEmail.
+type OutboxEmailStatus string
+
+const (
+	// OutboxEmailStatusPending messages are retried when NextAttemptAt is due.
+	OutboxEmailStatusPending OutboxEmailStatus = "pending"
+	OutboxEmailStatusSent    OutboxEmailStatus = "sent"
+	// OutboxEmailStatusFailed messages used all their attempts and are no longer retried.
+	OutboxEmailStatusFailed OutboxEmailStatus = "failed"
+)
+
+// OutboxEmail is a rendered email whose delivery failed, kept so it is retried instead of lost.
+// Attempts counts the deliveries tried so far, including the first one. The content (HTML,
+// Text and Headers) is cleared once the message is sent or given up.
+type OutboxEmail struct {
+	gorm.Model
+	ID        uuid.UUID `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:outbox_emails"`
+	Template  string    `json:"template" gorm:"size:50;not null;index"`
+	Provider  string    `json:"provider" gorm:"size:20;not null"`
+	Sender    string    `json:"sender" gorm:"size:255;not null"`
+	Recipient string    `json:"recipient" gorm:"size:255;not null;index"`
+	Subject   string    `json:"subject" gorm:"size:998;not null"`
+	HTML      string    `json:"html" gorm:"type:mediumtext"`
+	Text      string    `json:"text" gorm:"type:mediumtext"`
+	// Headers holds the extra message headers as a JSON object.
+	Headers       string            `json:"headers,omitempty" gorm:"type:text"`
+	Status        OutboxEmailStatus `json:"status" gorm:"size:20;not null;default:'pending';index:idx_outbox_emails_due"`
+	Attempts      int               `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt time.Time         `json:"next_attempt_at" gorm:"not null;index:idx_outbox_emails_due"`
+	LastError     string            `json:"last_error,omitempty" gorm:"type:text"`
+	SentAt        *time.Time        `json:"sent_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (e *OutboxEmail) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PlanPrice maps a Stripe price to a plan, billing interval and currency.
+// Rows here take precedence over the STRIPE_PRICE_ID_* environment catalog,
+// so prices can be rotated without a redeploy.
+type PlanPrice struct {
+	gorm.Model
+	ID            uuid.UUID        `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:plan_prices"`
+	Plan          SubscriptionPlan `json:"plan" gorm:"size:20;not null;index:idx_plan_price_lookup"`
+	Interval      string           `json:"interval" gorm:"size:10;not null;index:idx_plan_price_lookup"`
+	Currency      string           `json:"currency" gorm:"size:3;not null;index:idx_plan_price_lookup"`
+	StripePriceID string           `json:"stripe_price_id" gorm:"size:255;not null;uniqueIndex"`
+	Active        bool             `json:"active" gorm:"not null;default:true;index"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (p *PlanPrice) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReferralRewardType string
+
+const (
+	// ReferralRewardAICredits grants the referrer extra AI requests on top of the plan quota.
+	ReferralRewardAICredits ReferralRewardType = "ai_credits"
+	// ReferralRewardStripeCoupon applies a Stripe coupon to the referrer's subscription.
+	ReferralRewardStripeCoupon ReferralRewardType = "stripe_coupon"
+)
+
+func (t ReferralRewardType) IsValid() bool {
+	return t == ReferralRewardAICredits || t == ReferralRewardStripeCoupon
+}
+
+type ReferralRewardStatus string
+
+const (
+	ReferralRewardStatusGranted ReferralRewardStatus = "granted"
+	// ReferralRewardStatusPending is used for coupons waiting for the referrer's next checkout.
+	ReferralRewardStatusPending ReferralRewardStatus = "pending"
+)
+
+// ReferralCampaign defines what a referrer earns when a referred user converts to a paid plan.
+// Signups are attributed to the campaign active at registration time.
+type ReferralCampaign struct {
+	gorm.Model
+	ID             uuid.UUID          `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:referral_campaigns"`
+	Name           string             `json:"name" gorm:"size:255;not null"`
+	Description    string             `json:"description,omitempty" gorm:"type:text"`
+	RewardType     ReferralRewardType `json:"reward_type" gorm:"size:20;not null"`
+	RewardCredits  int64              `json:"reward_credits" gorm:"not null;default:0"`
+	StripeCouponID *string            `json:"stripe_coupon_id,omitempty" gorm:"size:255"`
+	Active         bool               `json:"active" gorm:"not null;default:true;index"`
+	StartsAt       *time.Time         `json:"starts_at,omitempty"`
+	EndsAt         *time.Time         `json:"ends_at,omitempty"`
+	DeactivatedAt  *time.Time         `json:"deactivated_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (c *ReferralCampaign) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsRunning reports whether the campaign accepts new signups at the given time.
+func (c *ReferralCampaign) IsRunning(now time.Time) bool {
+	if !c.Active {
+		return false
+	}
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return false
+	}
+	if c.EndsAt != nil && now.After(*c.EndsAt) {
+		return false
+	}
+	return true
+}
+
+// ReferralReward records the reward granted for one referred user's first paid conversion.
+// The unique index on ReferredUserID makes rewards idempotent across webhook retries.
+type ReferralReward struct {
+	gorm.Model
+	ID             uuid.UUID            `json:"id" gorm:"type:char(36);primary_key;default:(UUID());table:referral_rewards"`
+	ReferrerUserID uuid.UUID            `json:"referrer_user_id" gorm:"type:char(36);not null;index"`
+	ReferredUserID uuid.UUID            `json:"referred_user_id" gorm:"type:char(36);not null;uniqueIndex"`
+	CampaignID     *uuid.UUID           `json:"campaign_id,omitempty" gorm:"type:char(36);index"`
+	RewardType     ReferralRewardType   `json:"reward_type" gorm:"size:20;not null"`
+	RewardCredits  int64                `json:"reward_credits" gorm:"not null;default:0"`
+	StripeCouponID *string              `json:"stripe_coupon_id,omitempty" gorm:"size:255"`
+	Status         ReferralRewardStatus `json:"status" gorm:"size:20;not null;index"`
+	GrantedAt      *time.Time           `json:"granted_at,omitempty"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID
+func (r *ReferralReward) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
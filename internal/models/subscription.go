@@ -25,6 +25,23 @@ func (p SubscriptionPlan) IsValid() bool {
 	}
 }
 
+// Rank orders plans from cheapest to most expensive so plan changes can be
+// classified as upgrades or downgrades. Unknown plans rank below free.
+func (p SubscriptionPlan) Rank() int {
+	switch p {
+	case SubscriptionPlanFree:
+		return 0
+	case SubscriptionPlanSimple:
+		return 1
+	case SubscriptionPlanMedium:
+		return 2
+	case SubscriptionPlanUltra:
+		return 3
+	default:
+		return -1
+	}
+}
+
 type SubscriptionStatus string
 
 const (
@@ -49,6 +66,8 @@ type Subscription struct {
 	Status               SubscriptionStatus `json:"status" gorm:"size:40;not null;default:'trialing';index"`
 	StripeCustomerID     *string            `json:"stripe_customer_id" gorm:"size:255;index"`
 	StripeSubscriptionID *string            `json:"stripe_subscription_id" gorm:"size:255;uniqueIndex"`
+	BillingInterval      string             `json:"billing_interval,omitempty" gorm:"size:10"`
+	Currency             string             `json:"currency,omitempty" gorm:"size:3"`
 
 	CurrentPeriodEnd   *time.Time `json:"current_period_end,omitempty" gorm:"index"`
 	CancelAtPeriodEnd  bool       `json:"cancel_at_period_end" gorm:"not null;default:false"`
@@ -57,6 +76,21 @@ type Subscription struct {
 	AccessRevokedAt    *time.Time `json:"access_revoked_at,omitempty" gorm:"index"`
 	AccessRevokeReason *string    `json:"access_revoke_reason,omitempty" gorm:"size:255"`
 
+	// Lifecycle tracking for trial and past_due policies and their reminder emails.
+	TrialUsedAt                 *time.Time `json:"trial_used_at,omitempty"`
+	PastDueSince                *time.Time `json:"past_due_since,omitempty" gorm:"index"`
+	TrialReminderSentAt         *time.Time `json:"trial_reminder_sent_at,omitempty"`
+	PaymentFailedReminderSentAt *time.Time `json:"payment_failed_reminder_sent_at,omitempty"`
+
+	// BonusAIRequests are extra AI requests (e.g. referral rewards) consumed once the monthly quota is exhausted.
+	BonusAIRequests int64 `json:"bonus_ai_requests" gorm:"not null;default:0"`
+
+	// Downgrades are applied at the end of the current period through a Stripe
+	// subscription schedule; these fields track the pending change locally.
+	ScheduledPlan         *SubscriptionPlan `json:"scheduled_plan,omitempty" gorm:"size:20"`
+	ScheduledPlanChangeAt *time.Time        `json:"scheduled_plan_change_at,omitempty" gorm:"index"`
+	StripeScheduleID      *string           `json:"stripe_schedule_id,omitempty" gorm:"size:255;index"`
+
 	User User `json:"-" gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;foreignKey:UserID;references:ID"`
 }
 
@@ -16,6 +16,7 @@ type Work struct {
 	Description  string     `json:"description" gorm:"type:text"`
 	StartDate    time.Time  `json:"start_date" gorm:"type:date;not null"`
 	EndDate      *time.Time `json:"end_date" gorm:"type:date"` // Nullable para trabalhos atuais
+	SortOrder    int        `json:"sort_order" gorm:"not null;default:0"`
 }
 
 // BeforeCreate will set a UUID rather than numeric ID
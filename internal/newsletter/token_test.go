@@ -0,0 +1,110 @@
+package newsletter
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// signRaw builds a correctly signed token around an arbitrary payload.
+func signRaw(s *Signer, payload string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(s.mac(payload))
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	signer := NewSigner("test-secret")
+	userID := uuid.MustParse("6f1c2b8e-4d3a-4b9e-9f1a-2c3d4e5f6a7b")
+	campaignID := uuid.MustParse("0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e")
+	issuedAt := time.Date(2026, time.October, 18, 12, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		claims Claims
+	}{
+		{name: "confirmation", claims: Claims{Purpose: PurposeConfirm, UserID: userID, IssuedAt: issuedAt}},
+		{name: "unsubscribe without campaign", claims: Claims{Purpose: PurposeUnsubscribe, UserID: userID, IssuedAt: issuedAt}},
+		{name: "unsubscribe from campaign", claims: Claims{Purpose: PurposeUnsubscribe, UserID: userID, CampaignID: campaignID, IssuedAt: issuedAt}},
+		{name: "sub-second issue time is truncated", claims: Claims{Purpose: PurposeConfirm, UserID: userID, IssuedAt: issuedAt.Add(999 * time.Millisecond)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := signer.Sign(tt.claims)
+			if strings.ContainsAny(token, "+/=") {
+				t.Errorf("Sign() = %q, want a URL-safe token without padding", token)
+			}
+
+			got, err := signer.Verify(token, tt.claims.Purpose)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got.Purpose != tt.claims.Purpose || got.UserID != tt.claims.UserID || got.CampaignID != tt.claims.CampaignID {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.claims)
+			}
+			if want := tt.claims.IssuedAt.Truncate(time.Second); !got.IssuedAt.Equal(want) {
+				t.Errorf("Verify() IssuedAt = %v, want %v", got.IssuedAt, want)
+			}
+		})
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	signer := NewSigner("test-secret")
+	claims := Claims{
+		Purpose:  PurposeUnsubscribe,
+		UserID:   uuid.MustParse("6f1c2b8e-4d3a-4b9e-9f1a-2c3d4e5f6a7b"),
+		IssuedAt: time.Unix(1760790645, 0),
+	}
+	valid := signer.Sign(claims)
+	encodedPayload, encodedMAC, _ := strings.Cut(valid, ".")
+
+	payload, _ := base64.RawURLEncoding.DecodeString(encodedPayload)
+	otherUser := strings.Replace(string(payload), claims.UserID.String(), uuid.New().String(), 1)
+	mac, _ := base64.RawURLEncoding.DecodeString(encodedMAC)
+	mac[0] ^= 0xff
+
+	tests := []struct {
+		name    string
+		token   string
+		purpose Purpose
+	}{
+		{name: "empty token", token: "", purpose: PurposeUnsubscribe},
+		{name: "missing separator", token: encodedPayload + encodedMAC, purpose: PurposeUnsubscribe},
+		{name: "tampered payload", token: base64.RawURLEncoding.EncodeToString([]byte(otherUser)) + "." + encodedMAC, purpose: PurposeUnsubscribe},
+		{name: "tampered MAC", token: encodedPayload + "." + base64.RawURLEncoding.EncodeToString(mac), purpose: PurposeUnsubscribe},
+		{name: "truncated MAC", token: encodedPayload + "." + encodedMAC[:len(encodedMAC)-4], purpose: PurposeUnsubscribe},
+		{name: "empty MAC", token: encodedPayload + ".", purpose: PurposeUnsubscribe},
+		{name: "purpose mismatch", token: valid, purpose: PurposeConfirm},
+		{name: "malformed payload base64", token: "!!!" + "." + encodedMAC, purpose: PurposeUnsubscribe},
+		{name: "malformed MAC base64", token: encodedPayload + ".***", purpose: PurposeUnsubscribe},
+		{name: "padded base64", token: encodedPayload + "=." + encodedMAC, purpose: PurposeUnsubscribe},
+		{name: "signed with another secret", token: NewSigner("other-secret").Sign(claims), purpose: PurposeUnsubscribe},
+		{name: "signed payload with missing field", token: signRaw(signer, "unsubscribe|"+claims.UserID.String()+"|"+uuid.Nil.String()), purpose: PurposeUnsubscribe},
+		{name: "signed payload with extra field", token: signRaw(signer, string(payload)+"|extra"), purpose: PurposeUnsubscribe},
+		{name: "signed payload with invalid user", token: signRaw(signer, "unsubscribe|not-a-uuid|"+uuid.Nil.String()+"|1760790645"), purpose: PurposeUnsubscribe},
+		{name: "signed payload with invalid campaign", token: signRaw(signer, "unsubscribe|"+claims.UserID.String()+"|nope|1760790645"), purpose: PurposeUnsubscribe},
+		{name: "signed payload with invalid issue time", token: signRaw(signer, "unsubscribe|"+claims.UserID.String()+"|"+uuid.Nil.String()+"|yesterday"), purpose: PurposeUnsubscribe},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := signer.Verify(tt.token, tt.purpose); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Verify(%q) error = %v, want %v", tt.token, err, ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func TestVerifyWithEmptySecret(t *testing.T) {
+	signer := NewSigner("")
+	token := signer.Sign(Claims{Purpose: PurposeUnsubscribe, UserID: uuid.New(), IssuedAt: time.Now()})
+
+	if _, err := signer.Verify(token, PurposeUnsubscribe); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Verify() error = %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"time"
 
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	transporthttp "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/transport/http"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
@@ -71,6 +73,36 @@ func NewAIRateLimiter(client *redis.Client, logger *zap.Logger) *RateLimiter {
 	}
 }
 
+// NewSharePasswordRateLimiter creates a limiter for wrong share link passwords: after
+// SHARE_PASSWORD_RATE_LIMIT failures (default 5) an IP is locked out of that link for
+// SHARE_PASSWORD_RATE_WINDOW_MINUTES (default 15).
+func NewSharePasswordRateLimiter(client *redis.Client, logger *zap.Logger) *RateLimiter {
+	limit := getEnvAsInt("SHARE_PASSWORD_RATE_LIMIT", 5)
+	windowMinutes := getEnvAsInt("SHARE_PASSWORD_RATE_WINDOW_MINUTES", 15)
+
+	return &RateLimiter{
+		client:  client,
+		limit:   limit,
+		windows: time.Duration(windowMinutes) * time.Minute,
+		context: context.Background(),
+		logger:  logger,
+	}
+}
+
+// Blocked reports whether the key already used up its limit, without counting a request.
+// Like Allow, it blocks when Redis fails.
+func (rl *RateLimiter) Blocked(key string) bool {
+	count, err := rl.client.Get(rl.context, key).Int64()
+	if err == redis.Nil {
+		return false
+	}
+	if err != nil {
+		rl.logger.Error("Failed to read rate limit counter", zap.Error(err))
+		return true
+	}
+	return count >= int64(rl.limit)
+}
+
 // Allow verifica se a solicitação é permitida com base na chave
 func (rl *RateLimiter) Allow(key string) bool {
 	// Incrementa o contador do Redis
@@ -136,15 +168,31 @@ func RateLimiterMiddleware(rl *RateLimiter) gin.HandlerFunc {
 		clientIP := GetClientIP(c.Request)
 
 		if !rl.Allow(clientIP) {
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error":   "Too Many Requests",
-				"message": "Rate limit exceeded. Please try again later.",
-			})
-			c.Abort()
+			transporthttp.HandleCodeError(c, apperrors.CodeRateLimited, "")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// SharePasswordRateLimiterMiddleware locks an IP out of a share link after repeated wrong
+// passwords, so they cannot be brute-forced. Only failures are counted: a 401 answered to a
+// request that sent X-Share-Password.
+func SharePasswordRateLimiterMiddleware(rl *RateLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := "share-password:" + GetClientIP(c.Request) + ":" + c.Param("slug")
+
+		if rl.Blocked(key) {
+			transporthttp.HandleCodeError(c, apperrors.CodeRateLimited, "")
 			return
 		}
 
 		c.Next()
+
+		if c.Writer.Status() == http.StatusUnauthorized && c.GetHeader("X-Share-Password") != "" {
+			rl.Allow(key)
+		}
 	}
 }
 
@@ -162,22 +210,14 @@ func UserRateLimiterMiddleware(rl *RateLimiter) gin.HandlerFunc {
 			// Caso não encontre, usa o rate limiting baseado em IP
 			clientIP := GetClientIP(c.Request)
 			if !rl.Allow(clientIP) {
-				c.JSON(http.StatusTooManyRequests, gin.H{
-					"error":   "Too Many Requests",
-					"message": "Rate limit exceeded. Please try again later.",
-				})
-				c.Abort()
+				transporthttp.HandleCodeError(c, apperrors.CodeRateLimited, "")
 				return
 			}
 		} else {
 			// Usa o ID do usuário para rate limiting
 			userKey := "user:" + strconv.Itoa(userID.(int))
 			if !rl.Allow(userKey) {
-				c.JSON(http.StatusTooManyRequests, gin.H{
-					"error":   "Too Many Requests",
-					"message": "Rate limit exceeded. Please try again later.",
-				})
-				c.Abort()
+				transporthttp.HandleCodeError(c, apperrors.CodeRateLimited, "")
 				return
 			}
 		}
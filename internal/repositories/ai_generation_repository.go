@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AIGenerationFeatureCount aggregates the generations of one feature and how many got feedback.
+type AIGenerationFeatureCount struct {
+	Feature      string
+	Generations  int64
+	WithFeedback int64
+	Chosen       int64
+}
+
+// AIGenerationChoiceCount is how often the alternative at Position was chosen for a feature.
+type AIGenerationChoiceCount struct {
+	Feature  string
+	Position int
+	Count    int64
+}
+
+// AIGenerationRepository defines the interface for AI generation and feedback data operations.
+type AIGenerationRepository interface {
+	Create(ctx context.Context, generation *models.AIGeneration) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.AIGeneration, error)
+	SaveFeedback(ctx context.Context, generation *models.AIGeneration) error
+	CountByFeature(ctx context.Context) ([]AIGenerationFeatureCount, error)
+	CountChoices(ctx context.Context) ([]AIGenerationChoiceCount, error)
+}
+
+type aiGenerationRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewAIGenerationRepository creates a new AIGenerationRepository.
+func NewAIGenerationRepository(db *gorm.DB, logger *zap.Logger) AIGenerationRepository {
+	return &aiGenerationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func orderAIGenerationAlternatives(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
+
+// Create stores the generation together with its alternatives.
+func (r *aiGenerationRepository) Create(ctx context.Context, generation *models.AIGeneration) error {
+	if err := r.db.WithContext(ctx).Create(generation).Error; err != nil {
+		r.logger.Error("Failed to create AI generation", zap.Error(err), zap.String("user_id", generation.UserID.String()))
+		return fmt.Errorf("failed to create AI generation: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the generation does not exist.
+func (r *aiGenerationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.AIGeneration, error) {
+	var generation models.AIGeneration
+	if err := r.db.WithContext(ctx).Preload("Alternatives", orderAIGenerationAlternatives).Where("id = ?", id).First(&generation).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get AI generation", zap.Error(err), zap.String("generation_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get AI generation %s: %w", id.String(), err)
+	}
+	return &generation, nil
+}
+
+// SaveFeedback stores the chosen position and comment of the generation and the
+// rejected flag of each of its alternatives.
+func (r *aiGenerationRepository) SaveFeedback(ctx context.Context, generation *models.AIGeneration) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(generation).
+			Select("ChosenPosition", "FeedbackComment", "FeedbackAt").
+			Updates(generation).Error; err != nil {
+			return err
+		}
+		for i := range generation.Alternatives {
+			alternative := &generation.Alternatives[i]
+			if err := tx.Model(alternative).Select("Rejected").Updates(alternative).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to save AI generation feedback", zap.Error(err), zap.String("generation_id", generation.ID.String()))
+		return fmt.Errorf("failed to save AI generation feedback: %w", err)
+	}
+	return nil
+}
+
+// CountByFeature counts generations per feature, with and without feedback.
+func (r *aiGenerationRepository) CountByFeature(ctx context.Context) ([]AIGenerationFeatureCount, error) {
+	var counts []AIGenerationFeatureCount
+	err := r.db.WithContext(ctx).
+		Model(&models.AIGeneration{}).
+		Select("feature, COUNT(*) AS generations, " +
+			"SUM(CASE WHEN feedback_at IS NOT NULL THEN 1 ELSE 0 END) AS with_feedback, " +
+			"SUM(CASE WHEN chosen_position IS NOT NULL THEN 1 ELSE 0 END) AS chosen").
+		Group("feature").
+		Order("feature ASC").
+		Scan(&counts).Error
+	if err != nil {
+		r.logger.Error("Failed to count AI generations", zap.Error(err))
+		return nil, fmt.Errorf("failed to count AI generations: %w", err)
+	}
+	return counts, nil
+}
+
+// CountChoices counts, per feature, how often each alternative position was chosen.
+func (r *aiGenerationRepository) CountChoices(ctx context.Context) ([]AIGenerationChoiceCount, error) {
+	var counts []AIGenerationChoiceCount
+	err := r.db.WithContext(ctx).
+		Model(&models.AIGeneration{}).
+		Select("feature, chosen_position AS position, COUNT(*) AS count").
+		Where("chosen_position IS NOT NULL").
+		Group("feature, chosen_position").
+		Order("feature ASC, position ASC").
+		Scan(&counts).Error
+	if err != nil {
+		r.logger.Error("Failed to count AI generation choices", zap.Error(err))
+		return nil, fmt.Errorf("failed to count AI generation choices: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,216 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ApplicationRepository defines the interface for job application tracker data operations.
+type ApplicationRepository interface {
+	Create(ctx context.Context, application *models.Application, change *models.ApplicationStatusChange) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Application, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, status *models.ApplicationStatus) ([]models.Application, error)
+	Update(ctx context.Context, application *models.Application) error
+	UpdateStatus(ctx context.Context, application *models.Application, change *models.ApplicationStatusChange) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListStatusChanges(ctx context.Context, applicationID uuid.UUID) ([]models.ApplicationStatusChange, error)
+	ListFollowUpsDue(ctx context.Context, before time.Time) ([]models.Application, error)
+	MarkFollowUpReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error)
+	CountReachedAny(ctx context.Context, userID uuid.UUID, statuses []models.ApplicationStatus) (int64, error)
+}
+
+type applicationRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewApplicationRepository creates a new ApplicationRepository.
+func NewApplicationRepository(db *gorm.DB, logger *zap.Logger) ApplicationRepository {
+	return &applicationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores the application with its contacts and the initial status history entry.
+func (r *applicationRepository) Create(ctx context.Context, application *models.Application, change *models.ApplicationStatusChange) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(application).Error; err != nil {
+			return err
+		}
+		change.ApplicationID = application.ID
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to create application", zap.Error(err), zap.String("user_id", application.UserID.String()))
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the application does not exist.
+func (r *applicationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Application, error) {
+	var application models.Application
+	if err := r.db.WithContext(ctx).Preload("Contacts").Where("id = ?", id).First(&application).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get application", zap.Error(err), zap.String("application_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get application %s: %w", id.String(), err)
+	}
+	return &application, nil
+}
+
+// ListByUser returns the user's applications, most recently updated first, optionally filtered by status.
+func (r *applicationRepository) ListByUser(ctx context.Context, userID uuid.UUID, status *models.ApplicationStatus) ([]models.Application, error) {
+	query := r.db.WithContext(ctx).Preload("Contacts").Where("user_id = ?", userID)
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var applications []models.Application
+	if err := query.Order("updated_at DESC").Find(&applications).Error; err != nil {
+		r.logger.Error("Failed to list applications", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	return applications, nil
+}
+
+// Update saves the application fields and replaces its contacts.
+func (r *applicationRepository) Update(ctx context.Context, application *models.Application) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Contacts").Save(application).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("application_id = ?", application.ID).Delete(&models.ApplicationContact{}).Error; err != nil {
+			return err
+		}
+		for i := range application.Contacts {
+			application.Contacts[i].ID = uuid.Nil
+			application.Contacts[i].ApplicationID = application.ID
+		}
+		if len(application.Contacts) == 0 {
+			return nil
+		}
+		return tx.Create(&application.Contacts).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to update application", zap.Error(err), zap.String("application_id", application.ID.String()))
+		return fmt.Errorf("failed to update application: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus saves the new status fields and appends the history entry in one transaction.
+func (r *applicationRepository) UpdateStatus(ctx context.Context, application *models.Application, change *models.ApplicationStatusChange) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(application).
+			Select("Status", "StatusChangedAt", "AppliedAt", "FollowUpAt", "FollowUpReminderSentAt").
+			Updates(application).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to update application status", zap.Error(err), zap.String("application_id", application.ID.String()))
+		return fmt.Errorf("failed to update application status: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the application together with its contacts and status history.
+// Cover letters and interview practice sets prepared for it are kept and unlinked.
+func (r *applicationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("application_id = ?", id).Delete(&models.ApplicationContact{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("application_id = ?", id).Delete(&models.ApplicationStatusChange{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.CoverLetter{}).Where("application_id = ?", id).Update("application_id", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.InterviewPracticeSet{}).Where("application_id = ?", id).Update("application_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&models.Application{}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to delete application", zap.Error(err), zap.String("application_id", id.String()))
+		return fmt.Errorf("failed to delete application %s: %w", id.String(), err)
+	}
+	return nil
+}
+
+// ListStatusChanges returns the application's status history, oldest first.
+func (r *applicationRepository) ListStatusChanges(ctx context.Context, applicationID uuid.UUID) ([]models.ApplicationStatusChange, error) {
+	var changes []models.ApplicationStatusChange
+	err := r.db.WithContext(ctx).
+		Where("application_id = ?", applicationID).
+		Order("changed_at ASC").
+		Find(&changes).Error
+	if err != nil {
+		r.logger.Error("Failed to list application status changes", zap.Error(err), zap.String("application_id", applicationID.String()))
+		return nil, fmt.Errorf("failed to list application status changes: %w", err)
+	}
+	return changes, nil
+}
+
+// ListFollowUpsDue returns open applications whose follow-up date passed before the given
+// time and whose reminder was not sent yet.
+func (r *applicationRepository) ListFollowUpsDue(ctx context.Context, before time.Time) ([]models.Application, error) {
+	var applications []models.Application
+	err := r.db.WithContext(ctx).
+		Where("follow_up_at IS NOT NULL AND follow_up_at <= ? AND follow_up_reminder_sent_at IS NULL", before).
+		Where("status NOT IN ?", []models.ApplicationStatus{
+			models.ApplicationStatusAccepted,
+			models.ApplicationStatusRejected,
+			models.ApplicationStatusWithdrawn,
+		}).
+		Order("follow_up_at ASC").
+		Find(&applications).Error
+	if err != nil {
+		r.logger.Error("Failed to list application follow-ups due", zap.Error(err))
+		return nil, fmt.Errorf("failed to list application follow-ups due: %w", err)
+	}
+	return applications, nil
+}
+
+// MarkFollowUpReminderSent claims the follow-up reminder for an application. It returns
+// false when another worker already claimed it, so each reminder is sent once.
+func (r *applicationRepository) MarkFollowUpReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Application{}).
+		Where("id = ? AND follow_up_reminder_sent_at IS NULL", id).
+		Update("follow_up_reminder_sent_at", sentAt)
+	if result.Error != nil {
+		r.logger.Error("Failed to mark application follow-up reminder as sent", zap.Error(result.Error), zap.String("application_id", id.String()))
+		return false, fmt.Errorf("failed to mark application follow-up reminder as sent: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CountReachedAny counts the user's applications whose history reached any of the statuses,
+// so an application rejected after interviews still counts as interviewed.
+func (r *applicationRepository) CountReachedAny(ctx context.Context, userID uuid.UUID, statuses []models.ApplicationStatus) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&models.ApplicationStatusChange{}).
+		Joins("JOIN applications ON applications.id = application_status_changes.application_id AND applications.deleted_at IS NULL").
+		Where("applications.user_id = ? AND application_status_changes.to_status IN ?", userID, statuses).
+		Distinct("application_status_changes.application_id").
+		Count(&count).Error
+	if err != nil {
+		r.logger.Error("Failed to count applications by reached status", zap.Error(err), zap.String("user_id", userID.String()))
+		return 0, fmt.Errorf("failed to count applications by reached status: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ClientProfileRepository defines the interface for consultant client profile data operations.
+type ClientProfileRepository interface {
+	Create(ctx context.Context, client *models.ClientProfile) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ClientProfile, error)
+	ListByConsultant(ctx context.Context, consultantUserID uuid.UUID) ([]models.ClientProfile, error)
+	Save(ctx context.Context, client *models.ClientProfile) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type clientProfileRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewClientProfileRepository creates a new ClientProfileRepository.
+func NewClientProfileRepository(db *gorm.DB, logger *zap.Logger) ClientProfileRepository {
+	return &clientProfileRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *clientProfileRepository) Create(ctx context.Context, client *models.ClientProfile) error {
+	if err := r.db.WithContext(ctx).Create(client).Error; err != nil {
+		r.logger.Error("Failed to create client profile", zap.Error(err), zap.String("consultant_user_id", client.ConsultantUserID.String()))
+		return fmt.Errorf("failed to create client profile: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the client profile does not exist.
+func (r *clientProfileRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ClientProfile, error) {
+	var client models.ClientProfile
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&client).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get client profile", zap.Error(err), zap.String("client_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get client profile %s: %w", id.String(), err)
+	}
+	return &client, nil
+}
+
+func (r *clientProfileRepository) ListByConsultant(ctx context.Context, consultantUserID uuid.UUID) ([]models.ClientProfile, error) {
+	var clients []models.ClientProfile
+	err := r.db.WithContext(ctx).
+		Where("consultant_user_id = ?", consultantUserID).
+		Order("name ASC").
+		Find(&clients).Error
+	if err != nil {
+		r.logger.Error("Failed to list client profiles", zap.Error(err), zap.String("consultant_user_id", consultantUserID.String()))
+		return nil, fmt.Errorf("failed to list client profiles: %w", err)
+	}
+	return clients, nil
+}
+
+func (r *clientProfileRepository) Save(ctx context.Context, client *models.ClientProfile) error {
+	if err := r.db.WithContext(ctx).Save(client).Error; err != nil {
+		r.logger.Error("Failed to save client profile", zap.Error(err), zap.String("client_id", client.ID.String()))
+		return fmt.Errorf("failed to save client profile: %w", err)
+	}
+	return nil
+}
+
+func (r *clientProfileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.ClientProfile{}).Error; err != nil {
+		r.logger.Error("Failed to delete client profile", zap.Error(err), zap.String("client_id", id.String()))
+		return fmt.Errorf("failed to delete client profile %s: %w", id.String(), err)
+	}
+	return nil
+}
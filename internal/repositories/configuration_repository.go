@@ -15,6 +15,7 @@ type ConfigurationRepository interface {
 	Create(ctx context.Context, configuration *models.Configuration) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Configuration, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.Configuration, error)
+	GetByUserEmail(ctx context.Context, email string) (*models.Configuration, error)
 	Update(ctx context.Context, configuration *models.Configuration) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -64,6 +65,20 @@ func (c *configurationRepository) GetByUserID(ctx context.Context, userID uuid.U
 	return &configuration, nil
 }
 
+// GetByUserEmail retrieves the configuration of the user with the given email; returns
+// gorm.ErrRecordNotFound (wrapped) when there is no such user or configuration
+func (c *configurationRepository) GetByUserEmail(ctx context.Context, email string) (*models.Configuration, error) {
+	var configuration models.Configuration
+	err := c.db.WithContext(ctx).
+		Joins("JOIN users ON users.id = configurations.user_id AND users.deleted_at IS NULL").
+		Where("users.email = ?", email).
+		First(&configuration).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configuration by user email: %w", err)
+	}
+	return &configuration, nil
+}
+
 // Update updates an existing configuration in the database
 func (c *configurationRepository) Update(ctx context.Context, configuration *models.Configuration) error {
 	if err := c.db.WithContext(ctx).Save(configuration).Error; err != nil {
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// CoverLetterRepository defines the interface for cover letter data operations.
+type CoverLetterRepository interface {
+	Create(ctx context.Context, letter *models.CoverLetter) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CoverLetter, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]models.CoverLetter, error)
+	Update(ctx context.Context, letter *models.CoverLetter) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type coverLetterRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewCoverLetterRepository creates a new CoverLetterRepository.
+func NewCoverLetterRepository(db *gorm.DB, logger *zap.Logger) CoverLetterRepository {
+	return &coverLetterRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *coverLetterRepository) Create(ctx context.Context, letter *models.CoverLetter) error {
+	if err := r.db.WithContext(ctx).Create(letter).Error; err != nil {
+		r.logger.Error("Failed to create cover letter", zap.Error(err), zap.String("user_id", letter.UserID.String()))
+		return fmt.Errorf("failed to create cover letter: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the cover letter does not exist.
+func (r *coverLetterRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CoverLetter, error) {
+	var letter models.CoverLetter
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&letter).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get cover letter", zap.Error(err), zap.String("cover_letter_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get cover letter %s: %w", id.String(), err)
+	}
+	return &letter, nil
+}
+
+// ListByUser returns the user's cover letters, newest first, optionally only those for one curriculum.
+func (r *coverLetterRepository) ListByUser(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]models.CoverLetter, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if curriculumID != nil {
+		query = query.Where("curriculum_id = ?", *curriculumID)
+	}
+
+	var letters []models.CoverLetter
+	if err := query.Order("created_at DESC").Find(&letters).Error; err != nil {
+		r.logger.Error("Failed to list cover letters", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("failed to list cover letters: %w", err)
+	}
+	return letters, nil
+}
+
+// Update saves the editable fields of the cover letter.
+func (r *coverLetterRepository) Update(ctx context.Context, letter *models.CoverLetter) error {
+	err := r.db.WithContext(ctx).Model(letter).
+		Select("Title", "Company", "Position", "Content").
+		Updates(letter).Error
+	if err != nil {
+		r.logger.Error("Failed to update cover letter", zap.Error(err), zap.String("cover_letter_id", letter.ID.String()))
+		return fmt.Errorf("failed to update cover letter: %w", err)
+	}
+	return nil
+}
+
+func (r *coverLetterRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.CoverLetter{}).Error; err != nil {
+		r.logger.Error("Failed to delete cover letter", zap.Error(err), zap.String("cover_letter_id", id.String()))
+		return fmt.Errorf("failed to delete cover letter: %w", err)
+	}
+	return nil
+}
@@ -20,6 +20,9 @@ type CurriculumRepository interface {
 	Count(ctx context.Context) (int64, error)
 	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	DeleteCurriculum(ctx context.Context, id uuid.UUID) error
+	ListByClientProfileID(ctx context.Context, clientProfileID uuid.UUID) ([]models.Curriculums, error)
+	ListSharedWithEmail(ctx context.Context, email string, statuses []models.CurriculumStatus) ([]models.Curriculums, error)
+	UpdateStatus(ctx context.Context, curriculum *models.Curriculums) error
 }
 
 // curriculumRepository implements CurriculumRepository.
@@ -54,7 +57,7 @@ func (cu *curriculumRepository) Create(ctx context.Context, curriculum *models.C
 // GetByID retrieves a curriculum by ID.
 func (cu *curriculumRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Curriculums, error) {
 	var curriculum models.Curriculums
-	err := cu.db.WithContext(ctx).Preload("Works").Preload("Educations").Where("id = ?", id).First(&curriculum).Error
+	err := cu.db.WithContext(ctx).Preload("Works", orderWorks).Preload("Educations").Where("id = ?", id).First(&curriculum).Error
 	if err != nil {
 		cu.logger.Error("Failed to get curriculum by ID",
 			zap.Error(err),
@@ -76,7 +79,7 @@ func (cu *curriculumRepository) GetPageAfterID(ctx context.Context, afterID *uui
 	}
 
 	query := cu.db.WithContext(ctx).
-		Preload("Works").
+		Preload("Works", orderWorks).
 		Preload("Educations").
 		Model(&models.Curriculums{})
 
@@ -109,7 +112,7 @@ func (cu *curriculumRepository) GetPageAfterIDByUserID(ctx context.Context, user
 	}
 
 	query := cu.db.WithContext(ctx).
-		Preload("Works").
+		Preload("Works", orderWorks).
 		Preload("Educations").
 		Model(&models.Curriculums{}).
 		Where("user_id = ?", userID)
@@ -138,7 +141,7 @@ func (cu *curriculumRepository) GetPageAfterIDByUserID(ctx context.Context, user
 // GetByUserID retrieves a curriculum by user ID.
 func (cu *curriculumRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.Curriculums, error) {
 	var curriculum models.Curriculums
-	err := cu.db.WithContext(ctx).Preload("Works").Preload("Educations").Where("user_id = ?", userID).First(&curriculum).Error
+	err := cu.db.WithContext(ctx).Preload("Works", orderWorks).Preload("Educations").Where("user_id = ?", userID).First(&curriculum).Error
 	if err != nil {
 		cu.logger.Error("Failed to get curriculum by user ID",
 			zap.Error(err),
@@ -185,3 +188,61 @@ func (cu *curriculumRepository) DeleteCurriculum(ctx context.Context, id uuid.UU
 	}
 	return nil
 }
+
+// ListByClientProfileID returns the curriculums a consultant manages for the client profile, newest first.
+func (cu *curriculumRepository) ListByClientProfileID(ctx context.Context, clientProfileID uuid.UUID) ([]models.Curriculums, error) {
+	var curriculums []models.Curriculums
+	err := cu.db.WithContext(ctx).
+		Preload("Works", orderWorks).
+		Preload("Educations").
+		Where("client_profile_id = ?", clientProfileID).
+		Order("created_at DESC").
+		Find(&curriculums).Error
+	if err != nil {
+		cu.logger.Error("Failed to list curriculums by client profile",
+			zap.Error(err),
+			zap.String("client_profile_id", clientProfileID.String()),
+		)
+		return nil, fmt.Errorf("failed to list curriculums by client profile %s: %w", clientProfileID.String(), err)
+	}
+	return curriculums, nil
+}
+
+// ListSharedWithEmail returns curriculums whose client profile email matches, limited to the given statuses.
+func (cu *curriculumRepository) ListSharedWithEmail(ctx context.Context, email string, statuses []models.CurriculumStatus) ([]models.Curriculums, error) {
+	var curriculums []models.Curriculums
+	err := cu.db.WithContext(ctx).
+		Preload("Works", orderWorks).
+		Preload("Educations").
+		Joins("JOIN client_profiles ON client_profiles.id = curriculums.client_profile_id AND client_profiles.deleted_at IS NULL").
+		Where("LOWER(client_profiles.email) = LOWER(?)", email).
+		Where("curriculums.status IN ?", statuses).
+		Order("curriculums.status_changed_at DESC").
+		Find(&curriculums).Error
+	if err != nil {
+		cu.logger.Error("Failed to list curriculums shared with client", zap.Error(err))
+		return nil, fmt.Errorf("failed to list shared curriculums: %w", err)
+	}
+	return curriculums, nil
+}
+
+// UpdateStatus persists the review status fields only.
+func (cu *curriculumRepository) UpdateStatus(ctx context.Context, curriculum *models.Curriculums) error {
+	err := cu.db.WithContext(ctx).
+		Model(curriculum).
+		Select("Status", "ReviewComment", "StatusChangedAt").
+		Updates(curriculum).Error
+	if err != nil {
+		cu.logger.Error("Failed to update curriculum status",
+			zap.Error(err),
+			zap.String("curriculum_id", curriculum.ID.String()),
+		)
+		return fmt.Errorf("failed to update curriculum status %s: %w", curriculum.ID.String(), err)
+	}
+	return nil
+}
+
+// orderWorks keeps works in the order the user (or tailoring) chose, newest first otherwise.
+func orderWorks(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC").Order("start_date DESC")
+}
@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ShareViewCount is an aggregated number of views for one dimension value (country or referrer).
+type ShareViewCount struct {
+	Key   string
+	Count int64
+}
+
+// CurriculumShareRepository defines the interface for curriculum share link and view data operations.
+type CurriculumShareRepository interface {
+	CreateLink(ctx context.Context, link *models.CurriculumShareLink) error
+	GetLinkByID(ctx context.Context, id uuid.UUID) (*models.CurriculumShareLink, error)
+	GetLinkBySlug(ctx context.Context, slug string) (*models.CurriculumShareLink, error)
+	ListLinksByCurriculum(ctx context.Context, curriculumID uuid.UUID) ([]models.CurriculumShareLink, error)
+	RevokeLink(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	RecordView(ctx context.Context, view *models.CurriculumShareView) error
+	ListViews(ctx context.Context, shareLinkID uuid.UUID, limit int) ([]models.CurriculumShareView, error)
+	CountViewsBy(ctx context.Context, shareLinkID uuid.UUID, column string) ([]ShareViewCount, error)
+}
+
+type curriculumShareRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewCurriculumShareRepository creates a new CurriculumShareRepository.
+func NewCurriculumShareRepository(db *gorm.DB, logger *zap.Logger) CurriculumShareRepository {
+	return &curriculumShareRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *curriculumShareRepository) CreateLink(ctx context.Context, link *models.CurriculumShareLink) error {
+	if err := r.db.WithContext(ctx).Create(link).Error; err != nil {
+		r.logger.Error("Failed to create share link", zap.Error(err), zap.String("curriculum_id", link.CurriculumID.String()))
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+	return nil
+}
+
+// GetLinkByID returns gorm.ErrRecordNotFound (wrapped) when the link does not exist.
+func (r *curriculumShareRepository) GetLinkByID(ctx context.Context, id uuid.UUID) (*models.CurriculumShareLink, error) {
+	var link models.CurriculumShareLink
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&link).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get share link", zap.Error(err), zap.String("share_link_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get share link %s: %w", id.String(), err)
+	}
+	return &link, nil
+}
+
+// GetLinkBySlug returns gorm.ErrRecordNotFound (wrapped) when no link uses the slug.
+func (r *curriculumShareRepository) GetLinkBySlug(ctx context.Context, slug string) (*models.CurriculumShareLink, error) {
+	var link models.CurriculumShareLink
+	if err := r.db.WithContext(ctx).Where("slug = ?", slug).First(&link).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get share link by slug", zap.Error(err))
+		}
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+	return &link, nil
+}
+
+func (r *curriculumShareRepository) ListLinksByCurriculum(ctx context.Context, curriculumID uuid.UUID) ([]models.CurriculumShareLink, error) {
+	var links []models.CurriculumShareLink
+	err := r.db.WithContext(ctx).
+		Where("curriculum_id = ?", curriculumID).
+		Order("created_at DESC").
+		Find(&links).Error
+	if err != nil {
+		r.logger.Error("Failed to list share links", zap.Error(err), zap.String("curriculum_id", curriculumID.String()))
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	return links, nil
+}
+
+func (r *curriculumShareRepository) RevokeLink(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.CurriculumShareLink{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt).Error
+	if err != nil {
+		r.logger.Error("Failed to revoke share link", zap.Error(err), zap.String("share_link_id", id.String()))
+		return fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	return nil
+}
+
+// RecordView stores the view event and bumps the link's counters in one transaction.
+func (r *curriculumShareRepository) RecordView(ctx context.Context, view *models.CurriculumShareView) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(view).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.CurriculumShareLink{}).
+			Where("id = ?", view.ShareLinkID).
+			Updates(map[string]interface{}{
+				"view_count":     gorm.Expr("view_count + 1"),
+				"last_viewed_at": view.ViewedAt,
+			}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to record share view", zap.Error(err), zap.String("share_link_id", view.ShareLinkID.String()))
+		return fmt.Errorf("failed to record share view: %w", err)
+	}
+	return nil
+}
+
+// ListViews returns the most recent view events of a link.
+func (r *curriculumShareRepository) ListViews(ctx context.Context, shareLinkID uuid.UUID, limit int) ([]models.CurriculumShareView, error) {
+	var views []models.CurriculumShareView
+	err := r.db.WithContext(ctx).
+		Where("share_link_id = ?", shareLinkID).
+		Order("viewed_at DESC").
+		Limit(limit).
+		Find(&views).Error
+	if err != nil {
+		r.logger.Error("Failed to list share views", zap.Error(err), zap.String("share_link_id", shareLinkID.String()))
+		return nil, fmt.Errorf("failed to list share views: %w", err)
+	}
+	return views, nil
+}
+
+// CountViewsBy groups the link's views by "country" or "referrer", most frequent first.
+func (r *curriculumShareRepository) CountViewsBy(ctx context.Context, shareLinkID uuid.UUID, column string) ([]ShareViewCount, error) {
+	if column != "country" && column != "referrer" {
+		return nil, fmt.Errorf("unsupported share view dimension: %s", column)
+	}
+
+	var counts []ShareViewCount
+	err := r.db.WithContext(ctx).
+		Model(&models.CurriculumShareView{}).
+		Select(column+" AS `key`, COUNT(*) AS count").
+		Where("share_link_id = ?", shareLinkID).
+		Group(column).
+		Order("count DESC").
+		Scan(&counts).Error
+	if err != nil {
+		r.logger.Error("Failed to count share views", zap.Error(err), zap.String("share_link_id", shareLinkID.String()), zap.String("dimension", column))
+		return nil, fmt.Errorf("failed to count share views: %w", err)
+	}
+	return counts, nil
+}
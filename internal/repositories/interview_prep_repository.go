@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// InterviewPrepRepository defines the interface for interview practice set data operations.
+type InterviewPrepRepository interface {
+	Create(ctx context.Context, set *models.InterviewPracticeSet) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.InterviewPracticeSet, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]models.InterviewPracticeSet, error)
+	UpdateQuestion(ctx context.Context, question *models.InterviewQuestion) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type interviewPrepRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewInterviewPrepRepository creates a new InterviewPrepRepository.
+func NewInterviewPrepRepository(db *gorm.DB, logger *zap.Logger) InterviewPrepRepository {
+	return &interviewPrepRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func orderInterviewQuestions(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC")
+}
+
+// Create stores the practice set together with its questions.
+func (r *interviewPrepRepository) Create(ctx context.Context, set *models.InterviewPracticeSet) error {
+	if err := r.db.WithContext(ctx).Create(set).Error; err != nil {
+		r.logger.Error("Failed to create interview practice set", zap.Error(err), zap.String("user_id", set.UserID.String()))
+		return fmt.Errorf("failed to create interview practice set: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the practice set does not exist.
+func (r *interviewPrepRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.InterviewPracticeSet, error) {
+	var set models.InterviewPracticeSet
+	if err := r.db.WithContext(ctx).Preload("Questions", orderInterviewQuestions).Where("id = ?", id).First(&set).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get interview practice set", zap.Error(err), zap.String("practice_set_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get interview practice set %s: %w", id.String(), err)
+	}
+	return &set, nil
+}
+
+// ListByUser returns the user's practice sets with their questions, newest first,
+// optionally only those for one curriculum.
+func (r *interviewPrepRepository) ListByUser(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]models.InterviewPracticeSet, error) {
+	query := r.db.WithContext(ctx).Preload("Questions", orderInterviewQuestions).Where("user_id = ?", userID)
+	if curriculumID != nil {
+		query = query.Where("curriculum_id = ?", *curriculumID)
+	}
+
+	var sets []models.InterviewPracticeSet
+	if err := query.Order("created_at DESC").Find(&sets).Error; err != nil {
+		r.logger.Error("Failed to list interview practice sets", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("failed to list interview practice sets: %w", err)
+	}
+	return sets, nil
+}
+
+// UpdateQuestion saves the user's notes and practiced flag for a question.
+func (r *interviewPrepRepository) UpdateQuestion(ctx context.Context, question *models.InterviewQuestion) error {
+	err := r.db.WithContext(ctx).Model(question).
+		Select("Notes", "Practiced").
+		Updates(question).Error
+	if err != nil {
+		r.logger.Error("Failed to update interview question", zap.Error(err), zap.String("question_id", question.ID.String()))
+		return fmt.Errorf("failed to update interview question: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the practice set together with its questions.
+func (r *interviewPrepRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("practice_set_id = ?", id).Delete(&models.InterviewQuestion{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&models.InterviewPracticeSet{}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to delete interview practice set", zap.Error(err), zap.String("practice_set_id", id.String()))
+		return fmt.Errorf("failed to delete interview practice set: %w", err)
+	}
+	return nil
+}
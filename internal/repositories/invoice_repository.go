@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InvoiceRepository defines the interface for the local invoice history.
+type InvoiceRepository interface {
+	Upsert(ctx context.Context, invoice *models.Invoice) error
+	ListByStripeCustomerID(ctx context.Context, stripeCustomerID string, limit int) ([]models.Invoice, error)
+}
+
+type invoiceRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewInvoiceRepository creates a new InvoiceRepository.
+func NewInvoiceRepository(db *gorm.DB, logger *zap.Logger) InvoiceRepository {
+	return &invoiceRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// invoiceUpsertColumns are refreshed from Stripe when an invoice is stored again. status must
+// stay last: MySQL applies the assignments in order, and the others compare the stored status.
+var invoiceUpsertColumns = []string{
+	"user_id", "stripe_customer_id", "stripe_subscription_id", "number", "currency",
+	"amount_due", "amount_paid", "total", "hosted_invoice_url", "invoice_pdf",
+	"period_start", "period_end", "issued_at", "paid_at", "updated_at", "status",
+}
+
+// Upsert inserts the invoice or refreshes the stored copy when the Stripe invoice ID already exists.
+// Stripe does not deliver events in order, so an invoice stored as paid is never overwritten by an
+// older event (e.g. a late invoice.payment_failed).
+func (r *invoiceRepository) Upsert(ctx context.Context, invoice *models.Invoice) error {
+	assignments := make(clause.Set, 0, len(invoiceUpsertColumns))
+	for _, column := range invoiceUpsertColumns {
+		assignments = append(assignments, clause.Assignment{
+			Column: clause.Column{Name: column},
+			Value:  gorm.Expr(fmt.Sprintf("IF(status = ?, %[1]s, VALUES(%[1]s))", column), models.InvoiceStatusPaid),
+		})
+	}
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "stripe_invoice_id"}},
+		DoUpdates: assignments,
+	}).Create(invoice).Error
+	if err != nil {
+		r.logger.Error(
+			"Failed to upsert invoice",
+			zap.Error(err),
+			zap.String("stripe_invoice_id", invoice.StripeInvoiceID),
+		)
+		return fmt.Errorf("failed to upsert invoice: %w", err)
+	}
+	return nil
+}
+
+// ListByStripeCustomerID returns the customer's invoices, newest first.
+func (r *invoiceRepository) ListByStripeCustomerID(ctx context.Context, stripeCustomerID string, limit int) ([]models.Invoice, error) {
+	var invoices []models.Invoice
+	err := r.db.WithContext(ctx).
+		Where("stripe_customer_id = ?", stripeCustomerID).
+		Order("issued_at DESC").
+		Limit(limit).
+		Find(&invoices).Error
+	if err != nil {
+		r.logger.Error(
+			"Failed to list invoices",
+			zap.Error(err),
+			zap.String("stripe_customer_id", stripeCustomerID),
+		)
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	return invoices, nil
+}
@@ -0,0 +1,321 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewsletterCampaignStats counts the deliveries of a campaign by outcome.
+type NewsletterCampaignStats struct {
+	Recipients   int64
+	Sent         int64
+	Queued       int64
+	Failed       int64
+	Unsubscribed int64
+}
+
+// NewsletterRepository defines the interface for newsletter subscriptions, campaigns and deliveries
+type NewsletterRepository interface {
+	RequestSubscription(ctx context.Context, userID uuid.UUID, requestedAt time.Time) error
+	ConfirmSubscription(ctx context.Context, userID uuid.UUID, requestedAt, confirmedAt time.Time) (bool, error)
+	Unsubscribe(ctx context.Context, userID uuid.UUID, unsubscribedAt time.Time) (bool, error)
+	CreateCampaign(ctx context.Context, campaign *models.NewsletterCampaign) error
+	GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.NewsletterCampaign, error)
+	ListCampaigns(ctx context.Context) ([]models.NewsletterCampaign, error)
+	ListSendingCampaigns(ctx context.Context) ([]models.NewsletterCampaign, error)
+	StartCampaign(ctx context.Context, id uuid.UUID, startedAt time.Time) (bool, error)
+	CompleteCampaign(ctx context.Context, id uuid.UUID, completedAt time.Time) error
+	ListPendingRecipients(ctx context.Context, campaign *models.NewsletterCampaign, limit int) ([]models.User, error)
+	CreateDelivery(ctx context.Context, delivery *models.NewsletterDelivery) (bool, error)
+	SaveDelivery(ctx context.Context, delivery *models.NewsletterDelivery) error
+	SyncQueuedDeliveries(ctx context.Context) (int64, error)
+	MarkDeliveryUnsubscribed(ctx context.Context, campaignID, userID uuid.UUID, unsubscribedAt time.Time) error
+	GetCampaignStats(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]NewsletterCampaignStats, error)
+}
+
+type newsletterRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewNewsletterRepository creates a new NewsletterRepository
+func NewNewsletterRepository(db *gorm.DB, logger *zap.Logger) NewsletterRepository {
+	return &newsletterRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// RequestSubscription opts the user in, pending the confirmation identified by requestedAt
+func (r *newsletterRepository) RequestSubscription(ctx context.Context, userID uuid.UUID, requestedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.Configuration{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"newsletter":              true,
+			"newsletter_requested_at": requestedAt,
+			"newsletter_confirmed_at": nil,
+		}).Error
+	if err != nil {
+		r.logger.Error("Failed to request newsletter subscription", zap.Error(err), zap.String("user_id", userID.String()))
+		return fmt.Errorf("failed to request newsletter subscription: %w", err)
+	}
+	return nil
+}
+
+// ConfirmSubscription confirms the pending subscription requested at requestedAt. It returns
+// false when there is no such pending subscription: it was confirmed, cancelled or replaced
+// by a newer request.
+func (r *newsletterRepository) ConfirmSubscription(ctx context.Context, userID uuid.UUID, requestedAt, confirmedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Configuration{}).
+		Where("user_id = ? AND newsletter = ? AND newsletter_requested_at = ? AND newsletter_confirmed_at IS NULL", userID, true, requestedAt).
+		Update("newsletter_confirmed_at", confirmedAt)
+	if result.Error != nil {
+		r.logger.Error("Failed to confirm newsletter subscription", zap.Error(result.Error), zap.String("user_id", userID.String()))
+		return false, fmt.Errorf("failed to confirm newsletter subscription: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Unsubscribe opts the user out. It returns false when the user was not opted in.
+func (r *newsletterRepository) Unsubscribe(ctx context.Context, userID uuid.UUID, unsubscribedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Configuration{}).
+		Where("user_id = ? AND newsletter = ?", userID, true).
+		Updates(map[string]interface{}{
+			"newsletter":                 false,
+			"newsletter_requested_at":    nil,
+			"newsletter_confirmed_at":    nil,
+			"newsletter_unsubscribed_at": unsubscribedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to unsubscribe from newsletter", zap.Error(result.Error), zap.String("user_id", userID.String()))
+		return false, fmt.Errorf("failed to unsubscribe from newsletter: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *newsletterRepository) CreateCampaign(ctx context.Context, campaign *models.NewsletterCampaign) error {
+	if err := r.db.WithContext(ctx).Create(campaign).Error; err != nil {
+		r.logger.Error("Failed to create newsletter campaign", zap.Error(err))
+		return fmt.Errorf("failed to create newsletter campaign: %w", err)
+	}
+	return nil
+}
+
+// GetCampaignByID returns gorm.ErrRecordNotFound (wrapped) when the campaign does not exist.
+func (r *newsletterRepository) GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.NewsletterCampaign, error) {
+	var campaign models.NewsletterCampaign
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&campaign).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get newsletter campaign", zap.Error(err), zap.String("campaign_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get newsletter campaign %s: %w", id.String(), err)
+	}
+	return &campaign, nil
+}
+
+func (r *newsletterRepository) ListCampaigns(ctx context.Context) ([]models.NewsletterCampaign, error) {
+	var campaigns []models.NewsletterCampaign
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&campaigns).Error; err != nil {
+		r.logger.Error("Failed to list newsletter campaigns", zap.Error(err))
+		return nil, fmt.Errorf("failed to list newsletter campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// ListSendingCampaigns returns the campaigns being sent, oldest first
+func (r *newsletterRepository) ListSendingCampaigns(ctx context.Context) ([]models.NewsletterCampaign, error) {
+	var campaigns []models.NewsletterCampaign
+	err := r.db.WithContext(ctx).
+		Where("status = ?", models.NewsletterCampaignStatusSending).
+		Order("started_at ASC").
+		Find(&campaigns).Error
+	if err != nil {
+		r.logger.Error("Failed to list sending newsletter campaigns", zap.Error(err))
+		return nil, fmt.Errorf("failed to list sending newsletter campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// StartCampaign moves a draft campaign to sending. It returns false when the campaign is not a draft.
+func (r *newsletterRepository) StartCampaign(ctx context.Context, id uuid.UUID, startedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.NewsletterCampaign{}).
+		Where("id = ? AND status = ?", id, models.NewsletterCampaignStatusDraft).
+		Updates(map[string]interface{}{
+			"status":     models.NewsletterCampaignStatusSending,
+			"started_at": startedAt,
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to start newsletter campaign", zap.Error(result.Error), zap.String("campaign_id", id.String()))
+		return false, fmt.Errorf("failed to start newsletter campaign: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CompleteCampaign marks a campaign as sent once every recipient got a delivery
+func (r *newsletterRepository) CompleteCampaign(ctx context.Context, id uuid.UUID, completedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.NewsletterCampaign{}).
+		Where("id = ? AND status = ?", id, models.NewsletterCampaignStatusSending).
+		Updates(map[string]interface{}{
+			"status":       models.NewsletterCampaignStatusSent,
+			"completed_at": completedAt,
+		}).Error
+	if err != nil {
+		r.logger.Error("Failed to complete newsletter campaign", zap.Error(err), zap.String("campaign_id", id.String()))
+		return fmt.Errorf("failed to complete newsletter campaign: %w", err)
+	}
+	return nil
+}
+
+// ListPendingRecipients returns confirmed subscribers, with their configuration, who have no
+// delivery for the campaign yet
+func (r *newsletterRepository) ListPendingRecipients(ctx context.Context, campaign *models.NewsletterCampaign, limit int) ([]models.User, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Configuration").
+		Joins("JOIN configurations ON configurations.user_id = users.id AND configurations.deleted_at IS NULL").
+		Where("configurations.newsletter = ? AND configurations.newsletter_confirmed_at IS NOT NULL", true).
+		Where("NOT EXISTS (SELECT 1 FROM newsletter_deliveries WHERE newsletter_deliveries.campaign_id = ? AND newsletter_deliveries.user_id = users.id)", campaign.ID)
+	if campaign.Language != "" {
+		query = query.Where("configurations.language = ?", campaign.Language)
+	}
+
+	var users []models.User
+	if err := query.Order("users.id ASC").Limit(limit).Find(&users).Error; err != nil {
+		r.logger.Error("Failed to list newsletter recipients", zap.Error(err), zap.String("campaign_id", campaign.ID.String()))
+		return nil, fmt.Errorf("failed to list newsletter recipients: %w", err)
+	}
+	return users, nil
+}
+
+// CreateDelivery claims the campaign email of a user. It returns false when another worker
+// already created the delivery, so each user gets the campaign once.
+func (r *newsletterRepository) CreateDelivery(ctx context.Context, delivery *models.NewsletterDelivery) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(delivery)
+	if result.Error != nil {
+		r.logger.Error("Failed to create newsletter delivery", zap.Error(result.Error), zap.String("campaign_id", delivery.CampaignID.String()))
+		return false, fmt.Errorf("failed to create newsletter delivery: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *newsletterRepository) SaveDelivery(ctx context.Context, delivery *models.NewsletterDelivery) error {
+	if err := r.db.WithContext(ctx).Save(delivery).Error; err != nil {
+		r.logger.Error("Failed to save newsletter delivery", zap.Error(err), zap.String("delivery_id", delivery.ID.String()))
+		return fmt.Errorf("failed to save newsletter delivery: %w", err)
+	}
+	return nil
+}
+
+// SyncQueuedDeliveries gives queued deliveries the final status of their outbox email: sent
+// once the outbox delivered it, failed once it gave up. It returns how many were updated.
+func (r *newsletterRepository) SyncQueuedDeliveries(ctx context.Context) (int64, error) {
+	var updated int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		outcomes := []struct {
+			status  models.NewsletterDeliveryStatus
+			outbox  models.OutboxEmailStatus
+			columns map[string]any
+		}{
+			{
+				status: models.NewsletterDeliveryStatusSent,
+				outbox: models.OutboxEmailStatusSent,
+				columns: map[string]any{
+					"sent_at": gorm.Expr("(SELECT sent_at FROM outbox_emails WHERE outbox_emails.id = newsletter_deliveries.outbox_email_id)"),
+					"error":   "",
+				},
+			},
+			{
+				status: models.NewsletterDeliveryStatusFailed,
+				outbox: models.OutboxEmailStatusFailed,
+				columns: map[string]any{
+					"error": gorm.Expr("(SELECT last_error FROM outbox_emails WHERE outbox_emails.id = newsletter_deliveries.outbox_email_id)"),
+				},
+			},
+		}
+
+		for _, outcome := range outcomes {
+			outcome.columns["status"] = outcome.status
+			result := tx.Model(&models.NewsletterDelivery{}).
+				Where("status = ?", models.NewsletterDeliveryStatusQueued).
+				Where("outbox_email_id IN (?)", tx.Model(&models.OutboxEmail{}).Select("id").Where("status = ?", outcome.outbox)).
+				Updates(outcome.columns)
+			if result.Error != nil {
+				return result.Error
+			}
+			updated += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to sync queued newsletter deliveries", zap.Error(err))
+		return 0, fmt.Errorf("failed to sync queued newsletter deliveries: %w", err)
+	}
+	return updated, nil
+}
+
+// MarkDeliveryUnsubscribed records that the user unsubscribed through the campaign's email
+func (r *newsletterRepository) MarkDeliveryUnsubscribed(ctx context.Context, campaignID, userID uuid.UUID, unsubscribedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.NewsletterDelivery{}).
+		Where("campaign_id = ? AND user_id = ? AND unsubscribed_at IS NULL", campaignID, userID).
+		Update("unsubscribed_at", unsubscribedAt).Error
+	if err != nil {
+		r.logger.Error("Failed to mark newsletter delivery as unsubscribed", zap.Error(err), zap.String("campaign_id", campaignID.String()))
+		return fmt.Errorf("failed to mark newsletter delivery as unsubscribed: %w", err)
+	}
+	return nil
+}
+
+// GetCampaignStats counts the deliveries of the given campaigns. Campaigns without
+// deliveries are missing from the result.
+func (r *newsletterRepository) GetCampaignStats(ctx context.Context, campaignIDs []uuid.UUID) (map[uuid.UUID]NewsletterCampaignStats, error) {
+	stats := make(map[uuid.UUID]NewsletterCampaignStats, len(campaignIDs))
+	if len(campaignIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []struct {
+		CampaignID   uuid.UUID
+		Status       models.NewsletterDeliveryStatus
+		Count        int64
+		Unsubscribed int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.NewsletterDelivery{}).
+		Select("campaign_id, status, COUNT(*) AS count, COUNT(unsubscribed_at) AS unsubscribed").
+		Where("campaign_id IN ?", campaignIDs).
+		Group("campaign_id, status").
+		Scan(&rows).Error
+	if err != nil {
+		r.logger.Error("Failed to count newsletter deliveries", zap.Error(err))
+		return nil, fmt.Errorf("failed to count newsletter deliveries: %w", err)
+	}
+
+	for _, row := range rows {
+		campaignStats := stats[row.CampaignID]
+		campaignStats.Recipients += row.Count
+		campaignStats.Unsubscribed += row.Unsubscribed
+		switch row.Status {
+		case models.NewsletterDeliveryStatusSent:
+			campaignStats.Sent += row.Count
+		case models.NewsletterDeliveryStatusQueued:
+			campaignStats.Queued += row.Count
+		case models.NewsletterDeliveryStatusFailed:
+			campaignStats.Failed += row.Count
+		}
+		stats[row.CampaignID] = campaignStats
+	}
+	return stats, nil
+}
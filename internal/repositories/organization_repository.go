@@ -0,0 +1,261 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrganizationRepository defines the interface for organization, membership and invitation data operations.
+type OrganizationRepository interface {
+	CreateWithOwner(ctx context.Context, organization *models.Organization, owner *models.OrganizationMember) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Organization, error)
+	GetByStripeSubscriptionID(ctx context.Context, stripeSubscriptionID string) (*models.Organization, error)
+	Save(ctx context.Context, organization *models.Organization) error
+	GetMembershipByUserID(ctx context.Context, userID uuid.UUID) (*models.OrganizationMember, error)
+	ListMembers(ctx context.Context, organizationID uuid.UUID) ([]models.OrganizationMember, error)
+	CountMembers(ctx context.Context, organizationID uuid.UUID) (int64, error)
+	SaveMember(ctx context.Context, member *models.OrganizationMember) error
+	DeleteMember(ctx context.Context, member *models.OrganizationMember) error
+	CreateInvitation(ctx context.Context, invitation *models.OrganizationInvitation) error
+	GetInvitationByID(ctx context.Context, organizationID, invitationID uuid.UUID) (*models.OrganizationInvitation, error)
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.OrganizationInvitation, error)
+	ListPendingInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]models.OrganizationInvitation, error)
+	CountPendingInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) (int64, error)
+	SaveInvitation(ctx context.Context, invitation *models.OrganizationInvitation) error
+	AcceptInvitation(ctx context.Context, invitation *models.OrganizationInvitation, member *models.OrganizationMember) (bool, error)
+}
+
+type organizationRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewOrganizationRepository creates a new OrganizationRepository.
+func NewOrganizationRepository(db *gorm.DB, logger *zap.Logger) OrganizationRepository {
+	return &organizationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateWithOwner creates the organization and its owner membership in one transaction.
+func (r *organizationRepository) CreateWithOwner(ctx context.Context, organization *models.Organization, owner *models.OrganizationMember) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(organization).Error; err != nil {
+			return err
+		}
+		owner.OrganizationID = organization.ID
+		return tx.Omit("Organization", "User").Create(owner).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to create organization", zap.Error(err), zap.String("owner_user_id", organization.OwnerUserID.String()))
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns gorm.ErrRecordNotFound (wrapped) when the organization does not exist.
+func (r *organizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	var organization models.Organization
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&organization).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get organization", zap.Error(err), zap.String("organization_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get organization %s: %w", id.String(), err)
+	}
+	return &organization, nil
+}
+
+// GetByStripeSubscriptionID returns nil, nil when no organization is billed through the subscription.
+func (r *organizationRepository) GetByStripeSubscriptionID(ctx context.Context, stripeSubscriptionID string) (*models.Organization, error) {
+	var organization models.Organization
+	err := r.db.WithContext(ctx).Where("stripe_subscription_id = ?", stripeSubscriptionID).First(&organization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get organization by stripe subscription", zap.Error(err), zap.String("stripe_subscription_id", stripeSubscriptionID))
+		return nil, fmt.Errorf("failed to get organization by stripe subscription: %w", err)
+	}
+	return &organization, nil
+}
+
+func (r *organizationRepository) Save(ctx context.Context, organization *models.Organization) error {
+	if err := r.db.WithContext(ctx).Save(organization).Error; err != nil {
+		r.logger.Error("Failed to save organization", zap.Error(err), zap.String("organization_id", organization.ID.String()))
+		return fmt.Errorf("failed to save organization: %w", err)
+	}
+	return nil
+}
+
+// GetMembershipByUserID returns the user's membership with its organization loaded, or nil, nil if the user has none.
+func (r *organizationRepository) GetMembershipByUserID(ctx context.Context, userID uuid.UUID) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	err := r.db.WithContext(ctx).Preload("Organization").Where("user_id = ?", userID).First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get organization membership", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+	return &member, nil
+}
+
+// ListMembers returns the organization's members with their users loaded, oldest first.
+func (r *organizationRepository) ListMembers(ctx context.Context, organizationID uuid.UUID) ([]models.OrganizationMember, error) {
+	var members []models.OrganizationMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("organization_id = ?", organizationID).
+		Order("created_at ASC").
+		Find(&members).Error
+	if err != nil {
+		r.logger.Error("Failed to list organization members", zap.Error(err), zap.String("organization_id", organizationID.String()))
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return members, nil
+}
+
+func (r *organizationRepository) CountMembers(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.OrganizationMember{}).Where("organization_id = ?", organizationID).Count(&count).Error; err != nil {
+		r.logger.Error("Failed to count organization members", zap.Error(err), zap.String("organization_id", organizationID.String()))
+		return 0, fmt.Errorf("failed to count organization members: %w", err)
+	}
+	return count, nil
+}
+
+func (r *organizationRepository) SaveMember(ctx context.Context, member *models.OrganizationMember) error {
+	if err := r.db.WithContext(ctx).Omit("Organization", "User").Save(member).Error; err != nil {
+		r.logger.Error("Failed to save organization member", zap.Error(err), zap.String("member_id", member.ID.String()))
+		return fmt.Errorf("failed to save organization member: %w", err)
+	}
+	return nil
+}
+
+// DeleteMember hard-deletes the membership so the user can join another organization.
+func (r *organizationRepository) DeleteMember(ctx context.Context, member *models.OrganizationMember) error {
+	if err := r.db.WithContext(ctx).Unscoped().Delete(member).Error; err != nil {
+		r.logger.Error("Failed to delete organization member", zap.Error(err), zap.String("member_id", member.ID.String()))
+		return fmt.Errorf("failed to delete organization member: %w", err)
+	}
+	return nil
+}
+
+func (r *organizationRepository) CreateInvitation(ctx context.Context, invitation *models.OrganizationInvitation) error {
+	if err := r.db.WithContext(ctx).Create(invitation).Error; err != nil {
+		r.logger.Error("Failed to create organization invitation", zap.Error(err), zap.String("organization_id", invitation.OrganizationID.String()))
+		return fmt.Errorf("failed to create organization invitation: %w", err)
+	}
+	return nil
+}
+
+// GetInvitationByID returns gorm.ErrRecordNotFound (wrapped) when the invitation does not belong to the organization.
+func (r *organizationRepository) GetInvitationByID(ctx context.Context, organizationID, invitationID uuid.UUID) (*models.OrganizationInvitation, error) {
+	var invitation models.OrganizationInvitation
+	err := r.db.WithContext(ctx).Where("id = ? AND organization_id = ?", invitationID, organizationID).First(&invitation).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get organization invitation", zap.Error(err), zap.String("invitation_id", invitationID.String()))
+		}
+		return nil, fmt.Errorf("failed to get organization invitation %s: %w", invitationID.String(), err)
+	}
+	return &invitation, nil
+}
+
+// GetInvitationByTokenHash returns gorm.ErrRecordNotFound (wrapped) when no invitation matches the token.
+func (r *organizationRepository) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.OrganizationInvitation, error) {
+	var invitation models.OrganizationInvitation
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&invitation).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get organization invitation by token", zap.Error(err))
+		}
+		return nil, fmt.Errorf("failed to get organization invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+func (r *organizationRepository) pendingInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&models.OrganizationInvitation{}).
+		Where("organization_id = ?", organizationID).
+		Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?", now)
+}
+
+func (r *organizationRepository) ListPendingInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) ([]models.OrganizationInvitation, error) {
+	var invitations []models.OrganizationInvitation
+	if err := r.pendingInvitations(ctx, organizationID, now).Order("created_at DESC").Find(&invitations).Error; err != nil {
+		r.logger.Error("Failed to list organization invitations", zap.Error(err), zap.String("organization_id", organizationID.String()))
+		return nil, fmt.Errorf("failed to list organization invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+func (r *organizationRepository) CountPendingInvitations(ctx context.Context, organizationID uuid.UUID, now time.Time) (int64, error) {
+	var count int64
+	if err := r.pendingInvitations(ctx, organizationID, now).Count(&count).Error; err != nil {
+		r.logger.Error("Failed to count organization invitations", zap.Error(err), zap.String("organization_id", organizationID.String()))
+		return 0, fmt.Errorf("failed to count organization invitations: %w", err)
+	}
+	return count, nil
+}
+
+func (r *organizationRepository) SaveInvitation(ctx context.Context, invitation *models.OrganizationInvitation) error {
+	if err := r.db.WithContext(ctx).Save(invitation).Error; err != nil {
+		r.logger.Error("Failed to save organization invitation", zap.Error(err), zap.String("invitation_id", invitation.ID.String()))
+		return fmt.Errorf("failed to save organization invitation: %w", err)
+	}
+	return nil
+}
+
+// AcceptInvitation marks the invitation accepted and creates the membership in one transaction.
+// The conditional update prevents the same invitation from being accepted twice. The seats are
+// counted with the organization row locked, so concurrent acceptances cannot exceed them; it
+// returns false, without changes, when every seat is taken.
+func (r *organizationRepository) AcceptInvitation(ctx context.Context, invitation *models.OrganizationInvitation, member *models.OrganizationMember) (bool, error) {
+	accepted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var organization models.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", member.OrganizationID).
+			First(&organization).Error; err != nil {
+			return err
+		}
+		var members int64
+		if err := tx.Model(&models.OrganizationMember{}).Where("organization_id = ?", member.OrganizationID).Count(&members).Error; err != nil {
+			return err
+		}
+		if members >= int64(organization.Seats) {
+			return nil
+		}
+
+		result := tx.Model(&models.OrganizationInvitation{}).
+			Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", invitation.ID).
+			Update("accepted_at", invitation.AcceptedAt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("invitation is no longer pending")
+		}
+		if err := tx.Omit("Organization", "User").Create(member).Error; err != nil {
+			return err
+		}
+		accepted = true
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to accept organization invitation", zap.Error(err), zap.String("invitation_id", invitation.ID.String()))
+		return false, fmt.Errorf("failed to accept organization invitation: %w", err)
+	}
+	return accepted, nil
+}
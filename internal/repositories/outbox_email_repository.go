@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// OutboxEmailRepository defines the interface for the email outbox data operations
+type OutboxEmailRepository interface {
+	Create(ctx context.Context, outboxEmail *models.OutboxEmail) error
+	ListDue(ctx context.Context, now time.Time, limit int) ([]models.OutboxEmail, error)
+	Claim(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time) (bool, error)
+	MarkSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+	RecordFailure(ctx context.Context, id uuid.UUID, status models.OutboxEmailStatus, lastError string) error
+}
+
+type outboxEmailRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewOutboxEmailRepository creates a new OutboxEmailRepository
+func NewOutboxEmailRepository(db *gorm.DB, logger *zap.Logger) OutboxEmailRepository {
+	return &outboxEmailRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a message for a later delivery attempt
+func (r *outboxEmailRepository) Create(ctx context.Context, outboxEmail *models.OutboxEmail) error {
+	if err := r.db.WithContext(ctx).Create(outboxEmail).Error; err != nil {
+		r.logger.Error("Failed to create outbox email", zap.Error(err), zap.String("template", outboxEmail.Template))
+		return fmt.Errorf("failed to create outbox email: %w", err)
+	}
+	return nil
+}
+
+// ListDue returns pending messages whose next attempt is due, oldest first
+func (r *outboxEmailRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]models.OutboxEmail, error) {
+	var outboxEmails []models.OutboxEmail
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", models.OutboxEmailStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&outboxEmails).Error
+	if err != nil {
+		r.logger.Error("Failed to list due outbox emails", zap.Error(err))
+		return nil, fmt.Errorf("failed to list due outbox emails: %w", err)
+	}
+	return outboxEmails, nil
+}
+
+// Claim counts a new attempt for a pending message and schedules the one after it. It returns
+// false when another worker already claimed the message (its attempts no longer match), so
+// each attempt is made once.
+func (r *outboxEmailRepository) Claim(ctx context.Context, id uuid.UUID, attempts int, nextAttemptAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.OutboxEmail{}).
+		Where("id = ? AND status = ? AND attempts = ?", id, models.OutboxEmailStatusPending, attempts).
+		Updates(map[string]interface{}{
+			"attempts":        attempts + 1,
+			"next_attempt_at": nextAttemptAt,
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to claim outbox email", zap.Error(result.Error), zap.String("outbox_email_id", id.String()))
+		return false, fmt.Errorf("failed to claim outbox email: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// MarkSent records the successful delivery of a message and drops its content, which is
+// no longer needed
+func (r *outboxEmailRepository) MarkSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.OutboxEmail{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     models.OutboxEmailStatusSent,
+			"sent_at":    sentAt,
+			"last_error": "",
+			"html":       "",
+			"text":       "",
+			"headers":    "",
+		}).Error
+	if err != nil {
+		r.logger.Error("Failed to mark outbox email as sent", zap.Error(err), zap.String("outbox_email_id", id.String()))
+		return fmt.Errorf("failed to mark outbox email as sent: %w", err)
+	}
+	return nil
+}
+
+// RecordFailure stores the error of a failed attempt; status is pending to retry the message
+// and failed to give up on it, in which case its content is dropped
+func (r *outboxEmailRepository) RecordFailure(ctx context.Context, id uuid.UUID, status models.OutboxEmailStatus, lastError string) error {
+	updates := map[string]interface{}{
+		"status":     status,
+		"last_error": lastError,
+	}
+	if status == models.OutboxEmailStatusFailed {
+		updates["html"] = ""
+		updates["text"] = ""
+		updates["headers"] = ""
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.OutboxEmail{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+	if err != nil {
+		r.logger.Error("Failed to record outbox email failure", zap.Error(err), zap.String("outbox_email_id", id.String()))
+		return fmt.Errorf("failed to record outbox email failure: %w", err)
+	}
+	return nil
+}
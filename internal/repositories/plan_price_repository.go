@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// PlanPriceRepository defines the interface for the database-backed price catalog.
+type PlanPriceRepository interface {
+	ListActive(ctx context.Context) ([]models.PlanPrice, error)
+}
+
+type planPriceRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewPlanPriceRepository creates a new PlanPriceRepository.
+func NewPlanPriceRepository(db *gorm.DB, logger *zap.Logger) PlanPriceRepository {
+	return &planPriceRepository{db: db, logger: logger}
+}
+
+// ListActive returns every active plan price.
+func (r *planPriceRepository) ListActive(ctx context.Context) ([]models.PlanPrice, error) {
+	var prices []models.PlanPrice
+	if err := r.db.WithContext(ctx).Where("active = ?", true).Find(&prices).Error; err != nil {
+		r.logger.Error("Failed to list active plan prices", zap.Error(err))
+		return nil, fmt.Errorf("failed to list active plan prices: %w", err)
+	}
+	return prices, nil
+}
@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ReferralRepository defines the interface for referral campaign and reward data operations.
+type ReferralRepository interface {
+	CreateCampaign(ctx context.Context, campaign *models.ReferralCampaign) error
+	GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.ReferralCampaign, error)
+	ListCampaigns(ctx context.Context) ([]models.ReferralCampaign, error)
+	SaveCampaign(ctx context.Context, campaign *models.ReferralCampaign) error
+	GetRunningCampaign(ctx context.Context, now time.Time) (*models.ReferralCampaign, error)
+	GetRewardByReferredUserID(ctx context.Context, referredUserID uuid.UUID) (*models.ReferralReward, error)
+	CreateReward(ctx context.Context, reward *models.ReferralReward) error
+	SaveReward(ctx context.Context, reward *models.ReferralReward) error
+	GrantAICreditsReward(ctx context.Context, reward *models.ReferralReward, grantedAt time.Time) (bool, error)
+	ListRewardsByReferrer(ctx context.Context, referrerID uuid.UUID) ([]models.ReferralReward, error)
+}
+
+type referralRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewReferralRepository creates a new ReferralRepository.
+func NewReferralRepository(db *gorm.DB, logger *zap.Logger) ReferralRepository {
+	return &referralRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *referralRepository) CreateCampaign(ctx context.Context, campaign *models.ReferralCampaign) error {
+	if err := r.db.WithContext(ctx).Create(campaign).Error; err != nil {
+		r.logger.Error("Failed to create referral campaign", zap.Error(err), zap.String("name", campaign.Name))
+		return fmt.Errorf("failed to create referral campaign: %w", err)
+	}
+	return nil
+}
+
+// GetCampaignByID returns gorm.ErrRecordNotFound (wrapped) when the campaign does not exist.
+func (r *referralRepository) GetCampaignByID(ctx context.Context, id uuid.UUID) (*models.ReferralCampaign, error) {
+	var campaign models.ReferralCampaign
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&campaign).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get referral campaign", zap.Error(err), zap.String("campaign_id", id.String()))
+		}
+		return nil, fmt.Errorf("failed to get referral campaign %s: %w", id.String(), err)
+	}
+	return &campaign, nil
+}
+
+func (r *referralRepository) ListCampaigns(ctx context.Context) ([]models.ReferralCampaign, error) {
+	var campaigns []models.ReferralCampaign
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&campaigns).Error; err != nil {
+		r.logger.Error("Failed to list referral campaigns", zap.Error(err))
+		return nil, fmt.Errorf("failed to list referral campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+func (r *referralRepository) SaveCampaign(ctx context.Context, campaign *models.ReferralCampaign) error {
+	if err := r.db.WithContext(ctx).Save(campaign).Error; err != nil {
+		r.logger.Error("Failed to save referral campaign", zap.Error(err), zap.String("campaign_id", campaign.ID.String()))
+		return fmt.Errorf("failed to save referral campaign: %w", err)
+	}
+	return nil
+}
+
+// GetRunningCampaign returns the most recent active campaign within its date window, or nil if none.
+func (r *referralRepository) GetRunningCampaign(ctx context.Context, now time.Time) (*models.ReferralCampaign, error) {
+	var campaign models.ReferralCampaign
+	err := r.db.WithContext(ctx).
+		Where("active = ?", true).
+		Where("starts_at IS NULL OR starts_at <= ?", now).
+		Where("ends_at IS NULL OR ends_at >= ?", now).
+		Order("created_at DESC").
+		First(&campaign).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get running referral campaign", zap.Error(err))
+		return nil, fmt.Errorf("failed to get running referral campaign: %w", err)
+	}
+	return &campaign, nil
+}
+
+// GetRewardByReferredUserID returns nil, nil when the referred user has not produced a reward yet.
+func (r *referralRepository) GetRewardByReferredUserID(ctx context.Context, referredUserID uuid.UUID) (*models.ReferralReward, error) {
+	var reward models.ReferralReward
+	err := r.db.WithContext(ctx).Where("referred_user_id = ?", referredUserID).First(&reward).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		r.logger.Error("Failed to get referral reward", zap.Error(err), zap.String("referred_user_id", referredUserID.String()))
+		return nil, fmt.Errorf("failed to get referral reward: %w", err)
+	}
+	return &reward, nil
+}
+
+func (r *referralRepository) CreateReward(ctx context.Context, reward *models.ReferralReward) error {
+	if err := r.db.WithContext(ctx).Create(reward).Error; err != nil {
+		r.logger.Error("Failed to create referral reward", zap.Error(err), zap.String("referred_user_id", reward.ReferredUserID.String()))
+		return fmt.Errorf("failed to create referral reward: %w", err)
+	}
+	return nil
+}
+
+func (r *referralRepository) SaveReward(ctx context.Context, reward *models.ReferralReward) error {
+	if err := r.db.WithContext(ctx).Save(reward).Error; err != nil {
+		r.logger.Error("Failed to save referral reward", zap.Error(err), zap.String("reward_id", reward.ID.String()))
+		return fmt.Errorf("failed to save referral reward: %w", err)
+	}
+	return nil
+}
+
+// GrantAICreditsReward marks a pending AI credits reward as granted and credits the referrer's
+// subscription in one transaction. It returns false when the reward was no longer pending, so
+// concurrent or retried webhook deliveries credit the referrer once.
+func (r *referralRepository) GrantAICreditsReward(ctx context.Context, reward *models.ReferralReward, grantedAt time.Time) (bool, error) {
+	granted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ReferralReward{}).
+			Where("id = ? AND status = ?", reward.ID, models.ReferralRewardStatusPending).
+			Updates(map[string]interface{}{
+				"status":     models.ReferralRewardStatusGranted,
+				"granted_at": grantedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		result = tx.Model(&models.Subscription{}).
+			Where("user_id = ?", reward.ReferrerUserID).
+			Update("bonus_ai_requests", gorm.Expr("bonus_ai_requests + ?", reward.RewardCredits))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("subscription for user %s: %w", reward.ReferrerUserID.String(), gorm.ErrRecordNotFound)
+		}
+		granted = true
+		return nil
+	})
+	if err != nil {
+		r.logger.Error("Failed to grant referral reward", zap.Error(err), zap.String("reward_id", reward.ID.String()))
+		return false, fmt.Errorf("failed to grant referral reward: %w", err)
+	}
+	return granted, nil
+}
+
+func (r *referralRepository) ListRewardsByReferrer(ctx context.Context, referrerID uuid.UUID) ([]models.ReferralReward, error) {
+	var rewards []models.ReferralReward
+	if err := r.db.WithContext(ctx).Where("referrer_user_id = ?", referrerID).Order("created_at DESC").Find(&rewards).Error; err != nil {
+		r.logger.Error("Failed to list referral rewards", zap.Error(err), zap.String("referrer_user_id", referrerID.String()))
+		return nil, fmt.Errorf("failed to list referral rewards: %w", err)
+	}
+	return rewards, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	"github.com/google/uuid"
@@ -18,6 +19,14 @@ type SubscriptionRepository interface {
 	GetByStripeCustomerID(ctx context.Context, stripeCustomerID string) (*models.Subscription, error)
 	GetByStripeSubscriptionID(ctx context.Context, stripeSubscriptionID string) (*models.Subscription, error)
 	Save(ctx context.Context, subscription *models.Subscription) error
+	ListTrialsEndingBefore(ctx context.Context, before time.Time) ([]models.Subscription, error)
+	ListExpiredLocalTrials(ctx context.Context, now time.Time) ([]models.Subscription, error)
+	ListPastDueSince(ctx context.Context, since time.Time) ([]models.Subscription, error)
+	MarkTrialReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error)
+	MarkPaymentFailedReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error)
+	ReleaseTrialReminder(ctx context.Context, id uuid.UUID) error
+	ReleasePaymentFailedReminder(ctx context.Context, id uuid.UUID) error
+	ConsumeBonusAIRequest(ctx context.Context, userID uuid.UUID) (bool, error)
 }
 
 type subscriptionRepository struct {
@@ -106,3 +115,115 @@ func (r *subscriptionRepository) Save(ctx context.Context, subscription *models.
 	}
 	return nil
 }
+
+// ListTrialsEndingBefore returns trialing subscriptions ending before the given time that were not reminded yet.
+func (r *subscriptionRepository) ListTrialsEndingBefore(ctx context.Context, before time.Time) ([]models.Subscription, error) {
+	var subscriptions []models.Subscription
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND trial_ends_at IS NOT NULL AND trial_ends_at <= ? AND trial_reminder_sent_at IS NULL", models.SubscriptionStatusTrialing, before).
+		Find(&subscriptions).Error
+	if err != nil {
+		r.logger.Error("Failed to list trials ending soon", zap.Error(err))
+		return nil, fmt.Errorf("failed to list trials ending soon: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// ListExpiredLocalTrials returns trials started without Stripe whose end date has passed.
+func (r *subscriptionRepository) ListExpiredLocalTrials(ctx context.Context, now time.Time) ([]models.Subscription, error) {
+	var subscriptions []models.Subscription
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND stripe_subscription_id IS NULL AND trial_ends_at IS NOT NULL AND trial_ends_at < ?", models.SubscriptionStatusTrialing, now).
+		Find(&subscriptions).Error
+	if err != nil {
+		r.logger.Error("Failed to list expired trials", zap.Error(err))
+		return nil, fmt.Errorf("failed to list expired trials: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// ListPastDueSince returns past_due subscriptions that failed payment before the given time and were not reminded yet.
+func (r *subscriptionRepository) ListPastDueSince(ctx context.Context, since time.Time) ([]models.Subscription, error) {
+	var subscriptions []models.Subscription
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND past_due_since IS NOT NULL AND past_due_since <= ? AND payment_failed_reminder_sent_at IS NULL", models.SubscriptionStatusPastDue, since).
+		Find(&subscriptions).Error
+	if err != nil {
+		r.logger.Error("Failed to list past due subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("failed to list past due subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// MarkTrialReminderSent claims the trial reminder for a subscription. It returns false
+// when another worker already claimed it, so each reminder is sent once.
+func (r *subscriptionRepository) MarkTrialReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+	return r.claimReminder(ctx, id, "trial_reminder_sent_at", sentAt)
+}
+
+// MarkPaymentFailedReminderSent claims the payment failure reminder for a subscription.
+func (r *subscriptionRepository) MarkPaymentFailedReminderSent(ctx context.Context, id uuid.UUID, sentAt time.Time) (bool, error) {
+	return r.claimReminder(ctx, id, "payment_failed_reminder_sent_at", sentAt)
+}
+
+func (r *subscriptionRepository) claimReminder(ctx context.Context, id uuid.UUID, column string, sentAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Subscription{}).
+		Where("id = ? AND "+column+" IS NULL", id).
+		Update(column, sentAt)
+	if result.Error != nil {
+		r.logger.Error(
+			"Failed to mark subscription reminder as sent",
+			zap.Error(result.Error),
+			zap.String("subscription_id", id.String()),
+			zap.String("column", column),
+		)
+		return false, fmt.Errorf("failed to mark subscription reminder as sent: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseTrialReminder undoes MarkTrialReminderSent after the reminder could not be sent,
+// so the next run tries again.
+func (r *subscriptionRepository) ReleaseTrialReminder(ctx context.Context, id uuid.UUID) error {
+	return r.releaseReminder(ctx, id, "trial_reminder_sent_at")
+}
+
+// ReleasePaymentFailedReminder undoes MarkPaymentFailedReminderSent.
+func (r *subscriptionRepository) ReleasePaymentFailedReminder(ctx context.Context, id uuid.UUID) error {
+	return r.releaseReminder(ctx, id, "payment_failed_reminder_sent_at")
+}
+
+func (r *subscriptionRepository) releaseReminder(ctx context.Context, id uuid.UUID, column string) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.Subscription{}).
+		Where("id = ?", id).
+		Update(column, nil).Error
+	if err != nil {
+		r.logger.Error(
+			"Failed to release subscription reminder",
+			zap.Error(err),
+			zap.String("subscription_id", id.String()),
+			zap.String("column", column),
+		)
+		return fmt.Errorf("failed to release subscription reminder: %w", err)
+	}
+	return nil
+}
+
+// ConsumeBonusAIRequest atomically spends one bonus AI request. It returns false when none are left.
+func (r *subscriptionRepository) ConsumeBonusAIRequest(ctx context.Context, userID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.Subscription{}).
+		Where("user_id = ? AND bonus_ai_requests > 0", userID).
+		Update("bonus_ai_requests", gorm.Expr("bonus_ai_requests - 1"))
+	if result.Error != nil {
+		r.logger.Error(
+			"Failed to consume bonus AI request",
+			zap.Error(result.Error),
+			zap.String("user_id", userID.String()),
+		)
+		return false, fmt.Errorf("failed to consume bonus AI request: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
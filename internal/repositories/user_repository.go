@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
@@ -15,6 +16,10 @@ type UserRepository interface {
 	Create(ctx context.Context, user *models.User) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByIDWithConfiguration(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByReferralCode(ctx context.Context, code string) (*models.User, error)
+	CountReferredBy(ctx context.Context, referrerID uuid.UUID) (int64, error)
+	SetReferralCode(ctx context.Context, id uuid.UUID, code string) error
 	GetAll(ctx context.Context) ([]models.User, error)
 	GetPageAfterID(ctx context.Context, afterID *uuid.UUID, limit int) ([]models.User, bool, error)
 	Count(ctx context.Context) (int64, error)
@@ -57,6 +62,18 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Use
 	return &user, nil
 }
 
+// GetByIDWithConfiguration retrieves a user by ID with their configuration preloaded
+// (e.g. to address emails in the user's language)
+func (r *userRepository) GetByIDWithConfiguration(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Preload("Configuration").Where("id = ?", id).First(&user).Error
+	if err != nil {
+		r.logger.Error("Failed to get user with configuration by ID", zap.Error(err), zap.String("user_id", id.String()))
+		return nil, fmt.Errorf("failed to get user with configuration by ID %s: %w", id.String(), err)
+	}
+	return &user, nil
+}
+
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
@@ -68,6 +85,43 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*models.
 	return &user, nil
 }
 
+// GetByReferralCode retrieves a user by their referral code
+func (r *userRepository) GetByReferralCode(ctx context.Context, code string) (*models.User, error) {
+	var user models.User
+	err := r.db.WithContext(ctx).Where("referral_code = ?", code).First(&user).Error
+	if err != nil {
+		// Unknown codes are expected (typos, code generation collision checks), so only log real failures.
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.Error("Failed to get user by referral code", zap.Error(err), zap.String("referral_code", code))
+		}
+		return nil, fmt.Errorf("failed to get user by referral code %s: %w", code, err)
+	}
+	return &user, nil
+}
+
+// CountReferredBy returns how many users signed up with the referrer's code
+func (r *userRepository) CountReferredBy(ctx context.Context, referrerID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.User{}).Where("referred_by_user_id = ?", referrerID).Count(&count).Error
+	if err != nil {
+		r.logger.Error("Failed to count referred users", zap.Error(err), zap.String("user_id", referrerID.String()))
+		return 0, fmt.Errorf("failed to count referred users: %w", err)
+	}
+	return count, nil
+}
+
+// SetReferralCode assigns a referral code to a user that does not have one yet
+func (r *userRepository) SetReferralCode(ctx context.Context, id uuid.UUID, code string) error {
+	err := r.db.WithContext(ctx).Model(&models.User{}).
+		Where("id = ? AND referral_code IS NULL", id).
+		Update("referral_code", code).Error
+	if err != nil {
+		r.logger.Error("Failed to set referral code", zap.Error(err), zap.String("user_id", id.String()))
+		return fmt.Errorf("failed to set referral code: %w", err)
+	}
+	return nil
+}
+
 // GetAll retrieves all users from the database
 func (r *userRepository) GetAll(ctx context.Context) ([]models.User, error) {
 	var users []models.User
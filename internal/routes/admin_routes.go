@@ -13,11 +13,17 @@ import (
 
 // SetupAdminRoutes configures admin (back office) routes.
 // Double protection: X-Static-Token (trusted client) then Authorization Bearer session token; user must be admin.
-func SetupAdminRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, sessionRepo repositories.SessionRepository) {
+func SetupAdminRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, sessionRepo repositories.SessionRepository, referralUseCase usecases.ReferralUseCase, newsletterUseCase usecases.NewsletterUseCase) {
 	userRepo := repositories.NewUserRepository(db, logger)
 	curriculumRepo := repositories.NewCurriculumRepository(db, logger)
 	adminUseCase := usecases.NewAdminUseCase(userRepo, curriculumRepo, logger)
 	adminHandler := handlers.NewAdminHandler(adminUseCase, logger)
+	referralHandler := handlers.NewReferralHandler(referralUseCase, logger)
+	newsletterHandler := handlers.NewNewsletterHandler(newsletterUseCase, logger)
+	aiGenerationHandler := handlers.NewAIGenerationHandler(
+		usecases.NewAIGenerationUseCase(repositories.NewAIGenerationRepository(db, logger), logger),
+		logger,
+	)
 
 	admin := router.Group(
 		"/api/v1/admin",
@@ -37,5 +43,19 @@ func SetupAdminRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *
 		// Curriculums: register stats before list
 		admin.GET("/curriculums/stats", adminHandler.GetCurriculumsStats)
 		admin.GET("/curriculums", adminHandler.GetCurriculums)
+
+		// Referral campaigns
+		admin.GET("/referral-campaigns", referralHandler.ListCampaigns)
+		admin.POST("/referral-campaigns", referralHandler.CreateCampaign)
+		admin.PATCH("/referral-campaigns/:id/deactivate", referralHandler.DeactivateCampaign)
+
+		// Newsletter campaigns
+		admin.GET("/newsletter/campaigns", newsletterHandler.ListCampaigns)
+		admin.POST("/newsletter/campaigns", newsletterHandler.CreateCampaign)
+		admin.GET("/newsletter/campaigns/:id", newsletterHandler.GetCampaign)
+		admin.POST("/newsletter/campaigns/:id/send", newsletterHandler.SendCampaign)
+
+		// Feedback on AI generation alternatives (prompt quality analytics)
+		admin.GET("/ai-generations/stats", aiGenerationHandler.GetStats)
 	}
 }
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SetupAIGenerationRoutes configures the route to send feedback on the alternatives of an AI generation
+func SetupAIGenerationRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, aiGenerationUseCase usecases.AIGenerationUseCase) {
+	aiGenerationHandler := handlers.NewAIGenerationHandler(aiGenerationUseCase, logger)
+
+	aiGenerations := router.Group("/api/v1/ai-generations", authMiddleware)
+	{
+		aiGenerations.POST("/:generation_id/feedback", aiGenerationHandler.SubmitFeedback)
+	}
+}
@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupApplicationRoutes configures the job application tracker routes
+func SetupApplicationRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	// Follow-up reminders are emailed by the background job; the HTTP routes never send email.
+	applicationUseCase := usecases.NewApplicationUseCase(
+		repositories.NewApplicationRepository(db, logger),
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		nil,
+		logger,
+	)
+	applicationHandler := handlers.NewApplicationHandler(applicationUseCase, logger)
+
+	applications := router.Group("/api/v1/applications", authMiddleware)
+	{
+		applications.POST("", applicationHandler.CreateApplication)
+		applications.GET("", applicationHandler.ListApplications)
+		applications.GET("/stats", applicationHandler.GetApplicationStats)
+		applications.GET("/follow-ups", applicationHandler.ListFollowUpsDue)
+		applications.GET("/:application_id", applicationHandler.GetApplication)
+		applications.PUT("/:application_id", applicationHandler.UpdateApplication)
+		applications.DELETE("/:application_id", applicationHandler.DeleteApplication)
+		applications.PATCH("/:application_id/status", applicationHandler.UpdateApplicationStatus)
+		applications.GET("/:application_id/history", applicationHandler.ListApplicationHistory)
+	}
+}
@@ -12,13 +12,13 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupConfigurationRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+func SetupConfigurationRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, newsletterUseCase usecases.NewsletterUseCase) {
 	// Initialize cache service
 	cacheService := cache.NewCacheService(redis.GetClient(), logger)
 
 	// Initialize configuration dependencies
 	configurationRepo := repositories.NewConfigurationRepository(db, logger)
-	configurationUseCase := usecases.NewConfigurationUseCase(configurationRepo, cacheService, logger)
+	configurationUseCase := usecases.NewConfigurationUseCase(configurationRepo, newsletterUseCase, cacheService, logger)
 	configurationHandler := handlers.NewConfigurationHandler(configurationUseCase, logger)
 
 	// Configuration routes group (protected with authentication)
@@ -29,4 +29,7 @@ func SetupConfigurationRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logge
 		configuration.PATCH("/:user_id", configurationHandler.UpdateConfiguration)
 		configuration.DELETE("/:user_id", configurationHandler.DeleteConfiguration)
 	}
+
+	// Supported languages (public, used to build language pickers)
+	router.GET("/api/v1/locales", configurationHandler.ListLocales)
 }
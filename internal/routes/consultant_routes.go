@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupConsultantRoutes configures the consultant workspace (client profiles, review status) and client review routes
+func SetupConsultantRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	cacheService := cache.NewCacheService(redis.GetClient(), logger)
+	consultantUseCase := usecases.NewConsultantUseCase(
+		repositories.NewClientProfileRepository(db, logger),
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		cacheService,
+		logger,
+	)
+	consultantHandler := handlers.NewConsultantHandler(consultantUseCase, logger)
+
+	clients := router.Group("/api/v1/clients", authMiddleware)
+	{
+		clients.POST("", consultantHandler.CreateClient)
+		clients.GET("", consultantHandler.ListClients)
+		clients.GET("/:client_id", consultantHandler.GetClient)
+		clients.PUT("/:client_id", consultantHandler.UpdateClient)
+		clients.DELETE("/:client_id", consultantHandler.DeleteClient)
+	}
+
+	curriculums := router.Group("/api/v1/curriculums", authMiddleware)
+	{
+		curriculums.PATCH("/:curriculum_id/status", consultantHandler.UpdateCurriculumStatus)
+	}
+
+	reviews := router.Group("/api/v1/reviews", authMiddleware)
+	{
+		reviews.GET("", consultantHandler.ListReviews)
+		reviews.POST("/:curriculum_id/approve", consultantHandler.ApproveCurriculum)
+		reviews.POST("/:curriculum_id/request-changes", consultantHandler.RequestCurriculumChanges)
+	}
+}
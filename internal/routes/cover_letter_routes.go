@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupCoverLetterRoutes configures the routes to list, edit and export stored cover letters
+func SetupCoverLetterRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	coverLetterUseCase := usecases.NewCoverLetterUseCase(repositories.NewCoverLetterRepository(db, logger), logger)
+	coverLetterHandler := handlers.NewCoverLetterHandler(coverLetterUseCase, logger)
+
+	coverLetters := router.Group("/api/v1/cover-letters", authMiddleware)
+	{
+		coverLetters.GET("", coverLetterHandler.ListCoverLetters)
+		coverLetters.GET("/:cover_letter_id", coverLetterHandler.GetCoverLetter)
+		coverLetters.PUT("/:cover_letter_id", coverLetterHandler.UpdateCoverLetter)
+		coverLetters.DELETE("/:cover_letter_id", coverLetterHandler.DeleteCoverLetter)
+		coverLetters.GET("/:cover_letter_id/export", coverLetterHandler.ExportCoverLetter)
+	}
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // SetupCurriculumRoutes configures curriculum-related routes
-func SetupCurriculumRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, curriculumUseCase usecases.CurriculumUseCase) {
+func SetupCurriculumRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, curriculumUseCase usecases.CurriculumUseCase, referralUseCase usecases.ReferralUseCase) {
 	// Initialize cache service (used by user use case)
 	cacheService := cache.NewCacheService(redis.GetClient(), logger)
 
@@ -21,7 +21,8 @@ func SetupCurriculumRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger,
 	userRepo := repositories.NewUserRepository(db, logger)
 	configurationRepo := repositories.NewConfigurationRepository(db, logger)
 	subscriptionRepo := repositories.NewSubscriptionRepository(db, logger)
-	userUseCase := usecases.NewUserUseCase(userRepo, configurationRepo, subscriptionRepo, cacheService, logger)
+	// Only used to look users up, so it sends no emails
+	userUseCase := usecases.NewUserUseCase(userRepo, configurationRepo, subscriptionRepo, referralUseCase, nil, cacheService, logger)
 
 	curriculumHandler := handlers.NewCurriculumHandler(curriculumUseCase, userUseCase, logger)
 
@@ -35,5 +36,7 @@ func SetupCurriculumRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger,
 		curriculums.GET("/:curriculum_id", curriculumHandler.GetCurriculumByID)
 		curriculums.GET("/get-body/:curriculum_id", curriculumHandler.GetCurriculumBody)
 		curriculums.DELETE("/:curriculum_id", curriculumHandler.DeleteCurriculum)
+		curriculums.POST("/:curriculum_id/duplicate", curriculumHandler.DuplicateCurriculum)
+		curriculums.GET("/:curriculum_id/ats-check", curriculumHandler.CheckATS)
 	}
 }
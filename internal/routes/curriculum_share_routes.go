@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupCurriculumShareRoutes configures share link management and the public /p/:slug page
+func SetupCurriculumShareRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	shareUseCase := usecases.NewCurriculumShareUseCase(
+		repositories.NewCurriculumShareRepository(db, logger),
+		repositories.NewCurriculumRepository(db, logger),
+		cfg.App.URL,
+		logger,
+	)
+	shareHandler := handlers.NewCurriculumShareHandler(shareUseCase, cfg.App.CountryHeader, logger)
+
+	shareLinks := router.Group("/api/v1/curriculums/:curriculum_id/share-links", authMiddleware)
+	{
+		shareLinks.POST("", shareHandler.CreateShareLink)
+		shareLinks.GET("", shareHandler.ListShareLinks)
+		shareLinks.DELETE("/:link_id", shareHandler.RevokeShareLink)
+		shareLinks.GET("/:link_id/views", shareHandler.GetShareLinkViews)
+	}
+
+	// Public page, no authentication. Wrong passwords lock the IP out of the link for a while.
+	sharePasswordRateLimiter := ratelimit.NewSharePasswordRateLimiter(redis.GetClient(), logger)
+	router.GET("/p/:slug", ratelimit.SharePasswordRateLimiterMiddleware(sharePasswordRateLimiter), shareHandler.ViewSharedCurriculum)
+}
@@ -9,16 +9,10 @@ import (
 	"go.uber.org/zap"
 )
 
-// SetupEmailRoutes configures authentication email-related routes
-func SetupEmailRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config) {
-	// Initialize email dependencies
-	emailUseCase, err := usecases.NewEmailUseCase(logger)
-	if err != nil {
-		logger.Fatal("Failed to initialize email use case", zap.Error(err))
-		return
-	}
-
-	emailHandler := handlers.NewEmailHandler(emailUseCase, logger)
+// SetupEmailRoutes configures authentication email-related routes.
+// emailUseCase may be nil when no email provider is configured; the route then answers 503.
+func SetupEmailRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, emailUseCase usecases.EmailUseCase, configurationUseCase usecases.ConfigurationUseCase) {
+	emailHandler := handlers.NewEmailHandler(emailUseCase, configurationUseCase, logger)
 
 	// Protected email routes (authentication required)
 	email := router.Group("/api/v1/send-email", middleware.StaticTokenMiddleware(cfg.App.StaticToken))
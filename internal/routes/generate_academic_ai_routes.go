@@ -12,24 +12,35 @@ import (
 )
 
 // SetupGenerateAcademicAIRoutes configures AI filtering-related routes
-func SetupGenerateAcademicAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+func SetupGenerateAcademicAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, aiGenerationUseCase usecases.AIGenerationUseCase) {
 	generateAcademicAIUseCase, err := usecases.NewGenerateAcademicAIUseCase(cfg.OpenAI.APIKey)
 	if err != nil {
 		logger.Error("Failed to create Generate Academic AI usecase", zap.Error(err))
 		return
 	}
 
-	generateAcademicAIHandler := handlers.NewGenerateAcademicAIHandler(generateAcademicAIUseCase, logger)
+	generateAcademicAIHandler := handlers.NewGenerateAcademicAIHandler(generateAcademicAIUseCase, aiGenerationUseCase, logger)
 	// Criar rate limiter mais estrito para AI routes
 	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
 
 	generateAcademic := router.Group(
 		"/api/v1/generate-academic-ai",
 		authMiddleware,
-		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		middleware.RequireSubscriptionPlanWithAlternatives(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
 		ratelimit.RateLimiterMiddleware(aiRateLimiter),
 	)
 	{
 		generateAcademic.POST("", generateAcademicAIHandler.FilterContent)
 	}
+
+	// v2 returns the same content as typed items instead of free text
+	generateAcademicV2 := router.Group(
+		"/api/v2/generate-academic-ai",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+	)
+	{
+		generateAcademicV2.POST("", generateAcademicAIHandler.GenerateStructured)
+	}
 }
@@ -12,24 +12,35 @@ import (
 )
 
 // SetupGenerateCoursesAIRoutes configures AI filtering-related routes
-func SetupGenerateCoursesAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+func SetupGenerateCoursesAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, aiGenerationUseCase usecases.AIGenerationUseCase) {
 	generateCoursesAIUseCase, err := usecases.NewGenerateCoursesAIUseCase(cfg.OpenAI.APIKey)
 	if err != nil {
 		logger.Error("Failed to create Generate Courses AI usecase", zap.Error(err))
 		return
 	}
 
-	generateCoursesAIHandler := handlers.NewGenerateCoursesAIHandler(generateCoursesAIUseCase, logger)
+	generateCoursesAIHandler := handlers.NewGenerateCoursesAIHandler(generateCoursesAIUseCase, aiGenerationUseCase, logger)
 	// Criar rate limiter mais estrito para AI routes
 	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
 
 	generateCourses := router.Group(
 		"/api/v1/generate-courses-ai",
 		authMiddleware,
-		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		middleware.RequireSubscriptionPlanWithAlternatives(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
 		ratelimit.RateLimiterMiddleware(aiRateLimiter),
 	)
 	{
 		generateCourses.POST("", generateCoursesAIHandler.FilterContent)
 	}
+
+	// v2 returns the same content as typed items instead of free text
+	generateCoursesV2 := router.Group(
+		"/api/v2/generate-courses-ai",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+	)
+	{
+		generateCoursesV2.POST("", generateCoursesAIHandler.GenerateStructured)
+	}
 }
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupGenerateCoverLetterAIRoutes configures the AI route that generates a cover letter from a curriculum
+func SetupGenerateCoverLetterAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, curriculumUseCase usecases.CurriculumUseCase) {
+	generateCoverLetterAIUseCase, err := usecases.NewGenerateCoverLetterAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		curriculumUseCase,
+		repositories.NewApplicationRepository(db, logger),
+		repositories.NewCoverLetterRepository(db, logger),
+		logger,
+	)
+	if err != nil {
+		logger.Error("Failed to create Generate Cover Letter AI usecase", zap.Error(err))
+		return
+	}
+
+	generateCoverLetterAIHandler := handlers.NewGenerateCoverLetterAIHandler(generateCoverLetterAIUseCase, logger)
+	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
+
+	router.POST(
+		"/api/v1/curriculums/:curriculum_id/cover-letters",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+		generateCoverLetterAIHandler.GenerateCoverLetter,
+	)
+}
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupGenerateInterviewPrepAIRoutes configures the AI route that generates an interview practice set from a curriculum
+func SetupGenerateInterviewPrepAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+	generateInterviewPrepAIUseCase, err := usecases.NewGenerateInterviewPrepAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewApplicationRepository(db, logger),
+		repositories.NewInterviewPrepRepository(db, logger),
+		logger,
+	)
+	if err != nil {
+		logger.Error("Failed to create Generate Interview Prep AI usecase", zap.Error(err))
+		return
+	}
+
+	generateInterviewPrepAIHandler := handlers.NewGenerateInterviewPrepAIHandler(generateInterviewPrepAIUseCase, logger)
+	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
+
+	router.POST(
+		"/api/v1/curriculums/:curriculum_id/interview-prep",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+		generateInterviewPrepAIHandler.GenerateInterviewPrep,
+	)
+}
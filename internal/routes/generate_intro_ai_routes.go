@@ -6,20 +6,27 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // SetupGenerateIntroAIRoutes configures AI filtering-related routes
-func SetupGenerateIntroAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
-	generateIntroAIUseCase, err := usecases.NewGenerateIntroAIUseCase(cfg.OpenAI.APIKey)
+func SetupGenerateIntroAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, curriculumUseCase usecases.CurriculumUseCase, aiGenerationUseCase usecases.AIGenerationUseCase) {
+	generateIntroAIUseCase, err := usecases.NewGenerateIntroAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		curriculumUseCase,
+		repositories.NewConfigurationRepository(db, logger),
+	)
 	if err != nil {
 		logger.Error("Failed to create Generate Intro AI usecase", zap.Error(err))
 		return
 	}
 
-	generateIntroAIHandler := handlers.NewGenerateIntroAIHandler(generateIntroAIUseCase, logger)
+	generateIntroAIHandler := handlers.NewGenerateIntroAIHandler(generateIntroAIUseCase, aiGenerationUseCase, logger)
 
 	// Create stricter rate limiter for AI routes
 	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
@@ -27,7 +34,7 @@ func SetupGenerateIntroAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *con
 	generateIntros := router.Group(
 		"/api/v1/generate-intro-ai",
 		authMiddleware,
-		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		middleware.RequireSubscriptionPlanWithAlternatives(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
 		ratelimit.RateLimiterMiddleware(aiRateLimiter),
 	)
 
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupGenerateMatchAIRoutes configures job description match scoring routes
+func SetupGenerateMatchAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+	generateMatchAIUseCase, err := usecases.NewGenerateMatchAIUseCase(cfg.OpenAI.APIKey, repositories.NewCurriculumRepository(db, logger))
+	if err != nil {
+		logger.Error("Failed to create Generate Match AI usecase", zap.Error(err))
+		return
+	}
+
+	generateMatchAIHandler := handlers.NewGenerateMatchAIHandler(generateMatchAIUseCase, logger)
+	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
+
+	generateMatch := router.Group(
+		"/api/v1/generate-match-ai",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+	)
+	{
+		generateMatch.POST("/:id", generateMatchAIHandler.MatchJobDescription)
+	}
+}
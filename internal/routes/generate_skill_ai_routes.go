@@ -12,24 +12,35 @@ import (
 )
 
 // SetupGenerateSkillAIRoutes configures AI skill generation-related routes
-func SetupGenerateSkillAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+func SetupGenerateSkillAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, aiGenerationUseCase usecases.AIGenerationUseCase) {
 	generateSkillAIUseCase, err := usecases.NewGenerateSkillAIUseCase(cfg.OpenAI.APIKey)
 	if err != nil {
 		logger.Error("Failed to create Generate Skill AI usecase", zap.Error(err))
 		return
 	}
 
-	generateSkillAIHandler := handlers.NewGenerateSkillAIHandler(generateSkillAIUseCase, logger)
+	generateSkillAIHandler := handlers.NewGenerateSkillAIHandler(generateSkillAIUseCase, aiGenerationUseCase, logger)
 	// Criar rate limiter mais estrito para AI routes
 	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
 
 	generateSkill := router.Group(
 		"/api/v1/generate-skill-ai",
 		authMiddleware,
-		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		middleware.RequireSubscriptionPlanWithAlternatives(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
 		ratelimit.RateLimiterMiddleware(aiRateLimiter),
 	)
 	{
 		generateSkill.POST("", generateSkillAIHandler.FilterContent)
 	}
+
+	// v2 returns the same content as typed items instead of free text
+	generateSkillV2 := router.Group(
+		"/api/v2/generate-skill-ai",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+	)
+	{
+		generateSkillV2.POST("", generateSkillAIHandler.GenerateStructured)
+	}
 }
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupGenerateTailorAIRoutes configures the AI route that tailors a curriculum to a job posting
+func SetupGenerateTailorAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+	generateTailorAIUseCase, err := usecases.NewGenerateTailorAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewCurriculumCreationStatsRepository(db, logger),
+		logger,
+	)
+	if err != nil {
+		logger.Error("Failed to create Generate Tailor AI usecase", zap.Error(err))
+		return
+	}
+
+	generateTailorAIHandler := handlers.NewGenerateTailorAIHandler(generateTailorAIUseCase, logger)
+	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
+
+	router.POST(
+		"/api/v1/curriculums/:curriculum_id/tailor",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+		generateTailorAIHandler.TailorCurriculum,
+	)
+}
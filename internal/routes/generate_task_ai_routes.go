@@ -6,30 +6,48 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // SetupGenerateTaskAIRoutes configures AI filtering-related routes
-func SetupGenerateTaskAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
-	generateTaskAIUseCase, err := usecases.NewGenerateTaskAIUseCase(cfg.OpenAI.APIKey)
+func SetupGenerateTaskAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase, curriculumUseCase usecases.CurriculumUseCase, aiGenerationUseCase usecases.AIGenerationUseCase) {
+	generateTaskAIUseCase, err := usecases.NewGenerateTaskAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		curriculumUseCase,
+		repositories.NewConfigurationRepository(db, logger),
+	)
 	if err != nil {
 		logger.Error("Failed to create Generate Task AI usecase", zap.Error(err))
 		return
 	}
 
-	generateTaskAIHandler := handlers.NewGenerateTaskAIHandler(generateTaskAIUseCase, logger)
+	generateTaskAIHandler := handlers.NewGenerateTaskAIHandler(generateTaskAIUseCase, aiGenerationUseCase, logger)
 	// Criar rate limiter mais estrito para AI routes
 	aiRateLimiter := ratelimit.NewAIRateLimiter(redis.GetClient(), logger)
 
 	generateTasks := router.Group(
 		"/api/v1/generate-task-ai",
 		authMiddleware,
-		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		middleware.RequireSubscriptionPlanWithAlternatives(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
 		ratelimit.RateLimiterMiddleware(aiRateLimiter),
 	)
 	{
 		generateTasks.POST("", generateTaskAIHandler.FilterContent)
 	}
+
+	// v2 returns the same content as typed items instead of free text
+	generateTasksV2 := router.Group(
+		"/api/v2/generate-task-ai",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+	)
+	{
+		generateTasksV2.POST("", generateTaskAIHandler.GenerateStructured)
+	}
 }
@@ -6,14 +6,21 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // SetupGenerateTranslationAIRoutes configures AI filtering-related routes
-func SetupGenerateTranslationAIRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
-	generateTranslationAIUseCase, err := usecases.NewGenerateTranslationAIUseCase(cfg.OpenAI.APIKey)
+func SetupGenerateTranslationAIRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, subscriptionUseCase usecases.SubscriptionUseCase) {
+	generateTranslationAIUseCase, err := usecases.NewGenerateTranslationAIUseCase(
+		cfg.OpenAI.APIKey,
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewCurriculumCreationStatsRepository(db, logger),
+		logger,
+	)
 	if err != nil {
 		logger.Error("Failed to create Generate Translation AI usecase", zap.Error(err))
 		return
@@ -32,4 +39,13 @@ func SetupGenerateTranslationAIRoutes(router *gin.Engine, logger *zap.Logger, cf
 	{
 		generateTranslations.POST("", generateTranslationAIHandler.FilterContent)
 	}
+
+	// Translates a stored curriculum into a new linked curriculum
+	router.POST(
+		"/api/v1/curriculums/:curriculum_id/translate",
+		authMiddleware,
+		middleware.RequireSubscriptionPlan(subscriptionUseCase, redis.GetClient(), config.DefaultAIQuotaByPlan()),
+		ratelimit.RateLimiterMiddleware(aiRateLimiter),
+		generateTranslationAIHandler.TranslateStoredCurriculum,
+	)
 }
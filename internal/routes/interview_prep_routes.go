@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupInterviewPrepRoutes configures the routes to review stored interview practice sets and take notes
+func SetupInterviewPrepRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	interviewPrepUseCase := usecases.NewInterviewPrepUseCase(repositories.NewInterviewPrepRepository(db, logger), logger)
+	interviewPrepHandler := handlers.NewInterviewPrepHandler(interviewPrepUseCase, logger)
+
+	interviewPrep := router.Group("/api/v1/interview-prep", authMiddleware)
+	{
+		interviewPrep.GET("", interviewPrepHandler.ListPracticeSets)
+		interviewPrep.GET("/:set_id", interviewPrepHandler.GetPracticeSet)
+		interviewPrep.DELETE("/:set_id", interviewPrepHandler.DeletePracticeSet)
+		interviewPrep.PATCH("/:set_id/questions/:question_id", interviewPrepHandler.UpdateQuestion)
+	}
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SetupLinkedInImportRoutes configures the route that creates a curriculum from a LinkedIn data export
+func SetupLinkedInImportRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+	linkedInImportUseCase := usecases.NewLinkedInImportUseCase(
+		repositories.NewCurriculumRepository(db, logger),
+		repositories.NewCurriculumCreationStatsRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		logger,
+	)
+	linkedInImportHandler := handlers.NewLinkedInImportHandler(linkedInImportUseCase, logger)
+
+	router.POST("/api/v1/curriculums/import/linkedin", authMiddleware, linkedInImportHandler.ImportLinkedIn)
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SetupNewsletterRoutes configures the newsletter subscription routes and the public links of newsletter emails
+func SetupNewsletterRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, newsletterUseCase usecases.NewsletterUseCase) {
+	newsletterHandler := handlers.NewNewsletterHandler(newsletterUseCase, logger)
+
+	subscription := router.Group("/api/v1/newsletter/subscription", authMiddleware)
+	{
+		subscription.GET("", newsletterHandler.GetSubscription)
+		subscription.POST("", newsletterHandler.Subscribe)
+		subscription.DELETE("", newsletterHandler.Unsubscribe)
+	}
+
+	// Public: opened from emails, authenticated by the signed token
+	newsletter := router.Group("/api/v1/newsletter")
+	{
+		newsletter.GET("/confirm", newsletterHandler.Confirm)
+		newsletter.GET("/unsubscribe", newsletterHandler.UnsubscribeWithToken)
+		newsletter.POST("/unsubscribe", newsletterHandler.UnsubscribeWithToken)
+	}
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SetupOrganizationRoutes configures organization, membership, invitation and seat routes
+func SetupOrganizationRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, organizationUseCase usecases.OrganizationUseCase) {
+	organizationHandler := handlers.NewOrganizationHandler(organizationUseCase, logger)
+
+	organizations := router.Group("/api/v1/organizations", authMiddleware)
+	{
+		organizations.POST("", organizationHandler.CreateOrganization)
+		organizations.POST("/invitations/accept", organizationHandler.AcceptInvitation)
+		organizations.GET("/me", organizationHandler.GetMyOrganization)
+		organizations.POST("/me/invitations", organizationHandler.InviteMember)
+		organizations.DELETE("/me/invitations/:id", organizationHandler.RevokeInvitation)
+		organizations.PATCH("/me/members/:userId", organizationHandler.UpdateMemberRole)
+		organizations.DELETE("/me/members/:userId", organizationHandler.RemoveMember)
+		organizations.POST("/me/checkout", organizationHandler.CreateCheckoutSession)
+		organizations.PATCH("/me/seats", organizationHandler.UpdateSeats)
+	}
+}
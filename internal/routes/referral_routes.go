@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/usecases"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SetupReferralRoutes configures referral routes for authenticated users
+func SetupReferralRoutes(router *gin.Engine, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, referralUseCase usecases.ReferralUseCase) {
+	referralHandler := handlers.NewReferralHandler(referralUseCase, logger)
+
+	referrals := router.Group("/api/v1/referrals", authMiddleware)
+	{
+		referrals.GET("/me", referralHandler.GetMyReferral)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/handlers"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/middleware"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ratelimit"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/redis"
@@ -25,6 +26,36 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *confi
 	// Apply rate limiting to all routes except health check
 	router.Use(ratelimit.RateLimiterMiddleware(rateLimiter))
 
+	// Request language for error messages: the user's configured language, then Accept-Language
+	configurationUseCase := usecases.NewConfigurationUseCase(
+		repositories.NewConfigurationRepository(db, logger),
+		nil,
+		cache.NewCacheService(redis.GetClient(), logger),
+		logger,
+	)
+	router.Use(i18n.Middleware(configurationUseCase.GetLanguage))
+
+	// Emails are optional: without an email provider the API runs, transactional emails
+	// (welcome, subscription, quota, account deletion) are skipped and send-email answers 503.
+	emailUseCase, err := usecases.NewEmailUseCase(repositories.NewOutboxEmailRepository(db, logger), cfg.Email, cfg.App.URL, logger)
+	if err != nil {
+		logger.Warn("Email use case unavailable, transactional emails disabled", zap.Error(err))
+		emailUseCase = nil
+	}
+
+	// Newsletter use case (shared by configuration, newsletter and admin routes)
+	if cfg.Newsletter.SigningSecret == "" {
+		logger.Warn("NEWSLETTER_SIGNING_SECRET not set, newsletter disabled")
+	}
+	newsletterUseCase := usecases.NewNewsletterUseCase(
+		repositories.NewNewsletterRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		emailUseCase,
+		cache.NewCacheService(redis.GetClient(), logger),
+		cfg.Newsletter,
+		logger,
+	)
+
 	// Health check handler
 	healthHandler := handlers.NewHealthCheckHandler(logger)
 
@@ -38,54 +69,117 @@ func SetupRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *confi
 	sessionRepo := repositories.NewSessionRepository(db)
 	sessionAuthMiddleware := middleware.SessionMiddleware(sessionRepo)
 
+	// Referral use case (shared by user signup, subscription webhooks, referral and admin routes)
+	referralUseCase := usecases.NewReferralUseCase(
+		repositories.NewReferralRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		repositories.NewSubscriptionRepository(db, logger),
+		cfg.Stripe.SecretKey,
+		cfg.App.URL,
+		logger,
+	)
+
 	// Setup user routes
-	SetupUserRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+	SetupUserRoutes(router, db, logger, cfg, sessionAuthMiddleware, referralUseCase, emailUseCase)
 
 	// Setup admin (back office) routes (double protection: static token + session)
-	SetupAdminRoutes(router, db, logger, cfg, sessionRepo)
+	SetupAdminRoutes(router, db, logger, cfg, sessionRepo, referralUseCase, newsletterUseCase)
+
+	// Setup referral routes
+	SetupReferralRoutes(router, logger, cfg, sessionAuthMiddleware, referralUseCase)
+
+	// Organization use case (shared by organization routes, subscription webhooks and AI quota checks)
+	planPriceRepo := repositories.NewPlanPriceRepository(db, logger)
+	organizationUseCase := usecases.NewOrganizationUseCase(
+		repositories.NewOrganizationRepository(db, logger),
+		repositories.NewUserRepository(db, logger),
+		planPriceRepo,
+		cfg.Stripe,
+		cfg.Subscription,
+		cfg.App.URL,
+		logger,
+	)
+
+	// Setup organization routes
+	SetupOrganizationRoutes(router, logger, cfg, sessionAuthMiddleware, organizationUseCase)
 
 	// Curriculum use case (shared by curriculum and generate-analyze-ai routes)
 	cacheService := cache.NewCacheService(redis.GetClient(), logger)
 	curriculumRepo := repositories.NewCurriculumRepository(db, logger)
 	curriculumCreationStatsRepo := repositories.NewCurriculumCreationStatsRepository(db, logger)
-	curriculumUseCase := usecases.NewCurriculumUseCase(curriculumRepo, curriculumCreationStatsRepo, cacheService, logger)
+	clientProfileRepo := repositories.NewClientProfileRepository(db, logger)
+	curriculumUseCase := usecases.NewCurriculumUseCase(curriculumRepo, curriculumCreationStatsRepo, clientProfileRepo, cacheService, logger)
 
 	// Setup curriculum routes
-	SetupCurriculumRoutes(router, db, logger, cfg, sessionAuthMiddleware, curriculumUseCase)
+	SetupCurriculumRoutes(router, db, logger, cfg, sessionAuthMiddleware, curriculumUseCase, referralUseCase)
+
+	// Setup consultant workspace and client review routes
+	SetupConsultantRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+
+	// Setup LinkedIn data export import
+	SetupLinkedInImportRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+
+	// Setup curriculum share links and the public share page
+	SetupCurriculumShareRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+
+	// Setup job application tracker routes
+	SetupApplicationRoutes(router, db, logger, cfg, sessionAuthMiddleware)
 
 	// Subscription usecase (used by subscription-gated endpoints)
 	subscriptionRepo := repositories.NewSubscriptionRepository(db, logger)
 	userRepo := repositories.NewUserRepository(db, logger)
-	subscriptionUseCase := usecases.NewSubscriptionUseCase(subscriptionRepo, userRepo, cfg.Stripe, logger)
+	invoiceRepo := repositories.NewInvoiceRepository(db, logger)
+	subscriptionUseCase := usecases.NewSubscriptionUseCase(subscriptionRepo, userRepo, planPriceRepo, invoiceRepo, referralUseCase, organizationUseCase, emailUseCase, cfg.Stripe, cfg.Subscription, logger)
+
+	// AI generation usecase (records the alternatives of the text generators and the user's feedback)
+	aiGenerationUseCase := usecases.NewAIGenerationUseCase(repositories.NewAIGenerationRepository(db, logger), logger)
+	SetupAIGenerationRoutes(router, logger, cfg, sessionAuthMiddleware, aiGenerationUseCase)
 
 	// Setup AI analysis routes
-	SetupGenerateIntroAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateIntroAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, curriculumUseCase, aiGenerationUseCase)
 	// Setup generate courses AI routes
-	SetupGenerateCoursesAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateCoursesAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, aiGenerationUseCase)
 
 	// Setup generate academic AI routes
-	SetupGenerateAcademicAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateAcademicAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, aiGenerationUseCase)
 
 	// Setup generate task AI routes
-	SetupGenerateTaskAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateTaskAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, curriculumUseCase, aiGenerationUseCase)
 
 	// Setup generate skill AI routes
-	SetupGenerateSkillAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateSkillAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, aiGenerationUseCase)
 
 	// Setup configuration routes
-	SetupConfigurationRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+	SetupConfigurationRoutes(router, db, logger, cfg, sessionAuthMiddleware, newsletterUseCase)
+
+	// Setup newsletter subscription, confirmation and unsubscribe routes
+	SetupNewsletterRoutes(router, logger, cfg, sessionAuthMiddleware, newsletterUseCase)
 
 	// Setup authentication email routes
-	SetupEmailRoutes(router, logger, cfg)
+	SetupEmailRoutes(router, logger, cfg, emailUseCase, configurationUseCase)
 
 	// Setup generate analyze AI routes
 	SetupGenerateAnalyzeAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, curriculumUseCase)
 
+	// Setup tailor curriculum AI routes
+	SetupGenerateTailorAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+
+	// Setup cover letter generation and stored cover letter routes
+	SetupGenerateCoverLetterAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase, curriculumUseCase)
+	SetupCoverLetterRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+
+	// Setup interview preparation generation and practice set routes
+	SetupGenerateInterviewPrepAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupInterviewPrepRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+
+	// Setup job description match AI routes
+	SetupGenerateMatchAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+
 	// Setup generate translation AI routes
-	SetupGenerateTranslationAIRoutes(router, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
+	SetupGenerateTranslationAIRoutes(router, db, logger, cfg, sessionAuthMiddleware, subscriptionUseCase)
 
 	// Setup subscriptions routes (Stripe)
-	SetupSubscriptionRoutes(router, db, logger, cfg, sessionAuthMiddleware)
+	SetupSubscriptionRoutes(router, db, logger, cfg, sessionAuthMiddleware, referralUseCase, organizationUseCase, emailUseCase)
 
 	return nil
 }
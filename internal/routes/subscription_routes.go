@@ -10,10 +10,12 @@ import (
 	"gorm.io/gorm"
 )
 
-func SetupSubscriptionRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+func SetupSubscriptionRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, referralUseCase usecases.ReferralUseCase, organizationUseCase usecases.OrganizationUseCase, emailUseCase usecases.EmailUseCase) {
 	userRepo := repositories.NewUserRepository(db, logger)
 	subscriptionRepo := repositories.NewSubscriptionRepository(db, logger)
-	subscriptionUseCase := usecases.NewSubscriptionUseCase(subscriptionRepo, userRepo, cfg.Stripe, logger)
+	planPriceRepo := repositories.NewPlanPriceRepository(db, logger)
+	invoiceRepo := repositories.NewInvoiceRepository(db, logger)
+	subscriptionUseCase := usecases.NewSubscriptionUseCase(subscriptionRepo, userRepo, planPriceRepo, invoiceRepo, referralUseCase, organizationUseCase, emailUseCase, cfg.Stripe, cfg.Subscription, logger)
 	subscriptionHandler := handlers.NewSubscriptionHandler(subscriptionUseCase, logger)
 
 	// Stripe webhook should not be protected by static token.
@@ -24,5 +26,9 @@ func SetupSubscriptionRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger
 		protected.GET("/me", subscriptionHandler.GetMySubscription)
 		protected.POST("/checkout", subscriptionHandler.CreateCheckoutSession)
 		protected.POST("/portal", subscriptionHandler.CreatePortalSession)
+		protected.POST("/trial", subscriptionHandler.StartTrial)
+		protected.GET("/invoices", subscriptionHandler.ListInvoices)
+		protected.GET("/change-plan/preview", subscriptionHandler.PreviewChangePlan)
+		protected.POST("/change-plan", subscriptionHandler.ChangePlan)
 	}
 }
@@ -13,7 +13,7 @@ import (
 )
 
 // SetupUserRoutes configures user-related routes
-func SetupUserRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc) {
+func SetupUserRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *config.Config, authMiddleware gin.HandlerFunc, referralUseCase usecases.ReferralUseCase, emailUseCase usecases.EmailUseCase) {
 	// Initialize cache service
 	cacheService := cache.NewCacheService(redis.GetClient(), logger)
 
@@ -21,7 +21,7 @@ func SetupUserRoutes(router *gin.Engine, db *gorm.DB, logger *zap.Logger, cfg *c
 	userRepo := repositories.NewUserRepository(db, logger)
 	configurationRepo := repositories.NewConfigurationRepository(db, logger)
 	subscriptionRepo := repositories.NewSubscriptionRepository(db, logger)
-	userUseCase := usecases.NewUserUseCase(userRepo, configurationRepo, subscriptionRepo, cacheService, logger)
+	userUseCase := usecases.NewUserUseCase(userRepo, configurationRepo, subscriptionRepo, referralUseCase, emailUseCase, cacheService, logger)
 	userHandler := handlers.NewUserHandler(userUseCase, logger)
 
 	// Public user routes (no authentication)
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// ProblemContentType is the media type of every error response (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix prefixes the lower-kebab error code to build the problem type URI.
+const problemTypePrefix = "urn:dafon-cv:problem:"
+
+// Problem is the RFC 7807 problem details body of every error response. Code is the stable
+// identifier clients should match on; Detail is translated to the request language.
+type Problem struct {
+	Type     string            `json:"type" example:"urn:dafon-cv:problem:curriculum-not-found"`
+	Title    string            `json:"title" example:"Not Found"`
+	Status   int               `json:"status" example:"404"`
+	Detail   string            `json:"detail,omitempty" example:"curriculum not found"`
+	Instance string            `json:"instance,omitempty" example:"/api/v1/curriculums/5f0c3c1e-8a2b-4c1d-9e7f-1a2b3c4d5e6f"`
+	Code     apperrors.Code    `json:"code" example:"CURRICULUM_NOT_FOUND"`
+	Errors   []ValidationError `json:"errors,omitempty"`
+}
+
+// codeStatuses maps every error code to its HTTP status. Codes missing here are 500s.
+var codeStatuses = map[apperrors.Code]int{
+	apperrors.CodeBadRequest:      http.StatusBadRequest,
+	apperrors.CodeValidation:      http.StatusBadRequest,
+	apperrors.CodeUnauthorized:    http.StatusUnauthorized,
+	apperrors.CodeForbidden:       http.StatusForbidden,
+	apperrors.CodeNotFound:        http.StatusNotFound,
+	apperrors.CodeConflict:        http.StatusConflict,
+	apperrors.CodeGone:            http.StatusGone,
+	apperrors.CodePayloadTooLarge: http.StatusRequestEntityTooLarge,
+	apperrors.CodeRateLimited:     http.StatusTooManyRequests,
+	apperrors.CodeInternal:        http.StatusInternalServerError,
+
+	apperrors.CodeAuthRequired:        http.StatusUnauthorized,
+	apperrors.CodeInvalidAuthHeader:   http.StatusUnauthorized,
+	apperrors.CodeInvalidToken:        http.StatusUnauthorized,
+	apperrors.CodeSessionExpired:      http.StatusUnauthorized,
+	apperrors.CodeAdminRequired:       http.StatusForbidden,
+	apperrors.CodeOrganizationRole:    http.StatusForbidden,
+	apperrors.CodeInvalidUserID:       http.StatusBadRequest,
+	apperrors.CodeInvalidLanguage:     http.StatusBadRequest,
+	apperrors.CodeInvalidCurriculumID: http.StatusBadRequest,
+
+	apperrors.CodeNoWorkExperience:      http.StatusUnprocessableEntity,
+	apperrors.CodeInvalidLinkedInExport: http.StatusBadRequest,
+
+	apperrors.CodeInvalidPlan:       http.StatusBadRequest,
+	apperrors.CodeQuotaExceeded:     http.StatusPaymentRequired,
+	apperrors.CodeAlternativesLimit: http.StatusForbidden,
+
+	apperrors.CodeNoActiveSubscription:      http.StatusConflict,
+	apperrors.CodePlanUnchanged:             http.StatusConflict,
+	apperrors.CodeSubscriptionAlreadyActive: http.StatusConflict,
+	apperrors.CodeNoBillingAccount:          http.StatusConflict,
+
+	apperrors.CodeTrialUnavailable:           http.StatusServiceUnavailable,
+	apperrors.CodeTrialAlreadyUsed:           http.StatusConflict,
+	apperrors.CodeTrialAfterPaidSubscription: http.StatusConflict,
+
+	apperrors.CodeFeedbackRequired:           http.StatusBadRequest,
+	apperrors.CodeAlternativeIndexOutOfRange: http.StatusBadRequest,
+	apperrors.CodeChosenAlternativeRejected:  http.StatusBadRequest,
+
+	apperrors.CodeUserNotFound:                    http.StatusNotFound,
+	apperrors.CodeCurriculumNotFound:              http.StatusNotFound,
+	apperrors.CodeCurriculumOrApplicationNotFound: http.StatusNotFound,
+	apperrors.CodeConfigurationNotFound:           http.StatusNotFound,
+	apperrors.CodeCoverLetterNotFound:             http.StatusNotFound,
+	apperrors.CodeGenerationNotFound:              http.StatusNotFound,
+	apperrors.CodeOrganizationNotFound:            http.StatusNotFound,
+	apperrors.CodeClientProfileNotFound:           http.StatusNotFound,
+	apperrors.CodeReferralCampaignNotFound:        http.StatusNotFound,
+	apperrors.CodeShareLinkNotFound:               http.StatusNotFound,
+	apperrors.CodeApplicationNotFound:             http.StatusNotFound,
+	apperrors.CodePracticeSetNotFound:             http.StatusNotFound,
+	apperrors.CodePracticeQuestionNotFound:        http.StatusNotFound,
+
+	apperrors.CodeAlreadyInOrganization:          http.StatusConflict,
+	apperrors.CodeInvalidOrganizationRole:        http.StatusBadRequest,
+	apperrors.CodeNoSeatsAvailable:               http.StatusConflict,
+	apperrors.CodeSeatsBelowUsage:                http.StatusBadRequest,
+	apperrors.CodeInvitationNotPending:           http.StatusGone,
+	apperrors.CodeInvitationEmailMismatch:        http.StatusForbidden,
+	apperrors.CodeOrganizationOwnerImmutable:     http.StatusConflict,
+	apperrors.CodeOrganizationSubscriptionActive: http.StatusConflict,
+	apperrors.CodeNoOrganizationSubscription:     http.StatusConflict,
+
+	apperrors.CodeInvalidRewardType:     http.StatusBadRequest,
+	apperrors.CodeRewardCreditsRequired: http.StatusBadRequest,
+	apperrors.CodeStripeCouponRequired:  http.StatusBadRequest,
+	apperrors.CodeInvalidCampaignPeriod: http.StatusBadRequest,
+
+	apperrors.CodeClientHasCurriculums:        http.StatusConflict,
+	apperrors.CodeCurriculumNotLinkedToClient: http.StatusConflict,
+	apperrors.CodeInvalidCurriculumStatus:     http.StatusBadRequest,
+	apperrors.CodeCurriculumStatusUnchanged:   http.StatusConflict,
+	apperrors.CodeCurriculumNotInReview:       http.StatusConflict,
+
+	apperrors.CodeInvalidApplicationStatus:   http.StatusBadRequest,
+	apperrors.CodeApplicationStatusUnchanged: http.StatusConflict,
+
+	apperrors.CodeInvalidExportFormat:     http.StatusBadRequest,
+	apperrors.CodeCoverLetterContentBlank: http.StatusBadRequest,
+
+	apperrors.CodeShareLinkUnavailable:      http.StatusGone,
+	apperrors.CodeShareLinkPasswordRequired: http.StatusUnauthorized,
+	apperrors.CodeShareLinkInvalidPassword:  http.StatusUnauthorized,
+
+	apperrors.CodeEmailUnavailable: http.StatusServiceUnavailable,
+
+	apperrors.CodeNewsletterUnavailable:      http.StatusServiceUnavailable,
+	apperrors.CodeInvalidNewsletterToken:     http.StatusBadRequest,
+	apperrors.CodeNewsletterCampaignNotFound: http.StatusNotFound,
+	apperrors.CodeNewsletterCampaignNotDraft: http.StatusConflict,
+}
+
+// statusCodes maps an HTTP status to the generic code of errors reported by status only.
+var statusCodes = map[int]apperrors.Code{
+	http.StatusBadRequest:            apperrors.CodeBadRequest,
+	http.StatusUnauthorized:          apperrors.CodeUnauthorized,
+	http.StatusPaymentRequired:       apperrors.CodeQuotaExceeded,
+	http.StatusForbidden:             apperrors.CodeForbidden,
+	http.StatusNotFound:              apperrors.CodeNotFound,
+	http.StatusConflict:              apperrors.CodeConflict,
+	http.StatusGone:                  apperrors.CodeGone,
+	http.StatusRequestEntityTooLarge: apperrors.CodePayloadTooLarge,
+	http.StatusTooManyRequests:       apperrors.CodeRateLimited,
+}
+
+// StatusOf returns the HTTP status of an error code.
+func StatusOf(code apperrors.Code) int {
+	if status, ok := codeStatuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// codeOfStatus returns the generic error code of an HTTP status.
+func codeOfStatus(status int) apperrors.Code {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= http.StatusInternalServerError {
+		return apperrors.CodeInternal
+	}
+	return apperrors.CodeBadRequest
+}
+
+// HandleCodeError writes the problem response of code and aborts the request. The status
+// comes from the code; an empty message uses the code's default one. The message is
+// translated to the request language (see i18n.FromContext).
+func HandleCodeError(c *gin.Context, code apperrors.Code, message string) {
+	if message == "" {
+		message = code.Message()
+	}
+	writeProblem(c, code, StatusOf(code), i18n.T(i18n.FromContext(c), message), nil)
+}
+
+// HandleCodeErrorf is HandleCodeError with a message built from format, which is
+// translated before the arguments are applied.
+func HandleCodeErrorf(c *gin.Context, code apperrors.Code, format string, args ...any) {
+	writeProblem(c, code, StatusOf(code), i18n.Tf(i18n.FromContext(c), format, args...), nil)
+}
+
+// HandleError writes a problem response with an explicit status and aborts the request.
+// Use this for non-validation errors (auth/permission/internal) that have no specific
+// code; the response carries the generic code of the status.
+// The message is translated to the request language (see i18n.FromContext).
+func HandleError(c *gin.Context, status int, message string) {
+	writeProblem(c, codeOfStatus(status), status, i18n.T(i18n.FromContext(c), message), nil)
+}
+
+// HandleErrorf is HandleError with a message built from format, which is translated
+// before the arguments are applied.
+func HandleErrorf(c *gin.Context, status int, format string, args ...any) {
+	writeProblem(c, codeOfStatus(status), status, i18n.Tf(i18n.FromContext(c), format, args...), nil)
+}
+
+func writeProblem(c *gin.Context, code apperrors.Code, status int, detail string, fieldErrors []ValidationError) {
+	problem := Problem{
+		Type:     problemTypePrefix + strings.ToLower(strings.ReplaceAll(string(code), "_", "-")),
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.Path,
+		Code:     code,
+		Errors:   fieldErrors,
+	}
+	body, err := json.Marshal(problem)
+	if err != nil {
+		c.AbortWithStatus(status)
+		return
+	}
+	c.Data(status, ProblemContentType, body)
+	c.Abort()
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"strings"
 
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/i18n"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/validation"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -16,57 +18,59 @@ type ValidationError struct {
 	Message string `json:"message"`
 }
 
-type ValidationErrorResponse struct {
-	Message string            `json:"message"`
-	Errors  []ValidationError `json:"errors"`
-}
-
-// HandleError writes a standard error response and aborts the request.
-// Use this for non-validation errors (auth/permission/internal).
-func HandleError(c *gin.Context, status int, message string) {
-	c.AbortWithStatusJSON(status, gin.H{"error": message})
-}
-
+// HandleValidationError writes the problem response of a request that failed binding or
+// validation. Validator errors are VALIDATION_FAILED and list the invalid fields under
+// "errors"; coded application errors keep their code and status; anything else is
+// reported as BAD_REQUEST with its message.
 func HandleValidationError(c *gin.Context, err error) {
+	lang := i18n.FromContext(c)
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
-		formattedError := formatValidationError(validationErrors)
-		c.JSON(http.StatusBadRequest, formattedError)
+		writeProblem(c, apperrors.CodeValidation, http.StatusBadRequest, i18n.T(lang, apperrors.CodeValidation.Message()), formatValidationError(lang, validationErrors))
+		return
+	}
+	if code := apperrors.CodeOf(err); code != "" {
+		HandleCodeError(c, code, err.Error())
 		return
 	}
-	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	writeProblem(c, apperrors.CodeBadRequest, http.StatusBadRequest, i18n.T(lang, err.Error()), nil)
 }
 
-// HandleUseCaseError writes the appropriate HTTP status and body for use-case errors:
-// 404 when the error is gorm.ErrRecordNotFound, 500 otherwise.
-func HandleUseCaseError(c *gin.Context, err error, notFoundMessage string) {
+// HandleFieldError writes a VALIDATION_FAILED problem for a single invalid field, for checks
+// done outside the binding validator.
+func HandleFieldError(c *gin.Context, field, message string) {
+	lang := i18n.FromContext(c)
+	writeProblem(c, apperrors.CodeValidation, http.StatusBadRequest, i18n.T(lang, apperrors.CodeValidation.Message()), []ValidationError{
+		{Field: field, Message: i18n.T(lang, message)},
+	})
+}
+
+// HandleUseCaseError writes the appropriate problem response for use-case errors:
+// notFoundCode (404) when the error is gorm.ErrRecordNotFound, the error's own code when
+// it carries a client error code, INTERNAL_ERROR (500) otherwise.
+func HandleUseCaseError(c *gin.Context, err error, notFoundCode apperrors.Code) {
 	if err == nil {
 		return
 	}
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": notFoundMessage})
+		HandleCodeError(c, notFoundCode, "")
+		return
+	}
+	if code := apperrors.CodeOf(err); code != "" && StatusOf(code) < http.StatusInternalServerError {
+		HandleCodeError(c, code, "")
 		return
 	}
-	c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	HandleCodeError(c, apperrors.CodeInternal, "")
 }
 
 func ValidatePasswordAndReturnError(c *gin.Context, password string) bool {
 	if !validation.IsStrongPassword(password) {
-		errorResponse := ValidationErrorResponse{
-			Message: "Invalid input data",
-			Errors: []ValidationError{
-				{
-					Field:   "password",
-					Message: "Password must be at least 8 characters long and contain uppercase, lowercase, digit, and special character",
-				},
-			},
-		}
-		c.JSON(http.StatusBadRequest, errorResponse)
+		HandleFieldError(c, "password", "Password must be at least 8 characters long and contain uppercase, lowercase, digit, and special character")
 		return false
 	}
 	return true
 }
 
-func formatValidationError(err error) ValidationErrorResponse {
+func formatValidationError(lang string, err error) []ValidationError {
 	var errors []ValidationError
 
 	if validationErrors, ok := err.(validator.ValidationErrors); ok {
@@ -89,19 +93,18 @@ func formatValidationError(err error) ValidationErrorResponse {
 				message = "Invalid ID"
 			case "phone":
 				message = "Invalid phone number"
+			case "locale":
+				message = "Unsupported language"
 			default:
 				message = "Invalid value"
 			}
 
 			errors = append(errors, ValidationError{
 				Field:   field,
-				Message: message,
+				Message: i18n.T(lang, message),
 			})
 		}
 	}
 
-	return ValidationErrorResponse{
-		Message: "Invalid input data",
-		Errors:  errors,
-	}
+	return errors
 }
@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AIGenerationUseCase records the alternatives returned by the AI generators and the
+// user's feedback on them, and summarizes that feedback for prompt quality analytics.
+type AIGenerationUseCase interface {
+	Record(ctx context.Context, userID uuid.UUID, feature models.AIGenerationFeature, input string, alternatives []string) (*models.AIGeneration, error)
+	SubmitFeedback(ctx context.Context, userID, generationID uuid.UUID, req *dto.AIGenerationFeedbackRequest) (*dto.AIGenerationFeedbackResponse, error)
+	GetStats(ctx context.Context) (*dto.AIGenerationStatsResponse, error)
+}
+
+// AI generation feedback errors, reported to clients by their code.
+var (
+	ErrFeedbackRequired           = apperrors.NewCodedError(apperrors.CodeFeedbackRequired, "")
+	ErrAlternativeIndexOutOfRange = apperrors.NewCodedError(apperrors.CodeAlternativeIndexOutOfRange, "")
+	ErrChosenAlternativeRejected  = apperrors.NewCodedError(apperrors.CodeChosenAlternativeRejected, "")
+)
+
+type aiGenerationUseCase struct {
+	generationRepo repositories.AIGenerationRepository
+	logger         *zap.Logger
+	now            func() time.Time
+}
+
+// NewAIGenerationUseCase creates a new instance of AIGenerationUseCase.
+func NewAIGenerationUseCase(generationRepo repositories.AIGenerationRepository, logger *zap.Logger) AIGenerationUseCase {
+	return &aiGenerationUseCase{
+		generationRepo: generationRepo,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+func (uc *aiGenerationUseCase) Record(ctx context.Context, userID uuid.UUID, feature models.AIGenerationFeature, input string, alternatives []string) (*models.AIGeneration, error) {
+	generation := &models.AIGeneration{
+		UserID:       userID,
+		Feature:      feature,
+		Input:        input,
+		Alternatives: make([]models.AIGenerationAlternative, 0, len(alternatives)),
+	}
+	for i, content := range alternatives {
+		generation.Alternatives = append(generation.Alternatives, models.AIGenerationAlternative{
+			Position: i,
+			Content:  content,
+		})
+	}
+
+	if err := uc.generationRepo.Create(ctx, generation); err != nil {
+		return nil, err
+	}
+	return generation, nil
+}
+
+// SubmitFeedback stores which alternative the user kept and which they rejected.
+// Sending feedback again replaces the previous one.
+func (uc *aiGenerationUseCase) SubmitFeedback(ctx context.Context, userID, generationID uuid.UUID, req *dto.AIGenerationFeedbackRequest) (*dto.AIGenerationFeedbackResponse, error) {
+	generation, err := uc.generationRepo.GetByID(ctx, generationID)
+	if err != nil {
+		return nil, err
+	}
+	if generation.UserID != userID {
+		return nil, fmt.Errorf("AI generation %s: %w", generationID.String(), gorm.ErrRecordNotFound)
+	}
+
+	if req.ChosenIndex == nil && len(req.RejectedIndexes) == 0 {
+		return nil, ErrFeedbackRequired
+	}
+	total := len(generation.Alternatives)
+	if req.ChosenIndex != nil && *req.ChosenIndex >= total {
+		return nil, fmt.Errorf("%w: chosen_index must be lower than %d", ErrAlternativeIndexOutOfRange, total)
+	}
+	rejected := make(map[int]bool, len(req.RejectedIndexes))
+	for _, index := range req.RejectedIndexes {
+		if index >= total {
+			return nil, fmt.Errorf("%w: rejected_indexes must be lower than %d", ErrAlternativeIndexOutOfRange, total)
+		}
+		if req.ChosenIndex != nil && index == *req.ChosenIndex {
+			return nil, ErrChosenAlternativeRejected
+		}
+		rejected[index] = true
+	}
+
+	now := uc.now()
+	generation.ChosenPosition = req.ChosenIndex
+	generation.FeedbackComment = strings.TrimSpace(req.Comment)
+	generation.FeedbackAt = &now
+	for i := range generation.Alternatives {
+		generation.Alternatives[i].Rejected = rejected[generation.Alternatives[i].Position]
+	}
+	if err := uc.generationRepo.SaveFeedback(ctx, generation); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("AI generation feedback received",
+		zap.String("generation_id", generation.ID.String()),
+		zap.String("feature", string(generation.Feature)),
+		zap.Int("rejected", len(rejected)),
+	)
+
+	return toAIGenerationFeedbackResponse(generation), nil
+}
+
+// GetStats summarizes, per generator, how much feedback was received and which
+// alternative positions users keep.
+func (uc *aiGenerationUseCase) GetStats(ctx context.Context) (*dto.AIGenerationStatsResponse, error) {
+	counts, err := uc.generationRepo.CountByFeature(ctx)
+	if err != nil {
+		return nil, err
+	}
+	choices, err := uc.generationRepo.CountChoices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	choicesByFeature := make(map[string][]dto.AIGenerationChoiceStats)
+	for _, choice := range choices {
+		choicesByFeature[choice.Feature] = append(choicesByFeature[choice.Feature], dto.AIGenerationChoiceStats{
+			Index: choice.Position,
+			Count: choice.Count,
+		})
+	}
+
+	resp := &dto.AIGenerationStatsResponse{Features: make([]dto.AIGenerationFeatureStats, 0, len(counts))}
+	for _, count := range counts {
+		featureChoices := choicesByFeature[count.Feature]
+		if featureChoices == nil {
+			featureChoices = []dto.AIGenerationChoiceStats{}
+		}
+		resp.Features = append(resp.Features, dto.AIGenerationFeatureStats{
+			Feature:      count.Feature,
+			Generations:  count.Generations,
+			WithFeedback: count.WithFeedback,
+			FeedbackRate: percentage(count.WithFeedback, int(count.Generations)),
+			Chosen:       count.Chosen,
+			AllRejected:  count.WithFeedback - count.Chosen,
+			Choices:      featureChoices,
+		})
+	}
+	return resp, nil
+}
+
+func toAIGenerationFeedbackResponse(generation *models.AIGeneration) *dto.AIGenerationFeedbackResponse {
+	rejected := make([]int, 0, len(generation.Alternatives))
+	for _, alternative := range generation.Alternatives {
+		if alternative.Rejected {
+			rejected = append(rejected, alternative.Position)
+		}
+	}
+	sort.Ints(rejected)
+
+	return &dto.AIGenerationFeedbackResponse{
+		GenerationID:    generation.ID,
+		Feature:         string(generation.Feature),
+		Alternatives:    len(generation.Alternatives),
+		ChosenIndex:     generation.ChosenPosition,
+		RejectedIndexes: rejected,
+		Comment:         generation.FeedbackComment,
+		FeedbackAt:      generation.FeedbackAt,
+	}
+}
+
+// aiAlternativeCount is the number of choices to request from OpenAI; one when unset.
+func aiAlternativeCount(requested int) int {
+	return max(requested, 1)
+}
+
+// choiceContents returns the content of every choice in the order OpenAI returned them.
+// Callers must check that resp has at least one choice.
+func choiceContents(resp *openai.ChatCompletion) []string {
+	contents := make([]string, 0, len(resp.Choices))
+	for _, choice := range resp.Choices {
+		contents = append(contents, choice.Message.Content)
+	}
+	return contents
+}
@@ -0,0 +1,461 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ApplicationUseCase manages the job application tracker: applications, their status
+// pipeline and history, follow-up reminders and per-user stats.
+type ApplicationUseCase interface {
+	CreateApplication(ctx context.Context, userID uuid.UUID, req *dto.ApplicationRequest) (*dto.ApplicationResponse, error)
+	ListApplications(ctx context.Context, userID uuid.UUID, status string) ([]dto.ApplicationResponse, error)
+	GetApplication(ctx context.Context, userID, applicationID uuid.UUID) (*dto.ApplicationResponse, error)
+	UpdateApplication(ctx context.Context, userID, applicationID uuid.UUID, req *dto.ApplicationRequest) (*dto.ApplicationResponse, error)
+	DeleteApplication(ctx context.Context, userID, applicationID uuid.UUID) error
+	UpdateStatus(ctx context.Context, userID, applicationID uuid.UUID, req *dto.UpdateApplicationStatusRequest) (*dto.ApplicationResponse, error)
+	ListStatusHistory(ctx context.Context, userID, applicationID uuid.UUID) ([]dto.ApplicationStatusChangeResponse, error)
+	ListFollowUpsDue(ctx context.Context, userID uuid.UUID) ([]dto.ApplicationResponse, error)
+	GetStats(ctx context.Context, userID uuid.UUID) (*dto.ApplicationStatsResponse, error)
+	SendFollowUpReminders(ctx context.Context) (int, error)
+}
+
+// Application tracker errors, reported to clients by their code.
+var (
+	ErrInvalidApplicationStatus     = apperrors.NewCodedError(apperrors.CodeInvalidApplicationStatus, "")
+	ErrApplicationStatusUnchanged   = apperrors.NewCodedError(apperrors.CodeApplicationStatusUnchanged, "")
+	ErrInvalidApplicationCurriculum = apperrors.NewCodedError(apperrors.CodeInvalidCurriculumID, "")
+)
+
+type applicationUseCase struct {
+	applicationRepo repositories.ApplicationRepository
+	curriculumRepo  repositories.CurriculumRepository
+	userRepo        repositories.UserRepository
+	emailUseCase    EmailUseCase
+	logger          *zap.Logger
+	now             func() time.Time
+}
+
+// NewApplicationUseCase creates a new instance of ApplicationUseCase.
+// emailUseCase may be nil, in which case follow-up reminders are not emailed.
+func NewApplicationUseCase(
+	applicationRepo repositories.ApplicationRepository,
+	curriculumRepo repositories.CurriculumRepository,
+	userRepo repositories.UserRepository,
+	emailUseCase EmailUseCase,
+	logger *zap.Logger,
+) ApplicationUseCase {
+	return &applicationUseCase{
+		applicationRepo: applicationRepo,
+		curriculumRepo:  curriculumRepo,
+		userRepo:        userRepo,
+		emailUseCase:    emailUseCase,
+		logger:          logger,
+		now:             time.Now,
+	}
+}
+
+func (uc *applicationUseCase) CreateApplication(ctx context.Context, userID uuid.UUID, req *dto.ApplicationRequest) (*dto.ApplicationResponse, error) {
+	now := uc.now()
+	status := models.ApplicationStatusApplied
+	if req.Status != "" {
+		status = models.ApplicationStatus(req.Status)
+	}
+
+	application := &models.Application{
+		UserID:          userID,
+		Status:          status,
+		StatusChangedAt: now,
+	}
+	if err := uc.applyRequest(ctx, userID, application, req); err != nil {
+		return nil, err
+	}
+	if application.AppliedAt == nil && status != models.ApplicationStatusWishlist {
+		application.AppliedAt = &now
+	}
+
+	change := &models.ApplicationStatusChange{
+		ToStatus:  status,
+		ChangedAt: now,
+	}
+	if err := uc.applicationRepo.Create(ctx, application, change); err != nil {
+		return nil, err
+	}
+
+	resp := toApplicationResponse(application)
+	return &resp, nil
+}
+
+func (uc *applicationUseCase) ListApplications(ctx context.Context, userID uuid.UUID, status string) ([]dto.ApplicationResponse, error) {
+	var filter *models.ApplicationStatus
+	if status != "" {
+		s := models.ApplicationStatus(status)
+		if !s.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidApplicationStatus, status)
+		}
+		filter = &s
+	}
+
+	applications, err := uc.applicationRepo.ListByUser(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return toApplicationResponses(applications), nil
+}
+
+func (uc *applicationUseCase) GetApplication(ctx context.Context, userID, applicationID uuid.UUID) (*dto.ApplicationResponse, error) {
+	application, err := uc.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toApplicationResponse(application)
+	return &resp, nil
+}
+
+// UpdateApplication replaces the editable fields and contacts. The status is ignored here;
+// it only changes through UpdateStatus so the history stays complete.
+func (uc *applicationUseCase) UpdateApplication(ctx context.Context, userID, applicationID uuid.UUID, req *dto.ApplicationRequest) (*dto.ApplicationResponse, error) {
+	application, err := uc.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousFollowUp := application.FollowUpAt
+	if err := uc.applyRequest(ctx, userID, application, req); err != nil {
+		return nil, err
+	}
+	if !sameTime(previousFollowUp, application.FollowUpAt) {
+		application.FollowUpReminderSentAt = nil
+	}
+
+	if err := uc.applicationRepo.Update(ctx, application); err != nil {
+		return nil, err
+	}
+
+	resp := toApplicationResponse(application)
+	return &resp, nil
+}
+
+func (uc *applicationUseCase) DeleteApplication(ctx context.Context, userID, applicationID uuid.UUID) error {
+	application, err := uc.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return err
+	}
+	return uc.applicationRepo.Delete(ctx, application.ID)
+}
+
+// UpdateStatus moves the application to a new pipeline stage and records it in the history.
+// Closing an application (accepted, rejected, withdrawn) cancels its pending follow-up.
+func (uc *applicationUseCase) UpdateStatus(ctx context.Context, userID, applicationID uuid.UUID, req *dto.UpdateApplicationStatusRequest) (*dto.ApplicationResponse, error) {
+	application, err := uc.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.ApplicationStatus(req.Status)
+	if !status.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidApplicationStatus, req.Status)
+	}
+	if status == application.Status {
+		return nil, fmt.Errorf("%w: %s", ErrApplicationStatusUnchanged, status)
+	}
+
+	now := uc.now()
+	change := &models.ApplicationStatusChange{
+		ApplicationID: application.ID,
+		FromStatus:    application.Status,
+		ToStatus:      status,
+		Note:          strings.TrimSpace(req.Note),
+		ChangedAt:     now,
+	}
+
+	application.Status = status
+	application.StatusChangedAt = now
+	if application.AppliedAt == nil && status != models.ApplicationStatusWishlist {
+		application.AppliedAt = &now
+	}
+	switch {
+	case req.FollowUpAt != nil:
+		application.FollowUpAt = req.FollowUpAt
+		application.FollowUpReminderSentAt = nil
+	case status.IsClosed():
+		application.FollowUpAt = nil
+		application.FollowUpReminderSentAt = nil
+	}
+
+	if err := uc.applicationRepo.UpdateStatus(ctx, application, change); err != nil {
+		return nil, err
+	}
+
+	resp := toApplicationResponse(application)
+	return &resp, nil
+}
+
+func (uc *applicationUseCase) ListStatusHistory(ctx context.Context, userID, applicationID uuid.UUID) ([]dto.ApplicationStatusChangeResponse, error) {
+	application, err := uc.getOwnedApplication(ctx, userID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := uc.applicationRepo.ListStatusChanges(ctx, application.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.ApplicationStatusChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		resp = append(resp, dto.ApplicationStatusChangeResponse{
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			Note:       change.Note,
+			ChangedAt:  change.ChangedAt,
+		})
+	}
+	return resp, nil
+}
+
+// ListFollowUpsDue returns the user's open applications whose follow-up date has passed,
+// whether or not the reminder email was already sent.
+func (uc *applicationUseCase) ListFollowUpsDue(ctx context.Context, userID uuid.UUID) ([]dto.ApplicationResponse, error) {
+	applications, err := uc.applicationRepo.ListByUser(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	due := make([]models.Application, 0)
+	for _, application := range applications {
+		if isFollowUpDue(&application, now) {
+			due = append(due, application)
+		}
+	}
+	return toApplicationResponses(due), nil
+}
+
+func (uc *applicationUseCase) GetStats(ctx context.Context, userID uuid.UUID) (*dto.ApplicationStatsResponse, error) {
+	applications, err := uc.applicationRepo.ListByUser(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	stats := &dto.ApplicationStatsResponse{
+		Total:    len(applications),
+		ByStatus: make(map[string]int, len(models.ApplicationStatuses)),
+	}
+	for _, status := range models.ApplicationStatuses {
+		stats.ByStatus[string(status)] = 0
+	}
+
+	sent := 0
+	for i := range applications {
+		application := &applications[i]
+		stats.ByStatus[string(application.Status)]++
+		if !application.Status.IsClosed() {
+			stats.Active++
+		}
+		if application.AppliedAt != nil {
+			sent++
+			if application.AppliedAt.After(now.AddDate(0, 0, -30)) {
+				stats.AppliedLast30Days++
+			}
+		}
+		if isFollowUpDue(application, now) {
+			stats.FollowUpsDue++
+		}
+	}
+
+	responded, err := uc.applicationRepo.CountReachedAny(ctx, userID, []models.ApplicationStatus{
+		models.ApplicationStatusScreening,
+		models.ApplicationStatusInterviewing,
+		models.ApplicationStatusOffer,
+		models.ApplicationStatusAccepted,
+		models.ApplicationStatusRejected,
+	})
+	if err != nil {
+		return nil, err
+	}
+	interviewed, err := uc.applicationRepo.CountReachedAny(ctx, userID, []models.ApplicationStatus{
+		models.ApplicationStatusInterviewing,
+		models.ApplicationStatusOffer,
+		models.ApplicationStatusAccepted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	offered, err := uc.applicationRepo.CountReachedAny(ctx, userID, []models.ApplicationStatus{
+		models.ApplicationStatusOffer,
+		models.ApplicationStatusAccepted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.ResponseRate = percentage(responded, sent)
+	stats.InterviewRate = percentage(interviewed, sent)
+	stats.OfferRate = percentage(offered, sent)
+
+	return stats, nil
+}
+
+// SendFollowUpReminders emails users about follow-ups that are due, once per follow-up date.
+func (uc *applicationUseCase) SendFollowUpReminders(ctx context.Context) (int, error) {
+	if uc.emailUseCase == nil {
+		return 0, nil
+	}
+
+	now := uc.now()
+	applications, err := uc.applicationRepo.ListFollowUpsDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range applications {
+		application := &applications[i]
+
+		claimed, err := uc.applicationRepo.MarkFollowUpReminderSent(ctx, application.ID, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		user, err := uc.userRepo.GetByIDWithConfiguration(ctx, application.UserID)
+		if err != nil {
+			uc.logger.Warn("Failed to load user for application follow-up reminder", zap.String("user_id", application.UserID.String()), zap.Error(err))
+			continue
+		}
+		if err := uc.emailUseCase.SendApplicationFollowUpEmail(emailRecipientOf(user), application.Company, application.Position, *application.FollowUpAt); err != nil {
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// applyRequest copies the editable request fields onto the application, checking that a
+// referenced curriculum belongs to the user.
+func (uc *applicationUseCase) applyRequest(ctx context.Context, userID uuid.UUID, application *models.Application, req *dto.ApplicationRequest) error {
+	application.Company = strings.TrimSpace(req.Company)
+	application.Position = strings.TrimSpace(req.Position)
+	application.JobURL = strings.TrimSpace(req.JobURL)
+	application.Location = strings.TrimSpace(req.Location)
+	application.Notes = req.Notes
+	application.FollowUpAt = req.FollowUpAt
+	if req.AppliedAt != nil {
+		application.AppliedAt = req.AppliedAt
+	}
+
+	application.CurriculumID = nil
+	if req.CurriculumID != nil && *req.CurriculumID != "" {
+		curriculumID, err := uuid.Parse(*req.CurriculumID)
+		if err != nil {
+			return ErrInvalidApplicationCurriculum
+		}
+		curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+		if err != nil {
+			return err
+		}
+		if curriculum.UserID != userID {
+			return fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+		}
+		application.CurriculumID = &curriculum.ID
+	}
+
+	application.Contacts = make([]models.ApplicationContact, 0, len(req.Contacts))
+	for _, contact := range req.Contacts {
+		application.Contacts = append(application.Contacts, models.ApplicationContact{
+			Name:        strings.TrimSpace(contact.Name),
+			Role:        strings.TrimSpace(contact.Role),
+			Email:       strings.TrimSpace(contact.Email),
+			Phone:       strings.TrimSpace(contact.Phone),
+			LinkedInURL: strings.TrimSpace(contact.LinkedInURL),
+		})
+	}
+	return nil
+}
+
+// getOwnedApplication returns gorm.ErrRecordNotFound (wrapped) when the application belongs to another user.
+func (uc *applicationUseCase) getOwnedApplication(ctx context.Context, userID, applicationID uuid.UUID) (*models.Application, error) {
+	application, err := uc.applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.UserID != userID {
+		return nil, fmt.Errorf("application %s: %w", applicationID.String(), gorm.ErrRecordNotFound)
+	}
+	return application, nil
+}
+
+func isFollowUpDue(application *models.Application, now time.Time) bool {
+	return application.FollowUpAt != nil && !application.FollowUpAt.After(now) && !application.Status.IsClosed()
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// percentage returns part/total as a percentage rounded to one decimal, 0 when total is 0.
+func percentage(part int64, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*1000/float64(total)) / 10
+}
+
+func toApplicationResponses(applications []models.Application) []dto.ApplicationResponse {
+	resp := make([]dto.ApplicationResponse, 0, len(applications))
+	for i := range applications {
+		resp = append(resp, toApplicationResponse(&applications[i]))
+	}
+	return resp
+}
+
+func toApplicationResponse(application *models.Application) dto.ApplicationResponse {
+	contacts := make([]dto.ApplicationContactResponse, 0, len(application.Contacts))
+	for _, contact := range application.Contacts {
+		contacts = append(contacts, dto.ApplicationContactResponse{
+			ID:          contact.ID,
+			Name:        contact.Name,
+			Role:        contact.Role,
+			Email:       contact.Email,
+			Phone:       contact.Phone,
+			LinkedInURL: contact.LinkedInURL,
+		})
+	}
+
+	return dto.ApplicationResponse{
+		ID:              application.ID,
+		CurriculumID:    application.CurriculumID,
+		Company:         application.Company,
+		Position:        application.Position,
+		JobURL:          application.JobURL,
+		Location:        application.Location,
+		Status:          string(application.Status),
+		AppliedAt:       application.AppliedAt,
+		StatusChangedAt: application.StatusChangedAt,
+		FollowUpAt:      application.FollowUpAt,
+		Notes:           application.Notes,
+		Contacts:        contacts,
+		CreatedAt:       application.CreatedAt,
+		UpdatedAt:       application.UpdatedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/google/uuid"
@@ -17,17 +18,23 @@ type ConfigurationUseCase interface {
 	CreateDefaultConfiguration(ctx context.Context, userID uuid.UUID) (*dto.ConfigurationResponse, error)
 	UpdateConfiguration(ctx context.Context, userID uuid.UUID, req *dto.UpdateConfigurationRequest) (*dto.ConfigurationResponse, error)
 	DeleteConfiguration(ctx context.Context, userID uuid.UUID) error
+	GetLanguage(ctx context.Context, userID uuid.UUID) (string, error)
+	GetLanguageByEmail(ctx context.Context, email string) (string, error)
 }
 
 type configurationUseCase struct {
 	configurationRepo repositories.ConfigurationRepository
+	newsletterUseCase NewsletterUseCase
 	cacheService      *cache.CacheService
 	logger            *zap.Logger
 }
 
-func NewConfigurationUseCase(configurationRepo repositories.ConfigurationRepository, cacheService *cache.CacheService, logger *zap.Logger) ConfigurationUseCase {
+// NewConfigurationUseCase creates a new instance of ConfigurationUseCase.
+// newsletterUseCase may be nil when the configuration is only read, e.g. for the user's language.
+func NewConfigurationUseCase(configurationRepo repositories.ConfigurationRepository, newsletterUseCase NewsletterUseCase, cacheService *cache.CacheService, logger *zap.Logger) ConfigurationUseCase {
 	return &configurationUseCase{
 		configurationRepo: configurationRepo,
+		newsletterUseCase: newsletterUseCase,
 		cacheService:      cacheService,
 		logger:            logger,
 	}
@@ -56,12 +63,13 @@ func (c *configurationUseCase) GetConfigurationByUserID(ctx context.Context, use
 
 	// Create response
 	configurationResponse = dto.ConfigurationResponse{
-		ID:         configuration.ID,
-		UserID:     configuration.UserID,
-		Language:   configuration.Language,
-		Newsletter: configuration.Newsletter,
-		CreatedAt:  configuration.CreatedAt,
-		UpdatedAt:  configuration.UpdatedAt,
+		ID:               configuration.ID,
+		UserID:           configuration.UserID,
+		Language:         configuration.Language,
+		Newsletter:       configuration.Newsletter,
+		NewsletterStatus: string(configuration.NewsletterStatus()),
+		CreatedAt:        configuration.CreatedAt,
+		UpdatedAt:        configuration.UpdatedAt,
 	}
 
 	// Armazena os dados em cache por 15 minutos
@@ -84,8 +92,8 @@ func (c *configurationUseCase) GetConfigurationByUserID(ctx context.Context, use
 func (c *configurationUseCase) CreateDefaultConfiguration(ctx context.Context, userID uuid.UUID) (*dto.ConfigurationResponse, error) {
 	configuration := &models.Configuration{
 		UserID:     userID,
-		Language:   "en-us", // Idioma padrão
-		Newsletter: false,   // Newsletter padrão: off
+		Language:   locale.Default().Tag, // Idioma padrão
+		Newsletter: false,                // Newsletter padrão: off
 	}
 
 	if err := c.configurationRepo.Create(ctx, configuration); err != nil {
@@ -93,12 +101,13 @@ func (c *configurationUseCase) CreateDefaultConfiguration(ctx context.Context, u
 	}
 
 	return &dto.ConfigurationResponse{
-		ID:         configuration.ID,
-		UserID:     configuration.UserID,
-		Language:   configuration.Language,
-		Newsletter: configuration.Newsletter,
-		CreatedAt:  configuration.CreatedAt,
-		UpdatedAt:  configuration.UpdatedAt,
+		ID:               configuration.ID,
+		UserID:           configuration.UserID,
+		Language:         configuration.Language,
+		Newsletter:       configuration.Newsletter,
+		NewsletterStatus: string(configuration.NewsletterStatus()),
+		CreatedAt:        configuration.CreatedAt,
+		UpdatedAt:        configuration.UpdatedAt,
 	}, nil
 }
 
@@ -110,13 +119,30 @@ func (c *configurationUseCase) UpdateConfiguration(ctx context.Context, userID u
 		return nil, err
 	}
 
-	// Atualiza os campos se fornecidos
-	if req.Language != "" {
-		configuration.Language = req.Language
+	// A newsletter só é ativada após a confirmação por email (double opt-in)
+	if req.Newsletter != nil && *req.Newsletter != configuration.Newsletter {
+		if c.newsletterUseCase == nil {
+			return nil, ErrNewsletterUnavailable
+		}
+		if *req.Newsletter {
+			_, err = c.newsletterUseCase.Subscribe(ctx, userID)
+		} else {
+			_, err = c.newsletterUseCase.Unsubscribe(ctx, userID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Recarrega a configuração para não sobrescrever o estado da newsletter
+		configuration, err = c.configurationRepo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if req.Newsletter != configuration.Newsletter {
-		configuration.Newsletter = req.Newsletter
+	// Atualiza os campos se fornecidos
+	if req.Language != "" {
+		configuration.Language = locale.Resolve(req.Language).Tag
 	}
 
 	// Salva a configuração atualizada
@@ -135,12 +161,13 @@ func (c *configurationUseCase) UpdateConfiguration(ctx context.Context, userID u
 	}
 
 	return &dto.ConfigurationResponse{
-		ID:         configuration.ID,
-		UserID:     configuration.UserID,
-		Language:   configuration.Language,
-		Newsletter: configuration.Newsletter,
-		CreatedAt:  configuration.CreatedAt,
-		UpdatedAt:  configuration.UpdatedAt,
+		ID:               configuration.ID,
+		UserID:           configuration.UserID,
+		Language:         configuration.Language,
+		Newsletter:       configuration.Newsletter,
+		NewsletterStatus: string(configuration.NewsletterStatus()),
+		CreatedAt:        configuration.CreatedAt,
+		UpdatedAt:        configuration.UpdatedAt,
 	}, nil
 }
 
@@ -169,3 +196,22 @@ func (c *configurationUseCase) DeleteConfiguration(ctx context.Context, userID u
 
 	return nil
 }
+
+// GetLanguage returns the preferred language tag of a user, read through the configuration cache.
+func (c *configurationUseCase) GetLanguage(ctx context.Context, userID uuid.UUID) (string, error) {
+	configuration, err := c.GetConfigurationByUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return configuration.Language, nil
+}
+
+// GetLanguageByEmail returns the preferred language tag of the user with the given email.
+// Used before the user is authenticated, e.g. for the magic-link email.
+func (c *configurationUseCase) GetLanguageByEmail(ctx context.Context, email string) (string, error) {
+	configuration, err := c.configurationRepo.GetByUserEmail(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	return configuration.Language, nil
+}
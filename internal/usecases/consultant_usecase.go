@@ -0,0 +1,299 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ConsultantUseCase manages the consultant workspace: client profiles, the curriculums
+// kept on their behalf and the draft -> in_review -> approved review flow.
+type ConsultantUseCase interface {
+	CreateClient(ctx context.Context, consultantID uuid.UUID, req *dto.ClientProfileRequest) (*dto.ClientProfileResponse, error)
+	ListClients(ctx context.Context, consultantID uuid.UUID) ([]dto.ClientProfileResponse, error)
+	GetClient(ctx context.Context, consultantID, clientID uuid.UUID) (*dto.ClientProfileResponse, error)
+	UpdateClient(ctx context.Context, consultantID, clientID uuid.UUID, req *dto.ClientProfileRequest) (*dto.ClientProfileResponse, error)
+	DeleteClient(ctx context.Context, consultantID, clientID uuid.UUID) error
+	UpdateCurriculumStatus(ctx context.Context, consultantID, curriculumID uuid.UUID, req *dto.UpdateCurriculumStatusRequest) (*dto.CurriculumResponse, error)
+	ListReviews(ctx context.Context, userID uuid.UUID) ([]dto.CurriculumResponse, error)
+	ApproveCurriculum(ctx context.Context, userID, curriculumID uuid.UUID) (*dto.CurriculumResponse, error)
+	RequestCurriculumChanges(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.RequestCurriculumChangesRequest) (*dto.CurriculumResponse, error)
+}
+
+// Consultant review flow errors, reported to clients by their code.
+var (
+	ErrClientHasCurriculums        = apperrors.NewCodedError(apperrors.CodeClientHasCurriculums, "")
+	ErrCurriculumNotLinkedToClient = apperrors.NewCodedError(apperrors.CodeCurriculumNotLinkedToClient, "")
+	ErrInvalidCurriculumStatus     = apperrors.NewCodedError(apperrors.CodeInvalidCurriculumStatus, "")
+	ErrCurriculumStatusUnchanged   = apperrors.NewCodedError(apperrors.CodeCurriculumStatusUnchanged, "")
+	ErrCurriculumNotInReview       = apperrors.NewCodedError(apperrors.CodeCurriculumNotInReview, "")
+)
+
+type consultantUseCase struct {
+	clientProfileRepo repositories.ClientProfileRepository
+	curriculumRepo    repositories.CurriculumRepository
+	userRepo          repositories.UserRepository
+	cacheService      *cache.CacheService
+	logger            *zap.Logger
+	now               func() time.Time
+}
+
+// NewConsultantUseCase creates a new instance of ConsultantUseCase.
+func NewConsultantUseCase(
+	clientProfileRepo repositories.ClientProfileRepository,
+	curriculumRepo repositories.CurriculumRepository,
+	userRepo repositories.UserRepository,
+	cacheService *cache.CacheService,
+	logger *zap.Logger,
+) ConsultantUseCase {
+	return &consultantUseCase{
+		clientProfileRepo: clientProfileRepo,
+		curriculumRepo:    curriculumRepo,
+		userRepo:          userRepo,
+		cacheService:      cacheService,
+		logger:            logger,
+		now:               time.Now,
+	}
+}
+
+func (uc *consultantUseCase) CreateClient(ctx context.Context, consultantID uuid.UUID, req *dto.ClientProfileRequest) (*dto.ClientProfileResponse, error) {
+	client := &models.ClientProfile{
+		ConsultantUserID: consultantID,
+		Name:             strings.TrimSpace(req.Name),
+		Email:            strings.ToLower(strings.TrimSpace(req.Email)),
+		Notes:            req.Notes,
+	}
+	if err := uc.clientProfileRepo.Create(ctx, client); err != nil {
+		return nil, err
+	}
+
+	resp := toClientProfileResponse(client)
+	return &resp, nil
+}
+
+func (uc *consultantUseCase) ListClients(ctx context.Context, consultantID uuid.UUID) ([]dto.ClientProfileResponse, error) {
+	clients, err := uc.clientProfileRepo.ListByConsultant(ctx, consultantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.ClientProfileResponse, 0, len(clients))
+	for i := range clients {
+		resp = append(resp, toClientProfileResponse(&clients[i]))
+	}
+	return resp, nil
+}
+
+// GetClient returns the client profile with the curriculums the consultant manages for it.
+func (uc *consultantUseCase) GetClient(ctx context.Context, consultantID, clientID uuid.UUID) (*dto.ClientProfileResponse, error) {
+	client, err := uc.getOwnedClient(ctx, consultantID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculums, err := uc.curriculumRepo.ListByClientProfileID(ctx, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toClientProfileResponse(client)
+	resp.Curriculums = make([]dto.CurriculumResponse, 0, len(curriculums))
+	for i := range curriculums {
+		resp.Curriculums = append(resp.Curriculums, toCurriculumResponse(&curriculums[i]))
+	}
+	return &resp, nil
+}
+
+func (uc *consultantUseCase) UpdateClient(ctx context.Context, consultantID, clientID uuid.UUID, req *dto.ClientProfileRequest) (*dto.ClientProfileResponse, error) {
+	client, err := uc.getOwnedClient(ctx, consultantID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	client.Name = strings.TrimSpace(req.Name)
+	client.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	client.Notes = req.Notes
+	if err := uc.clientProfileRepo.Save(ctx, client); err != nil {
+		return nil, err
+	}
+
+	resp := toClientProfileResponse(client)
+	return &resp, nil
+}
+
+// DeleteClient removes a client profile that no longer has curriculums.
+func (uc *consultantUseCase) DeleteClient(ctx context.Context, consultantID, clientID uuid.UUID) error {
+	client, err := uc.getOwnedClient(ctx, consultantID, clientID)
+	if err != nil {
+		return err
+	}
+
+	curriculums, err := uc.curriculumRepo.ListByClientProfileID(ctx, client.ID)
+	if err != nil {
+		return err
+	}
+	if len(curriculums) > 0 {
+		return ErrClientHasCurriculums
+	}
+
+	return uc.clientProfileRepo.Delete(ctx, client.ID)
+}
+
+// UpdateCurriculumStatus lets the consultant share a draft with the client (in_review)
+// or take a shared or approved curriculum back to draft for further edits.
+func (uc *consultantUseCase) UpdateCurriculumStatus(ctx context.Context, consultantID, curriculumID uuid.UUID, req *dto.UpdateCurriculumStatusRequest) (*dto.CurriculumResponse, error) {
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != consultantID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	status := models.CurriculumStatus(req.Status)
+	switch status {
+	case models.CurriculumStatusInReview:
+		if curriculum.ClientProfileID == nil {
+			return nil, ErrCurriculumNotLinkedToClient
+		}
+		if curriculum.Status == models.CurriculumStatusInReview {
+			return nil, fmt.Errorf("%w: already %s", ErrCurriculumStatusUnchanged, status)
+		}
+		curriculum.ReviewComment = ""
+	case models.CurriculumStatusDraft:
+		if curriculum.Status == models.CurriculumStatusDraft {
+			return nil, fmt.Errorf("%w: already %s", ErrCurriculumStatusUnchanged, status)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCurriculumStatus, req.Status)
+	}
+
+	return uc.applyStatus(ctx, curriculum, status)
+}
+
+// ListReviews returns the curriculums shared with the caller's email for review, including approved ones.
+func (uc *consultantUseCase) ListReviews(ctx context.Context, userID uuid.UUID) ([]dto.CurriculumResponse, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculums, err := uc.curriculumRepo.ListSharedWithEmail(ctx, user.Email, []models.CurriculumStatus{
+		models.CurriculumStatusInReview,
+		models.CurriculumStatusApproved,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.CurriculumResponse, 0, len(curriculums))
+	for i := range curriculums {
+		resp = append(resp, toCurriculumResponse(&curriculums[i]))
+	}
+	return resp, nil
+}
+
+func (uc *consultantUseCase) ApproveCurriculum(ctx context.Context, userID, curriculumID uuid.UUID) (*dto.CurriculumResponse, error) {
+	curriculum, err := uc.getCurriculumInReview(ctx, userID, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculum.ReviewComment = ""
+	return uc.applyStatus(ctx, curriculum, models.CurriculumStatusApproved)
+}
+
+// RequestCurriculumChanges sends the curriculum back to the consultant as a draft with the client's comment.
+func (uc *consultantUseCase) RequestCurriculumChanges(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.RequestCurriculumChangesRequest) (*dto.CurriculumResponse, error) {
+	curriculum, err := uc.getCurriculumInReview(ctx, userID, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculum.ReviewComment = strings.TrimSpace(req.Comment)
+	return uc.applyStatus(ctx, curriculum, models.CurriculumStatusDraft)
+}
+
+func (uc *consultantUseCase) applyStatus(ctx context.Context, curriculum *models.Curriculums, status models.CurriculumStatus) (*dto.CurriculumResponse, error) {
+	now := uc.now()
+	curriculum.Status = status
+	curriculum.StatusChangedAt = &now
+	if err := uc.curriculumRepo.UpdateStatus(ctx, curriculum); err != nil {
+		return nil, err
+	}
+
+	cacheKey := cache.GenerateCurriculumCacheKey(curriculum.ID.String())
+	if err := uc.cacheService.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("Failed to invalidate curriculum cache after status change",
+			zap.Error(err),
+			zap.String("curriculum_id", curriculum.ID.String()))
+	}
+
+	uc.logger.Info("Curriculum status changed",
+		zap.String("curriculum_id", curriculum.ID.String()),
+		zap.String("status", string(status)),
+	)
+
+	resp := toCurriculumResponse(curriculum)
+	return &resp, nil
+}
+
+// getOwnedClient returns gorm.ErrRecordNotFound (wrapped) when the client belongs to another consultant.
+func (uc *consultantUseCase) getOwnedClient(ctx context.Context, consultantID, clientID uuid.UUID) (*models.ClientProfile, error) {
+	client, err := uc.clientProfileRepo.GetByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client.ConsultantUserID != consultantID {
+		return nil, fmt.Errorf("client profile %s: %w", clientID.String(), gorm.ErrRecordNotFound)
+	}
+	return client, nil
+}
+
+// getCurriculumInReview loads a curriculum shared with the caller. Curriculums not shared
+// with the caller's email are reported as not found.
+func (uc *consultantUseCase) getCurriculumInReview(ctx context.Context, userID, curriculumID uuid.UUID) (*models.Curriculums, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.ClientProfileID == nil {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+	client, err := uc.clientProfileRepo.GetByID(ctx, *curriculum.ClientProfileID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(client.Email, user.Email) {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+	if curriculum.Status != models.CurriculumStatusInReview {
+		return nil, ErrCurriculumNotInReview
+	}
+	return curriculum, nil
+}
+
+func toClientProfileResponse(client *models.ClientProfile) dto.ClientProfileResponse {
+	return dto.ClientProfileResponse{
+		ID:        client.ID,
+		Name:      client.Name,
+		Email:     client.Email,
+		Notes:     client.Notes,
+		CreatedAt: client.CreatedAt,
+		UpdatedAt: client.UpdatedAt,
+	}
+}
@@ -0,0 +1,215 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Cover letter export formats
+const (
+	CoverLetterFormatText = "txt"
+	CoverLetterFormatHTML = "html"
+)
+
+// Cover letter errors, reported to clients by their code.
+var (
+	ErrInvalidExportFormat     = apperrors.NewCodedError(apperrors.CodeInvalidExportFormat, "")
+	ErrCoverLetterContentBlank = apperrors.NewCodedError(apperrors.CodeCoverLetterContentBlank, "")
+)
+
+// CoverLetterExport is a cover letter rendered as a downloadable file.
+type CoverLetterExport struct {
+	Filename    string
+	ContentType string
+	Body        []byte
+}
+
+// CoverLetterUseCase manages stored cover letters. Letters are created by GenerateCoverLetterAIUseCase.
+type CoverLetterUseCase interface {
+	ListCoverLetters(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]dto.CoverLetterResponse, error)
+	GetCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID) (*dto.CoverLetterResponse, error)
+	UpdateCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID, req *dto.UpdateCoverLetterRequest) (*dto.CoverLetterResponse, error)
+	DeleteCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID) error
+	ExportCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID, format string) (*CoverLetterExport, error)
+}
+
+type coverLetterUseCase struct {
+	coverLetterRepo repositories.CoverLetterRepository
+	logger          *zap.Logger
+}
+
+// NewCoverLetterUseCase creates a new instance of CoverLetterUseCase.
+func NewCoverLetterUseCase(coverLetterRepo repositories.CoverLetterRepository, logger *zap.Logger) CoverLetterUseCase {
+	return &coverLetterUseCase{
+		coverLetterRepo: coverLetterRepo,
+		logger:          logger,
+	}
+}
+
+func (uc *coverLetterUseCase) ListCoverLetters(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]dto.CoverLetterResponse, error) {
+	letters, err := uc.coverLetterRepo.ListByUser(ctx, userID, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.CoverLetterResponse, 0, len(letters))
+	for i := range letters {
+		resp = append(resp, toCoverLetterResponse(&letters[i]))
+	}
+	return resp, nil
+}
+
+func (uc *coverLetterUseCase) GetCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID) (*dto.CoverLetterResponse, error) {
+	letter, err := uc.getOwnedCoverLetter(ctx, userID, coverLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toCoverLetterResponse(letter)
+	return &resp, nil
+}
+
+func (uc *coverLetterUseCase) UpdateCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID, req *dto.UpdateCoverLetterRequest) (*dto.CoverLetterResponse, error) {
+	letter, err := uc.getOwnedCoverLetter(ctx, userID, coverLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	letter.Title = strings.TrimSpace(req.Title)
+	letter.Company = strings.TrimSpace(req.Company)
+	letter.Position = strings.TrimSpace(req.Position)
+	letter.Content = strings.TrimSpace(req.Content)
+	if letter.Content == "" {
+		return nil, ErrCoverLetterContentBlank
+	}
+
+	if err := uc.coverLetterRepo.Update(ctx, letter); err != nil {
+		return nil, err
+	}
+
+	resp := toCoverLetterResponse(letter)
+	return &resp, nil
+}
+
+func (uc *coverLetterUseCase) DeleteCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID) error {
+	letter, err := uc.getOwnedCoverLetter(ctx, userID, coverLetterID)
+	if err != nil {
+		return err
+	}
+	return uc.coverLetterRepo.Delete(ctx, letter.ID)
+}
+
+// ExportCoverLetter renders the letter as plain text (txt) or a standalone HTML page (html)
+// that can be printed to PDF from the browser.
+func (uc *coverLetterUseCase) ExportCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID, format string) (*CoverLetterExport, error) {
+	if format == "" {
+		format = CoverLetterFormatText
+	}
+	if format != CoverLetterFormatText && format != CoverLetterFormatHTML {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExportFormat, format)
+	}
+
+	letter, err := uc.getOwnedCoverLetter(ctx, userID, coverLetterID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &CoverLetterExport{
+		Filename: coverLetterFilename(letter.Title) + "." + format,
+	}
+	switch format {
+	case CoverLetterFormatHTML:
+		export.ContentType = "text/html; charset=utf-8"
+		export.Body = []byte(renderCoverLetterHTML(letter))
+	default:
+		export.ContentType = "text/plain; charset=utf-8"
+		export.Body = []byte(letter.Content + "\n")
+	}
+	return export, nil
+}
+
+// getOwnedCoverLetter returns gorm.ErrRecordNotFound (wrapped) when the letter belongs to another user.
+func (uc *coverLetterUseCase) getOwnedCoverLetter(ctx context.Context, userID, coverLetterID uuid.UUID) (*models.CoverLetter, error) {
+	letter, err := uc.coverLetterRepo.GetByID(ctx, coverLetterID)
+	if err != nil {
+		return nil, err
+	}
+	if letter.UserID != userID {
+		return nil, fmt.Errorf("cover letter %s: %w", coverLetterID.String(), gorm.ErrRecordNotFound)
+	}
+	return letter, nil
+}
+
+// renderCoverLetterHTML escapes the letter and turns blank-line separated blocks into paragraphs.
+func renderCoverLetterHTML(letter *models.CoverLetter) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>")
+	b.WriteString(html.EscapeString(letter.Title))
+	b.WriteString("</title>\n<style>body{font-family:Georgia,serif;max-width:42rem;margin:3rem auto;line-height:1.5;}</style>\n</head>\n<body>\n")
+
+	content := strings.ReplaceAll(letter.Content, "\r\n", "\n")
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		lines := strings.Split(paragraph, "\n")
+		for i := range lines {
+			lines[i] = html.EscapeString(strings.TrimSpace(lines[i]))
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(lines, "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// coverLetterFilename turns the title into a lowercase ASCII file name.
+func coverLetterFilename(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	name := strings.TrimSuffix(b.String(), "-")
+	if name == "" {
+		return "cover-letter"
+	}
+	return name
+}
+
+func toCoverLetterResponse(letter *models.CoverLetter) dto.CoverLetterResponse {
+	return dto.CoverLetterResponse{
+		ID:             letter.ID,
+		CurriculumID:   letter.CurriculumID,
+		ApplicationID:  letter.ApplicationID,
+		Title:          letter.Title,
+		Company:        letter.Company,
+		Position:       letter.Position,
+		JobDescription: letter.JobDescription,
+		Tone:           letter.Tone,
+		Language:       letter.Language,
+		Content:        letter.Content,
+		CreatedAt:      letter.CreatedAt,
+		UpdatedAt:      letter.UpdatedAt,
+	}
+}
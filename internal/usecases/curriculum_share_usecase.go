@@ -0,0 +1,321 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	// shareSlugBytes gives a 22 character URL-safe slug (128 bits of randomness).
+	shareSlugBytes = 16
+	// shareViewsDefaultLimit and shareViewsMaxLimit bound the recent views returned to the owner.
+	shareViewsDefaultLimit = 50
+	shareViewsMaxLimit     = 200
+	maxShareReferrerLength = 2048
+)
+
+var (
+	// ErrShareLinkPasswordRequired is returned when a protected link is opened without a password.
+	ErrShareLinkPasswordRequired = errors.New("password required")
+	// ErrShareLinkInvalidPassword is returned when the password does not match.
+	ErrShareLinkInvalidPassword = errors.New("invalid password")
+	// ErrShareLinkUnavailable is returned for revoked or expired links.
+	ErrShareLinkUnavailable = errors.New("share link is no longer available")
+)
+
+// ShareViewer describes who opened a public link, as far as the request tells.
+type ShareViewer struct {
+	Password string
+	Referrer string
+	Country  string
+}
+
+// CurriculumShareUseCase manages public share links of curriculums and their view analytics.
+type CurriculumShareUseCase interface {
+	CreateShareLink(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.CreateShareLinkRequest) (*dto.ShareLinkResponse, error)
+	ListShareLinks(ctx context.Context, userID, curriculumID uuid.UUID) ([]dto.ShareLinkResponse, error)
+	RevokeShareLink(ctx context.Context, userID, curriculumID, linkID uuid.UUID) error
+	GetShareLinkViews(ctx context.Context, userID, curriculumID, linkID uuid.UUID, limit int) (*dto.ShareLinkViewsResponse, error)
+	ViewSharedCurriculum(ctx context.Context, slug string, viewer ShareViewer) (*dto.PublicCurriculumResponse, error)
+}
+
+type curriculumShareUseCase struct {
+	shareRepo      repositories.CurriculumShareRepository
+	curriculumRepo repositories.CurriculumRepository
+	appURL         string
+	logger         *zap.Logger
+	now            func() time.Time
+}
+
+// NewCurriculumShareUseCase creates a new instance of CurriculumShareUseCase.
+func NewCurriculumShareUseCase(
+	shareRepo repositories.CurriculumShareRepository,
+	curriculumRepo repositories.CurriculumRepository,
+	appURL string,
+	logger *zap.Logger,
+) CurriculumShareUseCase {
+	return &curriculumShareUseCase{
+		shareRepo:      shareRepo,
+		curriculumRepo: curriculumRepo,
+		appURL:         strings.TrimRight(appURL, "/"),
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+func (uc *curriculumShareUseCase) CreateShareLink(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.CreateShareLinkRequest) (*dto.ShareLinkResponse, error) {
+	if _, err := uc.getOwnedCurriculum(ctx, userID, curriculumID); err != nil {
+		return nil, err
+	}
+
+	slug, err := generateShareSlug()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share slug: %w", err)
+	}
+
+	link := &models.CurriculumShareLink{
+		CurriculumID: curriculumID,
+		UserID:       userID,
+		Slug:         slug,
+		Label:        strings.TrimSpace(req.Label),
+	}
+	if req.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash share link password: %w", err)
+		}
+		hashStr := string(hash)
+		link.PasswordHash = &hashStr
+	}
+	if req.ExpiresInHours > 0 {
+		expiresAt := uc.now().Add(time.Duration(req.ExpiresInHours) * time.Hour)
+		link.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.shareRepo.CreateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Curriculum share link created",
+		zap.String("curriculum_id", curriculumID.String()),
+		zap.String("share_link_id", link.ID.String()),
+		zap.Bool("password_protected", link.HasPassword()),
+	)
+
+	resp := uc.toShareLinkResponse(link)
+	return &resp, nil
+}
+
+func (uc *curriculumShareUseCase) ListShareLinks(ctx context.Context, userID, curriculumID uuid.UUID) ([]dto.ShareLinkResponse, error) {
+	if _, err := uc.getOwnedCurriculum(ctx, userID, curriculumID); err != nil {
+		return nil, err
+	}
+
+	links, err := uc.shareRepo.ListLinksByCurriculum(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.ShareLinkResponse, 0, len(links))
+	for i := range links {
+		resp = append(resp, uc.toShareLinkResponse(&links[i]))
+	}
+	return resp, nil
+}
+
+// RevokeShareLink disables the link immediately. Revoking an already revoked link is a no-op.
+func (uc *curriculumShareUseCase) RevokeShareLink(ctx context.Context, userID, curriculumID, linkID uuid.UUID) error {
+	link, err := uc.getOwnedLink(ctx, userID, curriculumID, linkID)
+	if err != nil {
+		return err
+	}
+	if link.RevokedAt != nil {
+		return nil
+	}
+
+	if err := uc.shareRepo.RevokeLink(ctx, link.ID, uc.now()); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Curriculum share link revoked",
+		zap.String("curriculum_id", curriculumID.String()),
+		zap.String("share_link_id", link.ID.String()),
+	)
+	return nil
+}
+
+// GetShareLinkViews returns totals by country and referrer plus the most recent views.
+func (uc *curriculumShareUseCase) GetShareLinkViews(ctx context.Context, userID, curriculumID, linkID uuid.UUID, limit int) (*dto.ShareLinkViewsResponse, error) {
+	link, err := uc.getOwnedLink(ctx, userID, curriculumID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if limit < 1 {
+		limit = shareViewsDefaultLimit
+	}
+	if limit > shareViewsMaxLimit {
+		limit = shareViewsMaxLimit
+	}
+
+	byCountry, err := uc.shareRepo.CountViewsBy(ctx, link.ID, "country")
+	if err != nil {
+		return nil, err
+	}
+	byReferrer, err := uc.shareRepo.CountViewsBy(ctx, link.ID, "referrer")
+	if err != nil {
+		return nil, err
+	}
+	views, err := uc.shareRepo.ListViews(ctx, link.ID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]dto.ShareViewResponse, 0, len(views))
+	for _, v := range views {
+		recent = append(recent, dto.ShareViewResponse{
+			ViewedAt: v.ViewedAt,
+			Referrer: v.Referrer,
+			Country:  v.Country,
+		})
+	}
+
+	return &dto.ShareLinkViewsResponse{
+		ShareLinkID: link.ID,
+		TotalViews:  link.ViewCount,
+		ByCountry:   toShareViewCounts(byCountry),
+		ByReferrer:  toShareViewCounts(byReferrer),
+		Recent:      recent,
+	}, nil
+}
+
+// ViewSharedCurriculum serves the curriculum behind a public slug and records the view.
+// Unknown slugs and deleted curriculums are reported as gorm.ErrRecordNotFound (wrapped).
+func (uc *curriculumShareUseCase) ViewSharedCurriculum(ctx context.Context, slug string, viewer ShareViewer) (*dto.PublicCurriculumResponse, error) {
+	link, err := uc.shareRepo.GetLinkBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	if !link.IsActive(now) {
+		return nil, ErrShareLinkUnavailable
+	}
+	if link.HasPassword() {
+		if viewer.Password == "" {
+			return nil, ErrShareLinkPasswordRequired
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(*link.PasswordHash), []byte(viewer.Password)); err != nil {
+			return nil, ErrShareLinkInvalidPassword
+		}
+	}
+
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, link.CurriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	referrer := viewer.Referrer
+	if len(referrer) > maxShareReferrerLength {
+		referrer = referrer[:maxShareReferrerLength]
+	}
+	view := &models.CurriculumShareView{
+		ShareLinkID:  link.ID,
+		CurriculumID: link.CurriculumID,
+		ViewedAt:     now,
+		Referrer:     referrer,
+		Country:      strings.ToUpper(viewer.Country),
+	}
+	// A failed analytics write must not hide the curriculum from the recruiter.
+	if err := uc.shareRepo.RecordView(ctx, view); err != nil {
+		uc.logger.Warn("Failed to record share link view",
+			zap.Error(err),
+			zap.String("share_link_id", link.ID.String()))
+	}
+
+	full := toCurriculumResponse(curriculum)
+	return &dto.PublicCurriculumResponse{
+		FullName:    full.FullName,
+		Email:       full.Email,
+		Phone:       full.Phone,
+		Intro:       full.Intro,
+		Skills:      full.Skills,
+		Languages:   full.Languages,
+		Courses:     full.Courses,
+		SocialLinks: full.SocialLinks,
+		ImageURL:    full.ImageURL,
+		Works:       full.Works,
+		Educations:  full.Educations,
+		Content:     buildCurriculumBodyText(curriculum),
+		UpdatedAt:   full.UpdatedAt,
+	}, nil
+}
+
+// getOwnedCurriculum returns gorm.ErrRecordNotFound (wrapped) when the curriculum belongs to another user.
+func (uc *curriculumShareUseCase) getOwnedCurriculum(ctx context.Context, userID, curriculumID uuid.UUID) (*models.Curriculums, error) {
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+	return curriculum, nil
+}
+
+func (uc *curriculumShareUseCase) getOwnedLink(ctx context.Context, userID, curriculumID, linkID uuid.UUID) (*models.CurriculumShareLink, error) {
+	link, err := uc.shareRepo.GetLinkByID(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.UserID != userID || link.CurriculumID != curriculumID {
+		return nil, fmt.Errorf("share link %s: %w", linkID.String(), gorm.ErrRecordNotFound)
+	}
+	return link, nil
+}
+
+func (uc *curriculumShareUseCase) toShareLinkResponse(link *models.CurriculumShareLink) dto.ShareLinkResponse {
+	return dto.ShareLinkResponse{
+		ID:                link.ID,
+		CurriculumID:      link.CurriculumID,
+		Slug:              link.Slug,
+		URL:               uc.appURL + "/p/" + link.Slug,
+		Label:             link.Label,
+		PasswordProtected: link.HasPassword(),
+		Active:            link.IsActive(uc.now()),
+		ExpiresAt:         link.ExpiresAt,
+		RevokedAt:         link.RevokedAt,
+		ViewCount:         link.ViewCount,
+		LastViewedAt:      link.LastViewedAt,
+		CreatedAt:         link.CreatedAt,
+	}
+}
+
+func toShareViewCounts(counts []repositories.ShareViewCount) []dto.ShareViewCountResponse {
+	resp := make([]dto.ShareViewCountResponse, 0, len(counts))
+	for _, c := range counts {
+		resp = append(resp, dto.ShareViewCountResponse{Value: c.Key, Count: c.Count})
+	}
+	return resp
+}
+
+// generateShareSlug returns a random URL-safe slug.
+func generateShareSlug() (string, error) {
+	buf := make([]byte, shareSlugBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
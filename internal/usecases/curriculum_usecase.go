@@ -2,8 +2,10 @@ package usecases
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/ats"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
@@ -12,6 +14,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 // CurriculumUsecase Define a interface para operações de dados de curriculum
@@ -23,23 +26,27 @@ type CurriculumUseCase interface {
 	GetCurriculumCountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	GetCreationCountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
 	DeleteCurriculum(ctx context.Context, id uuid.UUID) error
+	DuplicateCurriculum(ctx context.Context, userID, curriculumID uuid.UUID) (*dto.CurriculumResponse, error)
+	CheckATS(ctx context.Context, userID, curriculumID uuid.UUID, language string) (*dto.ATSCheckResponse, error)
 }
 
 // curriculumUsecase Implementa a interface CurriculumUseCase
 type curriculumUseCase struct {
-	curriculumRepo repositories.CurriculumRepository
-	statsRepo      repositories.CurriculumCreationStatsRepository
-	cacheService   *cache.CacheService
-	logger         *zap.Logger
+	curriculumRepo    repositories.CurriculumRepository
+	statsRepo         repositories.CurriculumCreationStatsRepository
+	clientProfileRepo repositories.ClientProfileRepository
+	cacheService      *cache.CacheService
+	logger            *zap.Logger
 }
 
 // NewCurriculumUseCase creates a new instance of CurriculumUseCase.
-func NewCurriculumUseCase(curriculumRepo repositories.CurriculumRepository, statsRepo repositories.CurriculumCreationStatsRepository, cacheService *cache.CacheService, logger *zap.Logger) CurriculumUseCase {
+func NewCurriculumUseCase(curriculumRepo repositories.CurriculumRepository, statsRepo repositories.CurriculumCreationStatsRepository, clientProfileRepo repositories.ClientProfileRepository, cacheService *cache.CacheService, logger *zap.Logger) CurriculumUseCase {
 	return &curriculumUseCase{
-		curriculumRepo: curriculumRepo,
-		statsRepo:      statsRepo,
-		cacheService:   cacheService,
-		logger:         logger,
+		curriculumRepo:    curriculumRepo,
+		statsRepo:         statsRepo,
+		clientProfileRepo: clientProfileRepo,
+		cacheService:      cacheService,
+		logger:            logger,
 	}
 }
 
@@ -58,6 +65,23 @@ func (cu *curriculumUseCase) CreateCurriculum(ctx context.Context, userID uuid.U
 		SocialLinks:   req.SocialLinks,
 		ImageURL:      req.ImageURL,
 		UserID:        userID,
+		Status:        models.CurriculumStatusDraft,
+	}
+
+	// Consultants create curriculums on behalf of their own client profiles only.
+	if req.ClientID != nil && *req.ClientID != "" {
+		clientID, err := uuid.Parse(*req.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client ID format: %w", err)
+		}
+		client, err := cu.clientProfileRepo.GetByID(ctx, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if client.ConsultantUserID != userID {
+			return nil, fmt.Errorf("client profile %s: %w", clientID.String(), gorm.ErrRecordNotFound)
+		}
+		curriculum.ClientProfileID = &client.ID
 	}
 
 	// Validar o modelo de curriculum
@@ -69,13 +93,14 @@ func (cu *curriculumUseCase) CreateCurriculum(ctx context.Context, userID uuid.U
 	}
 
 	// Criar works associados ao curriculum
-	for _, workReq := range req.Works {
+	for i, workReq := range req.Works {
 		work := models.Work{
 			Position:    workReq.Position,
 			Company:     workReq.Company,
 			Description: workReq.Description,
 			StartDate:   workReq.StartDate,
 			EndDate:     workReq.EndDate,
+			SortOrder:   i,
 		}
 		curriculum.Works = append(curriculum.Works, work)
 	}
@@ -104,54 +129,8 @@ func (cu *curriculumUseCase) CreateCurriculum(ctx context.Context, userID uuid.U
 		)
 	}
 
-	// Preparar response de works
-	worksResponse := make([]dto.WorkResponse, 0, len(curriculum.Works))
-	for _, work := range curriculum.Works {
-		worksResponse = append(worksResponse, dto.WorkResponse{
-			ID:          work.ID,
-			Position:    work.Position,
-			Company:     work.Company,
-			Description: work.Description,
-			StartDate:   work.StartDate,
-			EndDate:     work.EndDate,
-			CreatedAt:   work.CreatedAt,
-			UpdatedAt:   work.UpdatedAt,
-		})
-	}
-
-	// Preparar response de educations
-	educationsResponse := make([]dto.EducationResponse, 0, len(curriculum.Educations))
-	for _, education := range curriculum.Educations {
-		educationsResponse = append(educationsResponse, dto.EducationResponse{
-			ID:          education.ID,
-			Institution: education.Institution,
-			Degree:      education.Degree,
-			StartDate:   education.StartDate,
-			EndDate:     education.EndDate,
-			Description: education.Description,
-			CreatedAt:   education.CreatedAt,
-			UpdatedAt:   education.UpdatedAt,
-		})
-	}
-
-	// Return response
-	return &dto.CurriculumResponse{
-		ID:            curriculum.ID,
-		FullName:      curriculum.FullName,
-		Email:         curriculum.Email,
-		Phone:         curriculum.Phone,
-		DriverLicense: curriculum.DriverLicense,
-		Intro:         curriculum.Intro,
-		Skills:        curriculum.Skills,
-		Languages:     curriculum.Languages,
-		Courses:       curriculum.Courses,
-		SocialLinks:   curriculum.SocialLinks,
-		ImageURL:      curriculum.ImageURL,
-		Works:         worksResponse,
-		Educations:    educationsResponse,
-		CreatedAt:     curriculum.CreatedAt,
-		UpdatedAt:     curriculum.UpdatedAt,
-	}, nil
+	resp := toCurriculumResponse(curriculum)
+	return &resp, nil
 }
 
 // GetCurriculumByID retrieves a curriculum by ID
@@ -176,54 +155,7 @@ func (cu *curriculumUseCase) GetCurriculumByID(ctx context.Context, id uuid.UUID
 		return nil, err
 	}
 
-	// Convert works from models to DTOs
-	worksResponse := make([]dto.WorkResponse, 0, len(curriculum.Works))
-	for _, work := range curriculum.Works {
-		worksResponse = append(worksResponse, dto.WorkResponse{
-			ID:          work.ID,
-			Position:    work.Position,
-			Company:     work.Company,
-			Description: work.Description,
-			StartDate:   work.StartDate,
-			EndDate:     work.EndDate,
-			CreatedAt:   work.CreatedAt,
-			UpdatedAt:   work.UpdatedAt,
-		})
-	}
-
-	// Convert educations from models to DTOs
-	educationsResponse := make([]dto.EducationResponse, 0, len(curriculum.Educations))
-	for _, education := range curriculum.Educations {
-		educationsResponse = append(educationsResponse, dto.EducationResponse{
-			ID:          education.ID,
-			Institution: education.Institution,
-			Degree:      education.Degree,
-			StartDate:   education.StartDate,
-			EndDate:     education.EndDate,
-			Description: education.Description,
-			CreatedAt:   education.CreatedAt,
-			UpdatedAt:   education.UpdatedAt,
-		})
-	}
-
-	// Create response
-	curriculumResponse = dto.CurriculumResponse{
-		ID:            curriculum.ID,
-		FullName:      curriculum.FullName,
-		Email:         curriculum.Email,
-		Phone:         curriculum.Phone,
-		DriverLicense: curriculum.DriverLicense,
-		Intro:         curriculum.Intro,
-		Skills:        curriculum.Skills,
-		Languages:     curriculum.Languages,
-		Courses:       curriculum.Courses,
-		SocialLinks:   curriculum.SocialLinks,
-		ImageURL:      curriculum.ImageURL,
-		Works:         worksResponse,
-		Educations:    educationsResponse,
-		CreatedAt:     curriculum.CreatedAt,
-		UpdatedAt:     curriculum.UpdatedAt,
-	}
+	curriculumResponse = toCurriculumResponse(curriculum)
 
 	// Armazena os dados em cache por 30 minutos
 	ttl := 30 * time.Minute
@@ -255,53 +187,7 @@ func (cu *curriculumUseCase) GetAllCurriculums(ctx context.Context, userID uuid.
 	// Converter curriculums para DTOs
 	curriculumsResponse := make([]dto.CurriculumResponse, 0, len(curriculums))
 	for _, curriculum := range curriculums {
-		// Converter works de models para DTOs
-		worksResponse := make([]dto.WorkResponse, 0, len(curriculum.Works))
-		for _, work := range curriculum.Works {
-			worksResponse = append(worksResponse, dto.WorkResponse{
-				ID:          work.ID,
-				Position:    work.Position,
-				Company:     work.Company,
-				Description: work.Description,
-				StartDate:   work.StartDate,
-				EndDate:     work.EndDate,
-				CreatedAt:   work.CreatedAt,
-				UpdatedAt:   work.UpdatedAt,
-			})
-		}
-
-		// Converter educations de models para DTOs
-		educationsResponse := make([]dto.EducationResponse, 0, len(curriculum.Educations))
-		for _, education := range curriculum.Educations {
-			educationsResponse = append(educationsResponse, dto.EducationResponse{
-				ID:          education.ID,
-				Institution: education.Institution,
-				Degree:      education.Degree,
-				StartDate:   education.StartDate,
-				EndDate:     education.EndDate,
-				Description: education.Description,
-				CreatedAt:   education.CreatedAt,
-				UpdatedAt:   education.UpdatedAt,
-			})
-		}
-
-		curriculumsResponse = append(curriculumsResponse, dto.CurriculumResponse{
-			ID:            curriculum.ID,
-			FullName:      curriculum.FullName,
-			Email:         curriculum.Email,
-			Phone:         curriculum.Phone,
-			DriverLicense: curriculum.DriverLicense,
-			Intro:         curriculum.Intro,
-			Skills:        curriculum.Skills,
-			Languages:     curriculum.Languages,
-			Courses:       curriculum.Courses,
-			SocialLinks:   curriculum.SocialLinks,
-			ImageURL:      curriculum.ImageURL,
-			Works:         worksResponse,
-			Educations:    educationsResponse,
-			CreatedAt:     curriculum.CreatedAt,
-			UpdatedAt:     curriculum.UpdatedAt,
-		})
+		curriculumsResponse = append(curriculumsResponse, toCurriculumResponse(&curriculum))
 	}
 
 	pagination := dto.CursorPagination{
@@ -376,12 +262,51 @@ func (cu *curriculumUseCase) GetCurriculumBody(ctx context.Context, curriculumID
 	return &curriculumBodyResponse, nil
 }
 
+// Section headings of the text body, also checked by the ATS rules.
+const (
+	sectionPersonalInformation = "Personal Information"
+	sectionPresentation        = "Presentation"
+	sectionSkills              = "Skills"
+	sectionLanguages           = "Languages"
+	sectionCourses             = "Courses"
+	sectionSocialLinks         = "Social Links"
+	sectionWorkExperience      = "Work Experience"
+	sectionAcademicFormation   = "Academic Formation"
+)
+
+// curriculumBodySections returns the headings buildCurriculumBodyText writes for the curriculum.
+func curriculumBodySections(curriculum *models.Curriculums) []string {
+	sections := []string{sectionPersonalInformation}
+	if curriculum.Intro != "" {
+		sections = append(sections, sectionPresentation)
+	}
+	if curriculum.Skills != "" {
+		sections = append(sections, sectionSkills)
+	}
+	if curriculum.Languages != "" {
+		sections = append(sections, sectionLanguages)
+	}
+	if curriculum.Courses != "" {
+		sections = append(sections, sectionCourses)
+	}
+	if curriculum.SocialLinks != "" {
+		sections = append(sections, sectionSocialLinks)
+	}
+	if len(curriculum.Works) > 0 {
+		sections = append(sections, sectionWorkExperience)
+	}
+	if len(curriculum.Educations) > 0 {
+		sections = append(sections, sectionAcademicFormation)
+	}
+	return sections
+}
+
 // buildCurriculumBodyText builds the curriculum body in plain text format
 func buildCurriculumBodyText(curriculum *models.Curriculums) string {
 	var body string
 
 	// Personal Information
-	body += "Personal Information "
+	body += sectionPersonalInformation + " "
 	body += "Name: " + curriculum.FullName + " "
 	body += "Email: " + curriculum.Email + " "
 	body += "Phone: " + curriculum.Phone + " "
@@ -389,27 +314,27 @@ func buildCurriculumBodyText(curriculum *models.Curriculums) string {
 
 	// Introduction
 	if curriculum.Intro != "" {
-		body += "Presentation " + curriculum.Intro + " "
+		body += sectionPresentation + " " + curriculum.Intro + " "
 	}
 
 	// Skills
 	if curriculum.Skills != "" {
-		body += "Skills " + curriculum.Skills + " "
+		body += sectionSkills + " " + curriculum.Skills + " "
 	}
 
 	// Languages
 	if curriculum.Languages != "" {
-		body += "Languages " + curriculum.Languages + " "
+		body += sectionLanguages + " " + curriculum.Languages + " "
 	}
 
 	// Courses
 	if curriculum.Courses != "" {
-		body += "Courses " + curriculum.Courses + " "
+		body += sectionCourses + " " + curriculum.Courses + " "
 	}
 
 	// Social Links
 	if curriculum.SocialLinks != "" {
-		body += "Social Links " + curriculum.SocialLinks + " "
+		body += sectionSocialLinks + " " + curriculum.SocialLinks + " "
 	}
 
 	// Image URL
@@ -419,7 +344,7 @@ func buildCurriculumBodyText(curriculum *models.Curriculums) string {
 
 	// Work Experience
 	if len(curriculum.Works) > 0 {
-		body += "Work Experience "
+		body += sectionWorkExperience + " "
 		for _, work := range curriculum.Works {
 			body += "Position: " + work.Position + " "
 			body += "Company: " + work.Company + " "
@@ -437,7 +362,7 @@ func buildCurriculumBodyText(curriculum *models.Curriculums) string {
 
 	// Education
 	if len(curriculum.Educations) > 0 {
-		body += "Academic Formation "
+		body += sectionAcademicFormation + " "
 		for _, education := range curriculum.Educations {
 			body += "Degree: " + education.Degree + " "
 			body += "Institution: " + education.Institution + " "
@@ -487,3 +412,176 @@ func (cu *curriculumUseCase) DeleteCurriculum(ctx context.Context, id uuid.UUID)
 
 	return nil
 }
+
+// CheckATS runs the deterministic ATS rules against one of the user's curriculums. It does
+// not use the AI. Finding messages are written in language.
+func (cu *curriculumUseCase) CheckATS(ctx context.Context, userID, curriculumID uuid.UUID, language string) (*dto.ATSCheckResponse, error) {
+	curriculum, err := cu.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	report := ats.Check(ats.Input{
+		Curriculum:   curriculum,
+		SectionNames: curriculumBodySections(curriculum),
+		Now:          time.Now(),
+		Language:     language,
+	})
+
+	findings := make([]dto.ATSFinding, 0, len(report.Findings))
+	for _, f := range report.Findings {
+		findings = append(findings, dto.ATSFinding{
+			Rule:     f.Rule,
+			Severity: string(f.Severity),
+			Section:  f.Section,
+			Message:  f.Message,
+		})
+	}
+
+	return &dto.ATSCheckResponse{
+		Score:    report.Score,
+		Passed:   report.Passed,
+		Findings: findings,
+	}, nil
+}
+
+// DuplicateCurriculum deep-copies one of the user's curriculums, including works and
+// educations, into a new draft linked to its source.
+func (cu *curriculumUseCase) DuplicateCurriculum(ctx context.Context, userID, curriculumID uuid.UUID) (*dto.CurriculumResponse, error) {
+	source, err := cu.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if source.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	duplicate := cloneCurriculum(source)
+	if err := cu.curriculumRepo.Create(ctx, duplicate); err != nil {
+		return nil, err
+	}
+
+	if err := cu.statsRepo.IncrementCreationCount(ctx, userID); err != nil {
+		cu.logger.Warn("Failed to increment curriculum creation count; curriculum was duplicated",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+	}
+
+	cu.logger.Info("Curriculum duplicated",
+		zap.String("source_curriculum_id", source.ID.String()),
+		zap.String("curriculum_id", duplicate.ID.String()),
+	)
+
+	resp := toCurriculumResponse(duplicate)
+	return &resp, nil
+}
+
+// cloneCurriculum returns an unsaved draft copy of the curriculum with fresh works and
+// educations. The copy keeps the owner and client profile and points at its source.
+func cloneCurriculum(source *models.Curriculums) *models.Curriculums {
+	sourceID := source.ID
+	clone := &models.Curriculums{
+		FullName:           source.FullName,
+		Email:              source.Email,
+		Phone:              source.Phone,
+		DriverLicense:      source.DriverLicense,
+		Intro:              source.Intro,
+		Skills:             source.Skills,
+		Languages:          source.Languages,
+		Courses:            source.Courses,
+		SocialLinks:        source.SocialLinks,
+		ImageURL:           source.ImageURL,
+		UserID:             source.UserID,
+		ClientProfileID:    source.ClientProfileID,
+		Status:             models.CurriculumStatusDraft,
+		SourceCurriculumID: &sourceID,
+		JobDescription:     source.JobDescription,
+	}
+
+	for i, work := range source.Works {
+		clone.Works = append(clone.Works, models.Work{
+			Position:    work.Position,
+			Company:     work.Company,
+			Description: work.Description,
+			StartDate:   work.StartDate,
+			EndDate:     work.EndDate,
+			SortOrder:   i,
+		})
+	}
+
+	for _, education := range source.Educations {
+		clone.Educations = append(clone.Educations, models.Education{
+			Institution: education.Institution,
+			Degree:      education.Degree,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Description: education.Description,
+		})
+	}
+
+	return clone
+}
+
+// toCurriculumResponse converts a curriculum with its works and educations to its DTO.
+func toCurriculumResponse(curriculum *models.Curriculums) dto.CurriculumResponse {
+	worksResponse := make([]dto.WorkResponse, 0, len(curriculum.Works))
+	for _, work := range curriculum.Works {
+		worksResponse = append(worksResponse, dto.WorkResponse{
+			ID:          work.ID,
+			Position:    work.Position,
+			Company:     work.Company,
+			Description: work.Description,
+			StartDate:   work.StartDate,
+			EndDate:     work.EndDate,
+			CreatedAt:   work.CreatedAt,
+			UpdatedAt:   work.UpdatedAt,
+		})
+	}
+
+	educationsResponse := make([]dto.EducationResponse, 0, len(curriculum.Educations))
+	for _, education := range curriculum.Educations {
+		educationsResponse = append(educationsResponse, dto.EducationResponse{
+			ID:          education.ID,
+			Institution: education.Institution,
+			Degree:      education.Degree,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Description: education.Description,
+			CreatedAt:   education.CreatedAt,
+			UpdatedAt:   education.UpdatedAt,
+		})
+	}
+
+	status := curriculum.Status
+	if status == "" {
+		status = models.CurriculumStatusDraft
+	}
+
+	return dto.CurriculumResponse{
+		ID:                 curriculum.ID,
+		FullName:           curriculum.FullName,
+		Email:              curriculum.Email,
+		Phone:              curriculum.Phone,
+		DriverLicense:      curriculum.DriverLicense,
+		Intro:              curriculum.Intro,
+		Skills:             curriculum.Skills,
+		Languages:          curriculum.Languages,
+		Courses:            curriculum.Courses,
+		SocialLinks:        curriculum.SocialLinks,
+		ImageURL:           curriculum.ImageURL,
+		Works:              worksResponse,
+		Educations:         educationsResponse,
+		ClientProfileID:    curriculum.ClientProfileID,
+		Status:             string(status),
+		ReviewComment:      curriculum.ReviewComment,
+		StatusChangedAt:    curriculum.StatusChangedAt,
+		SourceCurriculumID: curriculum.SourceCurriculumID,
+		JobDescription:     curriculum.JobDescription,
+		CreatedAt:          curriculum.CreatedAt,
+		UpdatedAt:          curriculum.UpdatedAt,
+	}
+}
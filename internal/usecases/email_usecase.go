@@ -1,159 +1,471 @@
 package usecases
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"fmt"
-	"os"
+	"encoding/json"
+	htmltemplate "html/template"
+	"strings"
+	"time"
 
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/email"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
-	"github.com/resend/resend-go/v2"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+// EmailRecipient is the addressee of a transactional email. Language is a code or tag;
+// the email is written in English when it is empty or not translated.
+type EmailRecipient struct {
+	Email    string
+	Name     string
+	Language string
+}
+
+// emailRecipientOf builds the recipient of a user, in the language of their configuration
+// when it is loaded.
+func emailRecipientOf(user *models.User) EmailRecipient {
+	recipient := EmailRecipient{Email: user.Email, Name: user.Name}
+	if user.Configuration != nil {
+		recipient.Language = user.Configuration.Language
+	}
+	return recipient
+}
+
 // EmailUseCase defines the interface for email operations
 type EmailUseCase interface {
-	SendSessionTokenEmail(to, name, token string) error
+	SendSessionTokenEmail(to EmailRecipient, token string) error
+	SendWelcomeEmail(to EmailRecipient) error
+	SendSubscriptionActivatedEmail(to EmailRecipient, plan string) error
+	SendTrialEndingEmail(to EmailRecipient, plan string, trialEndsAt time.Time) error
+	SendPaymentFailedEmail(to EmailRecipient, plan string, graceEndsAt time.Time) error
+	SendQuotaNearlyExhaustedEmail(to EmailRecipient, plan string, used, limit int64, renewsAt time.Time) error
+	SendAccountDeletedEmail(to EmailRecipient) error
+	SendApplicationFollowUpEmail(to EmailRecipient, company, position string, followUpAt time.Time) error
+	SendNewsletterConfirmationEmail(to EmailRecipient, confirmURL string, validFor time.Duration) error
+	// SendNewsletterEmail sends a campaign. htmlBody is trusted HTML written by an admin;
+	// textBody may be empty, in which case it is derived from htmlBody. When the provider
+	// rejects the first attempt it returns the ID of the outbox message that retries it,
+	// and uuid.Nil otherwise.
+	SendNewsletterEmail(to EmailRecipient, subject, htmlBody, textBody, unsubscribeURL string) (uuid.UUID, error)
+	// RetryOutbox retries the due messages of the outbox and returns how many were delivered.
+	RetryOutbox(ctx context.Context) (int, error)
 }
 
+const (
+	// emailSendTimeout bounds a single delivery attempt.
+	emailSendTimeout = 30 * time.Second
+	// outboxBatchSize is how many outbox messages one RetryOutbox run tries at most.
+	outboxBatchSize = 50
+	// outboxFirstRetryDelay is the wait before the second attempt; it doubles with every
+	// attempt up to outboxMaxRetryDelay.
+	outboxFirstRetryDelay = time.Minute
+	outboxMaxRetryDelay   = time.Hour
+)
+
 // emailUseCase implements EmailUseCase interface
 type emailUseCase struct {
-	client *resend.Client
-	from   string
-	logger *zap.Logger
+	sender      email.EmailSender
+	outboxRepo  repositories.OutboxEmailRepository
+	from        string
+	appURL      string
+	maxAttempts int
+	renderer    *email.Renderer
+	logger      *zap.Logger
+	now         func() time.Time
 }
 
-// NewEmailUseCase creates a new instance of EmailUseCase
-func NewEmailUseCase(logger *zap.Logger) (EmailUseCase, error) {
-	apiKey := os.Getenv("RESEND_API_KEY")
-	from := os.Getenv("MAIL_FROM")
-
-	logger.Debug("Loading email configuration from environment variables",
-		zap.String("from", from),
+// NewEmailUseCase creates a new instance of EmailUseCase delivering through the provider
+// selected in cfg. Messages that fail are stored in the outbox and retried by RetryOutbox.
+func NewEmailUseCase(outboxRepo repositories.OutboxEmailRepository, cfg config.EmailConfig, appURL string, logger *zap.Logger) (EmailUseCase, error) {
+	logger.Debug("Loading email configuration",
+		zap.String("provider", cfg.Provider),
+		zap.String("from", cfg.From),
 	)
 
-	if apiKey == "" {
-		logger.Error("RESEND_API_KEY environment variable is missing")
-		return nil, errors.WrapError(errors.ErrEmailConfigMissing, "RESEND_API_KEY environment variable is required")
+	from := cfg.From
+	if from == "" && cfg.Provider == config.EmailProviderFile {
+		from = "Dafon CV <no-reply@localhost>"
 	}
 	if from == "" {
 		logger.Error("MAIL_FROM environment variable is missing")
 		return nil, errors.WrapError(errors.ErrEmailConfigMissing, "MAIL_FROM environment variable is required")
 	}
 
-	client := resend.NewClient(apiKey)
+	sender, err := newEmailSender(cfg)
+	if err != nil {
+		logger.Error("Failed to initialize email provider", zap.String("provider", cfg.Provider), zap.Error(err))
+		return nil, err
+	}
 
-	logger.Info("Email use case initialized successfully with Resend",
+	renderer, err := email.NewRenderer(appURL)
+	if err != nil {
+		logger.Error("Failed to parse email templates", zap.Error(err))
+		return nil, errors.WrapError(err, "failed to parse email templates")
+	}
+
+	logger.Info("Email use case initialized successfully",
+		zap.String("provider", sender.Name()),
 		zap.String("from", from),
 	)
 
 	return &emailUseCase{
-		client: client,
-		from:   from,
-		logger: logger,
+		sender:      sender,
+		outboxRepo:  outboxRepo,
+		from:        from,
+		appURL:      appURL,
+		maxAttempts: cfg.MaxAttempts,
+		renderer:    renderer,
+		logger:      logger,
+		now:         time.Now,
 	}, nil
 }
 
-// SendSessionTokenEmail sends a session token to the user's email
-func (uc *emailUseCase) SendSessionTokenEmail(to, name, token string) error {
-	uc.logger.Info("Sending session token email")
+// newEmailSender creates the sender of the configured provider
+func newEmailSender(cfg config.EmailConfig) (email.EmailSender, error) {
+	switch cfg.Provider {
+	case config.EmailProviderResend:
+		if cfg.APIKey == "" {
+			return nil, errors.WrapError(errors.ErrEmailConfigMissing, "RESEND_API_KEY environment variable is required")
+		}
+		return email.NewResendSender(cfg.APIKey), nil
+	case config.EmailProviderSMTP:
+		if cfg.SMTP.Host == "" {
+			return nil, errors.WrapError(errors.ErrEmailConfigMissing, "SMTP_HOST environment variable is required")
+		}
+		return email.NewSMTPSender(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password), nil
+	case config.EmailProviderFile:
+		sender, err := email.NewFileSender(cfg.OutboxDir)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrEmailConfigMissing, err.Error())
+		}
+		return sender, nil
+	case "":
+		return nil, errors.WrapError(errors.ErrEmailConfigMissing, "set EMAIL_PROVIDER, RESEND_API_KEY or SMTP_HOST")
+	default:
+		return nil, errors.WrapError(errors.ErrEmailConfigMissing, "unsupported EMAIL_PROVIDER "+cfg.Provider)
+	}
+}
 
+// SendSessionTokenEmail sends a session token to the user's email
+func (uc *emailUseCase) SendSessionTokenEmail(to EmailRecipient, token string) error {
 	// The token is passed directly from the frontend, no need to create a link
 	// The frontend will handle the token processing
-	loginLink := token
-
-	subject := "Welcome to Dafon CV - Your AI-Powered Resume Builder"
-	htmlContent := fmt.Sprintf(`
-		<!DOCTYPE html>
-		<html lang="en">
-		<head>
-			<meta charset="UTF-8">
-			<meta name="viewport" content="width=device-width, initial-scale=1.0">
-			<title>Dafon CV - AI Resume Builder</title>
-		</head>
-		<body style="margin: 0; padding: 0; background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif; min-height: 100vh;">
-			<div style="max-width: 600px; margin: 0 auto; padding: 40px 20px;">
-				<!-- Glassmorphism Container -->
-				<div style="background: rgba(255, 255, 255, 0.1); backdrop-filter: blur(20px); border-radius: 20px; border: 1px solid rgba(255, 255, 255, 0.2); padding: 40px; box-shadow: 0 8px 32px rgba(0, 0, 0, 0.1);">
-					
-					<!-- Header -->
-					<div style="text-align: center; margin-bottom: 40px;">
-						<div style="background: rgba(255, 255, 255, 0.2); border-radius: 50%%; width: 80px; height: 80px; margin: 0 auto 20px; display: flex; align-items: center; justify-content: center; border: 2px solid rgba(255, 255, 255, 0.3);">
-							<span style="font-size: 32px;">📄</span>
-						</div>
-						<h1 style="color: white; margin: 0; font-size: 28px; font-weight: 300; text-shadow: 0 2px 4px rgba(0, 0, 0, 0.1);">Dafon CV</h1>
-						<p style="color: rgba(255, 255, 255, 0.8); margin: 10px 0 0; font-size: 16px; font-weight: 300;">AI-Powered Resume Builder</p>
-					</div>
-
-					<!-- Content -->
-					<div style="background: rgba(255, 255, 255, 0.95); border-radius: 15px; padding: 30px; margin-bottom: 30px; box-shadow: 0 4px 16px rgba(0, 0, 0, 0.1);">
-						<h2 style="color: #2c3e50; margin: 0 0 20px; font-size: 24px; font-weight: 400;">Welcome to Your Professional Journey, %s!</h2>
-						
-						<p style="color: #5a6c7d; line-height: 1.6; margin: 0 0 20px; font-size: 16px;">
-							Thank you for choosing <strong style="color: #667eea;">Dafon CV</strong> - the revolutionary platform that transforms your career story into stunning, AI-optimized resumes.
-						</p>
-
-						<p style="color: #5a6c7d; line-height: 1.6; margin: 0 0 30px; font-size: 16px;">
-							Our intelligent system analyzes your experience and creates personalized, ATS-friendly resumes that stand out to recruiters and hiring managers.
-						</p>
-
-						<!-- CTA Button -->
-						<div style="text-align: center; margin: 30px 0;">
-							<a href="%s" style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 16px 32px; text-decoration: none; border-radius: 50px; display: inline-block; font-weight: 600; font-size: 16px; box-shadow: 0 4px 15px rgba(102, 126, 234, 0.4); transition: all 0.3s ease; border: none;">
-								🚀 Access Your Dashboard
-							</a>
-						</div>
-
-						<!-- Features -->
-						<div style="background: rgba(102, 126, 234, 0.1); border-radius: 10px; padding: 20px; margin: 30px 0;">
-							<h3 style="color: #667eea; margin: 0 0 15px; font-size: 18px; font-weight: 500;">✨ What makes Dafon CV special:</h3>
-							<ul style="color: #5a6c7d; margin: 0; padding-left: 20px; line-height: 1.8;">
-								<li><strong>AI-Powered Optimization:</strong> Smart content suggestions tailored to your industry</li>
-								<li><strong>ATS-Friendly Templates:</strong> Designed to pass Applicant Tracking Systems</li>
-								<li><strong>Real-time Analytics:</strong> Track your resume performance and views</li>
-								<li><strong>Multiple Formats:</strong> Export to PDF, Word, or share online</li>
-							</ul>
-						</div>
-
-						<!-- Security Notice -->
-						<div style="background: rgba(255, 193, 7, 0.1); border-left: 4px solid #ffc107; padding: 15px; margin: 20px 0; border-radius: 0 8px 8px 0;">
-							<p style="color: #856404; margin: 0; font-size: 14px; line-height: 1.5;">
-								<strong>🔒 Security Notice:</strong> This secure login link expires in 15 minutes and can only be used once. If you didn't request access to Dafon CV, please ignore this email.
-							</p>
-						</div>
-					</div>
-
-					<!-- Footer -->
-					<div style="text-align: center; color: rgba(255, 255, 255, 0.7); font-size: 14px;">
-						<p style="margin: 0 0 10px;">Ready to build your dream career?</p>
-						<p style="margin: 0; font-size: 12px;">
-							© 2025 Dafon CV. Empowering professionals worldwide with AI-driven resume solutions.
-						</p>
-					</div>
-				</div>
-			</div>
-		</body>
-		</html>
-	`, name, loginLink)
-
-	params := &resend.SendEmailRequest{
-		From:    uc.from,
-		To:      []string{to},
-		Subject: subject,
-		Html:    htmlContent,
+	return uc.send(to, email.TemplateSessionToken, email.Data{
+		"Name":     to.Name,
+		"CTAURL":   token,
+		"CTALabel": "Access Your Dashboard",
+	})
+}
+
+// SendWelcomeEmail greets a user who just signed up
+func (uc *emailUseCase) SendWelcomeEmail(to EmailRecipient) error {
+	return uc.send(to, email.TemplateWelcome, uc.withAppLink(email.Data{
+		"Name": to.Name,
+	}, "Create my curriculum"))
+}
+
+// SendSubscriptionActivatedEmail confirms a paid plan bought through checkout
+func (uc *emailUseCase) SendSubscriptionActivatedEmail(to EmailRecipient, plan string) error {
+	return uc.send(to, email.TemplateSubscriptionActivated, uc.withAppLink(email.Data{
+		"Name": to.Name,
+		"Plan": planDisplayName(plan),
+	}, "Open Dafon CV"))
+}
+
+// SendTrialEndingEmail reminds the user that the free trial is about to end
+func (uc *emailUseCase) SendTrialEndingEmail(to EmailRecipient, plan string, trialEndsAt time.Time) error {
+	return uc.send(to, email.TemplateTrialEnding, uc.withAppLink(email.Data{
+		"Name":        to.Name,
+		"Plan":        planDisplayName(plan),
+		"TrialEndsAt": trialEndsAt,
+	}, "Choose a plan"))
+}
+
+// SendPaymentFailedEmail tells the user the renewal payment failed and when access will be reduced
+func (uc *emailUseCase) SendPaymentFailedEmail(to EmailRecipient, plan string, graceEndsAt time.Time) error {
+	return uc.send(to, email.TemplatePaymentFailed, uc.withAppLink(email.Data{
+		"Name":        to.Name,
+		"Plan":        planDisplayName(plan),
+		"GraceEndsAt": graceEndsAt,
+	}, "Update payment method"))
+}
+
+// SendQuotaNearlyExhaustedEmail warns the user that most of the monthly AI requests are used
+func (uc *emailUseCase) SendQuotaNearlyExhaustedEmail(to EmailRecipient, plan string, used, limit int64, renewsAt time.Time) error {
+	return uc.send(to, email.TemplateQuotaNearlyExhausted, uc.withAppLink(email.Data{
+		"Name":     to.Name,
+		"Plan":     planDisplayName(plan),
+		"Used":     used,
+		"Limit":    limit,
+		"RenewsAt": renewsAt,
+	}, "See plans"))
+}
+
+// SendAccountDeletedEmail confirms that the user's account was deleted
+func (uc *emailUseCase) SendAccountDeletedEmail(to EmailRecipient) error {
+	return uc.send(to, email.TemplateAccountDeleted, email.Data{
+		"Name": to.Name,
+	})
+}
+
+// SendApplicationFollowUpEmail reminds the user to follow up on a tracked job application
+func (uc *emailUseCase) SendApplicationFollowUpEmail(to EmailRecipient, company, position string, followUpAt time.Time) error {
+	return uc.send(to, email.TemplateApplicationFollowUp, uc.withAppLink(email.Data{
+		"Name":       to.Name,
+		"Company":    company,
+		"Position":   position,
+		"FollowUpAt": followUpAt,
+	}, "Open my applications"))
+}
+
+// SendNewsletterConfirmationEmail asks the user to confirm a newsletter subscription (double opt-in)
+func (uc *emailUseCase) SendNewsletterConfirmationEmail(to EmailRecipient, confirmURL string, validFor time.Duration) error {
+	return uc.send(to, email.TemplateNewsletterConfirmation, email.Data{
+		"Name":       to.Name,
+		"ValidHours": int(validFor.Hours()),
+		"CTAURL":     confirmURL,
+		"CTALabel":   "Confirm subscription",
+	})
+}
+
+// SendNewsletterEmail sends a newsletter campaign with one-click unsubscribe headers (RFC 8058)
+func (uc *emailUseCase) SendNewsletterEmail(to EmailRecipient, subject, htmlBody, textBody, unsubscribeURL string) (uuid.UUID, error) {
+	if textBody == "" {
+		textBody = email.PlainText(htmlBody)
+	}
+
+	envelope, err := uc.render(to, email.TemplateNewsletter, email.Data{
+		"Subject":        subject,
+		"Body":           htmltemplate.HTML(htmlBody),
+		"TextBody":       textBody,
+		"UnsubscribeURL": unsubscribeURL,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	envelope.Headers = map[string]string{
+		"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+	return uc.deliver(email.TemplateNewsletter, envelope, to.Language)
+}
+
+// withAppLink adds a call-to-action to the frontend, when APP_URL is configured
+func (uc *emailUseCase) withAppLink(data email.Data, label string) email.Data {
+	if uc.appURL != "" {
+		data["CTAURL"] = uc.appURL
+		data["CTALabel"] = label
+	}
+	return data
+}
+
+// send renders a template in the recipient's language and delivers it
+func (uc *emailUseCase) send(to EmailRecipient, template email.Template, data email.Data) error {
+	envelope, err := uc.render(to, template, data)
+	if err != nil {
+		return err
 	}
+	_, err = uc.deliver(template, envelope, to.Language)
+	return err
+}
 
-	_, err := uc.client.Emails.Send(params)
+// render renders a template in the recipient's language, with its plain-text alternative
+func (uc *emailUseCase) render(to EmailRecipient, template email.Template, data email.Data) (email.Envelope, error) {
+	message, err := uc.renderer.Render(template, to.Language, data)
 	if err != nil {
-		uc.logger.Error("Failed to send session token email",
+		uc.logger.Error("Failed to render email",
+			zap.String("kind", string(template)),
 			zap.Error(err),
 		)
-		return errors.WrapError(errors.ErrEmailSendFailed, "failed to send session token email")
+		return email.Envelope{}, errors.WrapError(errors.ErrEmailSendFailed, "failed to render "+string(template)+" email")
+	}
+
+	return email.Envelope{
+		From:    uc.from,
+		To:      to.Email,
+		Subject: message.Subject,
+		HTML:    message.HTML,
+		Text:    message.Text,
+	}, nil
+}
+
+// deliver sends an envelope. A message the provider rejects is queued in the outbox for
+// retry and the ID of the outbox message is returned (uuid.Nil when sent); only a message
+// that can be neither sent nor queued is reported as an error. Session token emails are
+// never queued: the outbox would store the token and deliver it late, so their failure is
+// reported for the client to retry.
+func (uc *emailUseCase) deliver(template email.Template, envelope email.Envelope, language string) (uuid.UUID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), emailSendTimeout)
+	defer cancel()
+
+	sendErr := uc.sender.Send(ctx, envelope)
+	if sendErr == nil {
+		uc.logger.Info("Email sent successfully",
+			zap.String("kind", string(template)),
+			zap.String("provider", uc.sender.Name()),
+			zap.String("language", language),
+		)
+		return uuid.Nil, nil
 	}
 
-	uc.logger.Info("Session token email sent successfully")
+	if template == email.TemplateSessionToken {
+		uc.logger.Error("Failed to send email",
+			zap.String("kind", string(template)),
+			zap.String("provider", uc.sender.Name()),
+			zap.Error(sendErr),
+		)
+		return uuid.Nil, errors.WrapError(errors.ErrEmailSendFailed, "failed to send "+string(template)+" email")
+	}
+
+	uc.logger.Warn("Failed to send email, queuing it for retry",
+		zap.String("kind", string(template)),
+		zap.String("provider", uc.sender.Name()),
+		zap.Error(sendErr),
+	)
+	// The send may have used up ctx, so the message is queued with a context of its own
+	outboxEmailID, err := uc.enqueue(context.Background(), template, envelope, sendErr)
+	if err != nil {
+		return uuid.Nil, errors.WrapError(errors.ErrEmailSendFailed, "failed to send "+string(template)+" email")
+	}
+	return outboxEmailID, nil
+}
+
+// enqueue stores a message whose first attempt failed in the outbox and returns its ID
+func (uc *emailUseCase) enqueue(ctx context.Context, template email.Template, envelope email.Envelope, sendErr error) (uuid.UUID, error) {
+	if uc.outboxRepo == nil || uc.maxAttempts <= 1 {
+		return uuid.Nil, sendErr
+	}
+
+	var headers string
+	if len(envelope.Headers) > 0 {
+		encoded, err := json.Marshal(envelope.Headers)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		headers = string(encoded)
+	}
 
-	return nil
+	outboxEmail := &models.OutboxEmail{
+		Template:      string(template),
+		Provider:      uc.sender.Name(),
+		Sender:        envelope.From,
+		Recipient:     envelope.To,
+		Subject:       envelope.Subject,
+		HTML:          envelope.HTML,
+		Text:          envelope.Text,
+		Headers:       headers,
+		Status:        models.OutboxEmailStatusPending,
+		Attempts:      1,
+		NextAttemptAt: uc.now().Add(outboxRetryDelay(1)),
+		LastError:     sendErr.Error(),
+	}
+	if err := uc.outboxRepo.Create(ctx, outboxEmail); err != nil {
+		return uuid.Nil, err
+	}
+	return outboxEmail.ID, nil
+}
+
+// RetryOutbox sends the due outbox messages again. A message is given up (status failed)
+// after the configured number of attempts.
+func (uc *emailUseCase) RetryOutbox(ctx context.Context) (int, error) {
+	if uc.outboxRepo == nil {
+		return 0, nil
+	}
+
+	now := uc.now()
+	due, err := uc.outboxRepo.ListDue(ctx, now, outboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range due {
+		outboxEmail := &due[i]
+		attempt := outboxEmail.Attempts + 1
+
+		claimed, err := uc.outboxRepo.Claim(ctx, outboxEmail.ID, outboxEmail.Attempts, now.Add(outboxRetryDelay(attempt)))
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		envelope := email.Envelope{
+			From:    outboxEmail.Sender,
+			To:      outboxEmail.Recipient,
+			Subject: outboxEmail.Subject,
+			HTML:    outboxEmail.HTML,
+			Text:    outboxEmail.Text,
+		}
+		if outboxEmail.Headers != "" {
+			if err := json.Unmarshal([]byte(outboxEmail.Headers), &envelope.Headers); err != nil {
+				uc.logger.Warn("Ignoring invalid outbox email headers",
+					zap.String("outbox_email_id", outboxEmail.ID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, emailSendTimeout)
+		sendErr := uc.sender.Send(sendCtx, envelope)
+		cancel()
+
+		if sendErr == nil {
+			if err := uc.outboxRepo.MarkSent(ctx, outboxEmail.ID, uc.now()); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		status := models.OutboxEmailStatusPending
+		if attempt >= uc.maxAttempts {
+			status = models.OutboxEmailStatusFailed
+			uc.logger.Error("Giving up on outbox email",
+				zap.String("outbox_email_id", outboxEmail.ID.String()),
+				zap.String("kind", outboxEmail.Template),
+				zap.Int("attempts", attempt),
+				zap.Error(sendErr),
+			)
+		} else {
+			uc.logger.Warn("Outbox email retry failed",
+				zap.String("outbox_email_id", outboxEmail.ID.String()),
+				zap.String("kind", outboxEmail.Template),
+				zap.Int("attempts", attempt),
+				zap.Error(sendErr),
+			)
+		}
+		if err := uc.outboxRepo.RecordFailure(ctx, outboxEmail.ID, status, sendErr.Error()); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// outboxRetryDelay is the wait after the given attempt: 1m, 2m, 4m... capped at 1h
+func outboxRetryDelay(attempt int) time.Duration {
+	delay := outboxFirstRetryDelay
+	for i := 1; i < attempt && delay < outboxMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxRetryDelay {
+		delay = outboxMaxRetryDelay
+	}
+	return delay
+}
+
+// planDisplayName capitalizes a plan identifier for display ("ultra" -> "Ultra")
+func planDisplayName(plan string) string {
+	if plan == "" {
+		return plan
+	}
+	return strings.ToUpper(plan[:1]) + plan[1:]
 }
 
 // GenerateSecureToken generates a secure random token for session
@@ -14,6 +14,7 @@ import (
 // GenerateAcademicAIUseCase defines the interface for AI filtering operations
 type GenerateAcademicAIUseCase interface {
 	FilterContent(ctx context.Context, req *dto.GenerateAcademicAIRequest) (*dto.GenerateAcademicAIResponse, error)
+	GenerateStructured(ctx context.Context, req *dto.GenerateAcademicAIRequest) (*dto.GenerateAcademicAIV2Response, error)
 }
 
 // generateAcademicAIUseCase implements GenerateAcademicAIUseCase interface
@@ -85,6 +86,7 @@ ADDITIONAL INSTRUCTIONS:
 		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
 		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
 		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+		N:           openai.Int(int64(aiAlternativeCount(req.Alternatives))),
 	}
 
 	// Call OpenAI API
@@ -97,10 +99,43 @@ ADDITIONAL INSTRUCTIONS:
 		return nil, errors.NewAppError("no response from OpenAI")
 	}
 
-	// Get the filtered content
-	filteredContent := resp.Choices[0].Message.Content
+	// The first choice stays in filtered_content for clients that ignore alternatives
+	alternatives := choiceContents(resp)
 
 	return &dto.GenerateAcademicAIResponse{
-		FilteredContent: filteredContent,
+		FilteredContent: alternatives[0],
+		AIAlternatives:  dto.AIAlternatives{Alternatives: alternatives},
 	}, nil
 }
+
+// academicListSchema is the JSON schema of the v2 academic generator
+var academicListSchema = structuredListSchema("activities", structuredObjectSchema(map[string]any{
+	"title":       map[string]any{"type": "string"},
+	"type":        stringEnumSchema("subject", "project", "research", "extracurricular", "achievement"),
+	"description": map[string]any{"type": "string"},
+}))
+
+// GenerateStructured returns academic activities for the degree as typed items, validated
+// against a JSON schema.
+func (uc *generateAcademicAIUseCase) GenerateStructured(ctx context.Context, req *dto.GenerateAcademicAIRequest) (*dto.GenerateAcademicAIV2Response, error) {
+	systemPrompt := `You are a professional resume writer specialized in crafting impactful and recruiter-friendly academic activity lists that enhance resumes.
+
+Your task is to generate a list of 10 to 20 relevant academic activities, subjects, and experiences based on a user-provided university degree or field of study (e.g., Computer Science, Medicine, Engineering, Business Administration, etc.).
+
+For each activity:
+- "title": a short title as it would appear on a resume
+- "type": "subject" for coursework, "project" for practical or capstone projects, "research" for research work, "extracurricular" for clubs, teaching assistance or volunteering, "achievement" for awards and distinctions
+- "description": one sentence describing what was done or learned
+
+Mix the types. Every new request must result in a new and varied list.`
+
+	resp := &dto.GenerateAcademicAIV2Response{}
+	userPrompt := fmt.Sprintf("Generate a professional list of academic activities based on this degree or field of study:\n\n%s", req.Content)
+	if err := completeStructured(ctx, uc.openaiClient, systemPrompt, userPrompt, "academic_activity_list", academicListSchema, nil, resp); err != nil {
+		return nil, err
+	}
+	if resp.Activities == nil {
+		resp.Activities = []dto.GeneratedAcademicActivity{}
+	}
+	return resp, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	"github.com/google/uuid"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
@@ -15,7 +16,7 @@ import (
 
 // GenerateAnalyzeAIUseCase defines the interface for AI filtering operations
 type GenerateAnalyzeAIUseCase interface {
-	FilterContent(ctx context.Context, curriculumID uuid.UUID, language string) (*dto.GenerateAnalyzeAIResponse, error)
+	FilterContent(ctx context.Context, userID, curriculumID uuid.UUID, language string) (*dto.GenerateAnalyzeAIResponse, error)
 }
 
 // generateAnalyzeAIUseCase implements GenerateAnalyzeAIUseCase interface
@@ -38,23 +39,24 @@ func NewGenerateAnalyzeAIUseCase(apiKey string, curriculumUseCase CurriculumUseC
 	}, nil
 }
 
-func (uc *generateAnalyzeAIUseCase) FilterContent(ctx context.Context, curriculumID uuid.UUID, language string) (*dto.GenerateAnalyzeAIResponse, error) {
+// FilterContent analyzes one of the user's curriculums. A curriculum of another user is
+// reported as not found (gorm.ErrRecordNotFound, wrapped) before the AI is called.
+func (uc *generateAnalyzeAIUseCase) FilterContent(ctx context.Context, userID, curriculumID uuid.UUID, language string) (*dto.GenerateAnalyzeAIResponse, error) {
+	// Deterministic ATS rules complement the model's ats_compatibility opinion; they also
+	// check that the curriculum belongs to the user
+	atsChecks, err := uc.curriculumUseCase.CheckATS(ctx, userID, curriculumID, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run ATS checks: %w", err)
+	}
+
 	// Get curriculum body using the existing method from CurriculumUseCase
 	curriculumBody, err := uc.curriculumUseCase.GetCurriculumBody(ctx, curriculumID)
 	if err != nil {
 		return nil, errors.WrapError(err, "failed to get curriculum body")
 	}
 
-	// Map language code to full language name for the prompt
-	languageMap := map[string]string{
-		"pt": "português",
-		"en": "english",
-		"es": "español",
-	}
-	languageName := languageMap[language]
-	if languageName == "" {
-		languageName = "english" // default fallback
-	}
+	// Map language code to full language name for the prompt (English when unsupported)
+	languageName := locale.Resolve(language).PromptName
 
 	// Prepare the prompt for curriculum analysis using the fetched body
 	prompt := fmt.Sprintf(`
@@ -182,5 +184,7 @@ Your goal is to help the person improve their curriculum and increase their chan
 		return nil, errors.WrapError(err, "failed to parse AI response as JSON")
 	}
 
+	structured.ATSChecks = atsChecks
+
 	return &structured, nil
 }
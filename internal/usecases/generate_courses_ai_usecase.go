@@ -14,6 +14,7 @@ import (
 // GenerateCoursesAIUseCase defines the interface for AI filtering operations
 type GenerateCoursesAIUseCase interface {
 	FilterContent(ctx context.Context, req *dto.GenerateCoursesAIRequest) (*dto.GenerateCoursesAIResponse, error)
+	GenerateStructured(ctx context.Context, req *dto.GenerateCoursesAIRequest) (*dto.GenerateCoursesAIV2Response, error)
 }
 
 // generateCoursesAIUseCase implements GenerateCoursesAIUseCase interface
@@ -78,6 +79,7 @@ ADDITIONAL INSTRUCTIONS:
 		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
 		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
 		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+		N:           openai.Int(int64(aiAlternativeCount(req.Alternatives))),
 	}
 
 	// Call OpenAI API
@@ -90,10 +92,45 @@ ADDITIONAL INSTRUCTIONS:
 		return nil, errors.NewAppError("no response from OpenAI")
 	}
 
-	// Get the filtered content
-	filteredContent := resp.Choices[0].Message.Content
+	// The first choice stays in filtered_content for clients that ignore alternatives
+	alternatives := choiceContents(resp)
 
 	return &dto.GenerateCoursesAIResponse{
-		FilteredContent: filteredContent,
+		FilteredContent: alternatives[0],
+		AIAlternatives:  dto.AIAlternatives{Alternatives: alternatives},
 	}, nil
 }
+
+// courseListSchema is the JSON schema of the v2 course generator
+var courseListSchema = structuredListSchema("courses", structuredObjectSchema(map[string]any{
+	"name":     map[string]any{"type": "string"},
+	"type":     stringEnumSchema("course", "certification"),
+	"provider": map[string]any{"type": "string"},
+	"level":    stringEnumSchema("beginner", "intermediate", "advanced"),
+}))
+
+// GenerateStructured returns relevant courses and certifications as typed items, validated
+// against a JSON schema.
+func (uc *generateCoursesAIUseCase) GenerateStructured(ctx context.Context, req *dto.GenerateCoursesAIRequest) (*dto.GenerateCoursesAIV2Response, error) {
+	systemPrompt := `You are a professional resume writer specialized in crafting impactful and recruiter-friendly course or certification lists that enhance resumes.
+
+Your task is to generate a list of 10 to 20 relevant courses or certifications based on a user-provided course, degree, or academic/professional field (e.g., Electrician, Computer Science, Business Administration, etc.).
+
+For each item:
+- "name": the course or certification name as it would appear on a resume
+- "type": "certification" for credentials issued after an exam, otherwise "course"
+- "provider": the well-known institution or vendor that offers it, or an empty string when it is a generic course. Only name providers that really offer it
+- "level": the level of the course
+
+Every new request must result in a new and varied list.`
+
+	resp := &dto.GenerateCoursesAIV2Response{}
+	userPrompt := fmt.Sprintf("Generate a professional list of courses or certifications based on this content:\n\n%s", req.Content)
+	if err := completeStructured(ctx, uc.openaiClient, systemPrompt, userPrompt, "course_list", courseListSchema, nil, resp); err != nil {
+		return nil, err
+	}
+	if resp.Courses == nil {
+		resp.Courses = []dto.GeneratedCourse{}
+	}
+	return resp, nil
+}
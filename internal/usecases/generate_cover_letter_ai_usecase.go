@@ -0,0 +1,245 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const defaultCoverLetterTone = "formal"
+
+// coverLetterToneInstructions describes each supported tone for the prompt
+var coverLetterToneInstructions = map[string]string{
+	"formal":       "Use a formal, professional tone.",
+	"friendly":     "Use a warm, friendly but professional tone.",
+	"enthusiastic": "Use an enthusiastic tone that shows genuine interest in the role and company.",
+	"confident":    "Use a confident, assertive tone that leads with achievements.",
+	"concise":      "Be concise: three short paragraphs, no more than 200 words in total.",
+}
+
+// GenerateCoverLetterAIUseCase defines the interface for generating cover letters
+type GenerateCoverLetterAIUseCase interface {
+	GenerateCoverLetter(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateCoverLetterRequest) (*dto.CoverLetterResponse, error)
+}
+
+// generateCoverLetterAIUseCase implements GenerateCoverLetterAIUseCase interface
+type generateCoverLetterAIUseCase struct {
+	openaiClient      *openai.Client
+	curriculumRepo    repositories.CurriculumRepository
+	curriculumUseCase CurriculumUseCase
+	applicationRepo   repositories.ApplicationRepository
+	coverLetterRepo   repositories.CoverLetterRepository
+	logger            *zap.Logger
+}
+
+// coverLetterContent is the strict JSON the model returns
+type coverLetterContent struct {
+	Content string `json:"content"`
+}
+
+// NewGenerateCoverLetterAIUseCase creates a new instance of GenerateCoverLetterAIUseCase
+func NewGenerateCoverLetterAIUseCase(
+	apiKey string,
+	curriculumRepo repositories.CurriculumRepository,
+	curriculumUseCase CurriculumUseCase,
+	applicationRepo repositories.ApplicationRepository,
+	coverLetterRepo repositories.CoverLetterRepository,
+	logger *zap.Logger,
+) (GenerateCoverLetterAIUseCase, error) {
+	if apiKey == "" {
+		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
+	}
+
+	client := openai.NewClient(option.WithAPIKey(apiKey))
+
+	return &generateCoverLetterAIUseCase{
+		openaiClient:      &client,
+		curriculumRepo:    curriculumRepo,
+		curriculumUseCase: curriculumUseCase,
+		applicationRepo:   applicationRepo,
+		coverLetterRepo:   coverLetterRepo,
+		logger:            logger,
+	}, nil
+}
+
+// GenerateCoverLetter writes a cover letter from the curriculum body and the job posting and
+// stores it so the user can edit and export it later.
+func (uc *generateCoverLetterAIUseCase) GenerateCoverLetter(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateCoverLetterRequest) (*dto.CoverLetterResponse, error) {
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	letter := &models.CoverLetter{
+		UserID:         userID,
+		CurriculumID:   curriculum.ID,
+		Company:        strings.TrimSpace(req.Company),
+		Position:       strings.TrimSpace(req.Position),
+		JobDescription: req.JobDescription,
+		Tone:           req.Tone,
+		Language:       locale.CodeOf(req.Language),
+	}
+	if letter.Tone == "" {
+		letter.Tone = defaultCoverLetterTone
+	}
+
+	application, err := getLinkedApplication(ctx, uc.applicationRepo, userID, req.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application != nil {
+		letter.ApplicationID = &application.ID
+		if letter.Company == "" {
+			letter.Company = application.Company
+		}
+		if letter.Position == "" {
+			letter.Position = application.Position
+		}
+	}
+
+	curriculumBody, err := uc.curriculumUseCase.GetCurriculumBody(ctx, curriculum.ID)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get curriculum body")
+	}
+
+	content, err := uc.generateContent(ctx, curriculumBody.Body, letter)
+	if err != nil {
+		return nil, err
+	}
+
+	letter.Content = strings.TrimSpace(content.Content)
+	if letter.Content == "" {
+		return nil, errors.NewAppError("AI returned an empty cover letter")
+	}
+	letter.Title = coverLetterTitle(letter.Company, letter.Position)
+
+	if err := uc.coverLetterRepo.Create(ctx, letter); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Cover letter generated",
+		zap.String("cover_letter_id", letter.ID.String()),
+		zap.String("curriculum_id", curriculum.ID.String()),
+	)
+
+	resp := toCoverLetterResponse(letter)
+	return &resp, nil
+}
+
+func (uc *generateCoverLetterAIUseCase) generateContent(ctx context.Context, curriculumBody string, letter *models.CoverLetter) (*coverLetterContent, error) {
+	languageInstruction := "Write in the same language the curriculum is written in."
+	if language, ok := locale.Lookup(letter.Language); ok {
+		languageInstruction = fmt.Sprintf("You MUST write the cover letter in %s.", language.PromptName)
+	}
+
+	var target strings.Builder
+	if letter.Position != "" {
+		fmt.Fprintf(&target, "Position: %s\n", letter.Position)
+	}
+	if letter.Company != "" {
+		fmt.Fprintf(&target, "Company: %s\n", letter.Company)
+	}
+
+	prompt := fmt.Sprintf(`
+Write a cover letter for the candidate below, applying to the job posting.
+
+%s
+Job posting:
+%s
+
+Candidate curriculum:
+%s
+
+%s
+%s
+
+Return ONLY a strict JSON object with this exact structure and keys:
+{
+  "content": string // the full cover letter: greeting, 3 to 4 paragraphs and sign-off with the candidate's name, paragraphs separated by a blank line
+}
+
+STRICT RULES:
+- Output must be valid JSON only (no markdown, no backticks, no extra text)
+- Never invent experience, employers, skills, tools, dates or numbers that are not in the curriculum
+- Connect the candidate's most relevant experience to the requirements of the posting
+- Do not include addresses, dates or placeholders such as [Company Address]
+`, target.String(), letter.JobDescription, curriculumBody, coverLetterToneInstructions[letter.Tone], languageInstruction)
+
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(`You are a professional career writer. You write tailored cover letters that connect a candidate's real experience to a specific job posting. You are strictly truthful: you only use what the candidate's curriculum states. Respond with strict JSON only, matching the requested schema.`),
+			openai.UserMessage(prompt),
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := uc.openaiClient.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.NewAppError("no response from OpenAI")
+	}
+
+	var content coverLetterContent
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &content); err != nil {
+		return nil, errors.WrapError(err, "failed to parse AI response as JSON")
+	}
+
+	return &content, nil
+}
+
+// coverLetterTitle names a new letter after the position and company it was written for.
+func coverLetterTitle(company, position string) string {
+	switch {
+	case position != "" && company != "":
+		return fmt.Sprintf("Cover letter - %s at %s", position, company)
+	case position != "":
+		return "Cover letter - " + position
+	case company != "":
+		return "Cover letter - " + company
+	default:
+		return "Cover letter"
+	}
+}
+
+// getLinkedApplication loads the tracked application a generated document is prepared for.
+// It returns nil when no application ID is given and gorm.ErrRecordNotFound (wrapped) when
+// the application belongs to another user.
+func getLinkedApplication(ctx context.Context, applicationRepo repositories.ApplicationRepository, userID uuid.UUID, rawID *string) (*models.Application, error) {
+	if rawID == nil || *rawID == "" {
+		return nil, nil
+	}
+	applicationID, err := uuid.Parse(*rawID)
+	if err != nil {
+		return nil, errors.NewAppError("invalid application ID format")
+	}
+	application, err := applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.UserID != userID {
+		return nil, fmt.Errorf("application %s: %w", applicationID.String(), gorm.ErrRecordNotFound)
+	}
+	return application, nil
+}
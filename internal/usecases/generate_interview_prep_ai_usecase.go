@@ -0,0 +1,281 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const defaultInterviewQuestionCount = 10
+
+// ErrNoWorkExperience is returned when the curriculum has no works to ground STAR answers in.
+var ErrNoWorkExperience = errors.NewAppError("curriculum has no work experience to ground the answers in")
+
+// GenerateInterviewPrepAIUseCase defines the interface for generating interview practice sets
+type GenerateInterviewPrepAIUseCase interface {
+	GenerateInterviewPrep(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateInterviewPrepRequest) (*dto.InterviewPracticeSetResponse, error)
+}
+
+// generateInterviewPrepAIUseCase implements GenerateInterviewPrepAIUseCase interface
+type generateInterviewPrepAIUseCase struct {
+	openaiClient      *openai.Client
+	curriculumRepo    repositories.CurriculumRepository
+	applicationRepo   repositories.ApplicationRepository
+	interviewPrepRepo repositories.InterviewPrepRepository
+	logger            *zap.Logger
+}
+
+// generatedInterviewQuestion is one question of the strict JSON the model returns.
+// WorkIndex points at the work in the curriculum the answer draws on.
+type generatedInterviewQuestion struct {
+	Category  string `json:"category"`
+	Question  string `json:"question"`
+	WorkIndex int    `json:"work_index"`
+	Situation string `json:"situation"`
+	Task      string `json:"task"`
+	Action    string `json:"action"`
+	Result    string `json:"result"`
+}
+
+// generatedInterviewPrep is the strict JSON the model returns
+type generatedInterviewPrep struct {
+	Questions []generatedInterviewQuestion `json:"questions"`
+}
+
+// NewGenerateInterviewPrepAIUseCase creates a new instance of GenerateInterviewPrepAIUseCase
+func NewGenerateInterviewPrepAIUseCase(
+	apiKey string,
+	curriculumRepo repositories.CurriculumRepository,
+	applicationRepo repositories.ApplicationRepository,
+	interviewPrepRepo repositories.InterviewPrepRepository,
+	logger *zap.Logger,
+) (GenerateInterviewPrepAIUseCase, error) {
+	if apiKey == "" {
+		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
+	}
+
+	client := openai.NewClient(option.WithAPIKey(apiKey))
+
+	return &generateInterviewPrepAIUseCase{
+		openaiClient:      &client,
+		curriculumRepo:    curriculumRepo,
+		applicationRepo:   applicationRepo,
+		interviewPrepRepo: interviewPrepRepo,
+		logger:            logger,
+	}, nil
+}
+
+// GenerateInterviewPrep asks the model for likely behavioral and technical questions for the
+// job posting, each with a STAR answer built from one of the curriculum's works, and stores
+// them as a practice set.
+func (uc *generateInterviewPrepAIUseCase) GenerateInterviewPrep(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateInterviewPrepRequest) (*dto.InterviewPracticeSetResponse, error) {
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+	if len(curriculum.Works) == 0 {
+		return nil, ErrNoWorkExperience
+	}
+
+	set := &models.InterviewPracticeSet{
+		UserID:         userID,
+		CurriculumID:   curriculum.ID,
+		Company:        strings.TrimSpace(req.Company),
+		Position:       strings.TrimSpace(req.Position),
+		JobDescription: req.JobDescription,
+		Language:       locale.CodeOf(req.Language),
+	}
+
+	application, err := getLinkedApplication(ctx, uc.applicationRepo, userID, req.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application != nil {
+		set.ApplicationID = &application.ID
+		if set.Company == "" {
+			set.Company = application.Company
+		}
+		if set.Position == "" {
+			set.Position = application.Position
+		}
+	}
+
+	questionCount := req.QuestionCount
+	if questionCount == 0 {
+		questionCount = defaultInterviewQuestionCount
+	}
+
+	generated, err := uc.generateQuestions(ctx, curriculum, set, questionCount)
+	if err != nil {
+		return nil, err
+	}
+
+	set.Questions = buildInterviewQuestions(curriculum.Works, generated.Questions)
+	if len(set.Questions) == 0 {
+		return nil, errors.NewAppError("AI returned no usable interview questions")
+	}
+	set.Title = interviewPrepTitle(set.Company, set.Position)
+
+	if err := uc.interviewPrepRepo.Create(ctx, set); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Interview practice set generated",
+		zap.String("practice_set_id", set.ID.String()),
+		zap.String("curriculum_id", curriculum.ID.String()),
+		zap.Int("questions", len(set.Questions)),
+	)
+
+	resp := toInterviewPracticeSetResponse(set)
+	return &resp, nil
+}
+
+func (uc *generateInterviewPrepAIUseCase) generateQuestions(ctx context.Context, curriculum *models.Curriculums, set *models.InterviewPracticeSet, questionCount int) (*generatedInterviewPrep, error) {
+	languageInstruction := "Write in the same language the curriculum is written in."
+	if language, ok := locale.Lookup(set.Language); ok {
+		languageInstruction = fmt.Sprintf("You MUST write all questions and answers in %s.", language.PromptName)
+	}
+
+	var target strings.Builder
+	if set.Position != "" {
+		fmt.Fprintf(&target, "Position: %s\n", set.Position)
+	}
+	if set.Company != "" {
+		fmt.Fprintf(&target, "Company: %s\n", set.Company)
+	}
+
+	var works strings.Builder
+	for i, work := range curriculum.Works {
+		fmt.Fprintf(&works, "[%d] %s at %s\n%s\n\n", i, work.Position, work.Company, work.Description)
+	}
+
+	prompt := fmt.Sprintf(`
+Prepare the candidate for an interview for the job posting below.
+
+%s
+Job posting:
+%s
+
+Candidate skills:
+%s
+
+Candidate work experience (index in brackets):
+%s
+
+Generate exactly %d likely interview questions, about half behavioral and half technical, based on what the posting asks for.
+For each question write a suggested answer in STAR format (situation, task, action, result) drawn from ONE of the works above.
+
+%s
+
+Return ONLY a strict JSON object with this exact structure and keys:
+{
+  "questions": [
+    {
+      "category": "behavioral" | "technical",
+      "question": string,
+      "work_index": number, // index of the work the answer is based on
+      "situation": string,
+      "task": string,
+      "action": string,
+      "result": string
+    }
+  ]
+}
+
+STRICT RULES:
+- Output must be valid JSON only (no markdown, no backticks, no extra text)
+- Every answer must be based on the work at work_index; never invent employers, projects, tools, dates or numbers that are not in the curriculum
+- When the work description lacks a detail, keep the answer general instead of making it up
+- Each STAR part is 1 to 3 sentences, written in the first person
+`, target.String(), set.JobDescription, curriculum.Skills, works.String(), questionCount, languageInstruction)
+
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(`You are an experienced interview coach. You predict the questions a hiring team will ask for a specific job posting and help candidates answer them with the STAR method using only their real experience. Respond with strict JSON only, matching the requested schema.`),
+			openai.UserMessage(prompt),
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000)) * 4),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := uc.openaiClient.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.NewAppError("no response from OpenAI")
+	}
+
+	var generated generatedInterviewPrep
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &generated); err != nil {
+		return nil, errors.WrapError(err, "failed to parse AI response as JSON")
+	}
+
+	return &generated, nil
+}
+
+// buildInterviewQuestions keeps the generated questions that have text and links each answer
+// to its work. Unknown categories fall back to behavioral and out-of-range work indexes leave
+// the answer unlinked.
+func buildInterviewQuestions(works []models.Work, generated []generatedInterviewQuestion) []models.InterviewQuestion {
+	questions := make([]models.InterviewQuestion, 0, len(generated))
+	for _, item := range generated {
+		text := strings.TrimSpace(item.Question)
+		if text == "" {
+			continue
+		}
+
+		category := models.InterviewQuestionCategory(strings.ToLower(strings.TrimSpace(item.Category)))
+		if !category.IsValid() {
+			category = models.InterviewQuestionBehavioral
+		}
+
+		question := models.InterviewQuestion{
+			SortOrder: len(questions),
+			Category:  category,
+			Question:  text,
+			Situation: strings.TrimSpace(item.Situation),
+			Task:      strings.TrimSpace(item.Task),
+			Action:    strings.TrimSpace(item.Action),
+			Result:    strings.TrimSpace(item.Result),
+		}
+		if item.WorkIndex >= 0 && item.WorkIndex < len(works) {
+			question.WorkID = &works[item.WorkIndex].ID
+		}
+		questions = append(questions, question)
+	}
+	return questions
+}
+
+// interviewPrepTitle names a new practice set after the position and company it was prepared for.
+func interviewPrepTitle(company, position string) string {
+	switch {
+	case position != "" && company != "":
+		return fmt.Sprintf("Interview prep - %s at %s", position, company)
+	case position != "":
+		return "Interview prep - " + position
+	case company != "":
+		return "Interview prep - " + company
+	default:
+		return "Interview prep"
+	}
+}
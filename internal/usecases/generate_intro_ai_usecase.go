@@ -7,22 +7,31 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 )
 
 // GenerateIntroAIUseCase defines the interface for AI filtering operations
 type GenerateIntroAIUseCase interface {
-	FilterContent(ctx context.Context, req *dto.GenerateIntroAIRequest) (*dto.GenerateIntroAIResponse, error)
+	FilterContent(ctx context.Context, userID uuid.UUID, req *dto.GenerateIntroAIRequest) (*dto.GenerateIntroAIResponse, error)
 }
 
 // generateIntroAIUseCase implements GenerateIntroAIUseCase interface
 type generateIntroAIUseCase struct {
-	openaiClient *openai.Client
+	openaiClient   *openai.Client
+	contextSources curriculumContextSources
 }
 
-// NewGenerateIntroAIUseCase creates a new instance of GenerateIntroAIUseCase
-func NewGenerateIntroAIUseCase(apiKey string) (GenerateIntroAIUseCase, error) {
+// NewGenerateIntroAIUseCase creates a new instance of GenerateIntroAIUseCase.
+// The repositories are used to ground the prompt in a curriculum when the request has a curriculum_id.
+func NewGenerateIntroAIUseCase(
+	apiKey string,
+	curriculumRepo repositories.CurriculumRepository,
+	curriculumUseCase CurriculumUseCase,
+	configurationRepo repositories.ConfigurationRepository,
+) (GenerateIntroAIUseCase, error) {
 	if apiKey == "" {
 		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
 	}
@@ -31,15 +40,25 @@ func NewGenerateIntroAIUseCase(apiKey string) (GenerateIntroAIUseCase, error) {
 
 	return &generateIntroAIUseCase{
 		openaiClient: &client,
+		contextSources: curriculumContextSources{
+			curriculumRepo:    curriculumRepo,
+			curriculumUseCase: curriculumUseCase,
+			configurationRepo: configurationRepo,
+		},
 	}, nil
 }
 
 // FilterContent processes the content through OpenAI API to filter and improve it
-func (uc *generateIntroAIUseCase) FilterContent(ctx context.Context, req *dto.GenerateIntroAIRequest) (*dto.GenerateIntroAIResponse, error) {
+func (uc *generateIntroAIUseCase) FilterContent(ctx context.Context, userID uuid.UUID, req *dto.GenerateIntroAIRequest) (*dto.GenerateIntroAIResponse, error) {
+	genCtx, err := uc.contextSources.load(ctx, userID, req.CurriculumID, req.Language)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create chat completion request
 	chatReq := openai.ChatCompletionNewParams{
 		Model: "gpt-4o-mini",
-		Messages: []openai.ChatCompletionMessageParamUnion{
+		Messages: withGenerationContext([]openai.ChatCompletionMessageParamUnion{
 			openai.SystemMessage(`You are a professional writing assistant. Your ONLY task is to generate a polished description (max 320 characters) based on the user's input.
 
 ABSOLUTE REQUIREMENTS - THESE ARE NON-NEGOTIABLE:
@@ -71,10 +90,11 @@ PROCESS:
 
 REMEMBER: Your response is a description, not a question, not a request, not a suggestion. It is a finished, polished description ready to use.`),
 			openai.UserMessage(fmt.Sprintf("Generate a polished description based on this content:\n\n%s", req.Content)),
-		},
+		}, genCtx),
 		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
 		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
 		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+		N:           openai.Int(int64(aiAlternativeCount(req.Alternatives))),
 	}
 
 	// Call OpenAI API
@@ -87,10 +107,11 @@ REMEMBER: Your response is a description, not a question, not a request, not a s
 		return nil, fmt.Errorf("no response from OpenAI for intro generation")
 	}
 
-	// Get the filtered content
-	filteredContent := resp.Choices[0].Message.Content
+	// The first choice stays in filtered_content for clients that ignore alternatives
+	alternatives := choiceContents(resp)
 
 	return &dto.GenerateIntroAIResponse{
-		FilteredContent: filteredContent,
+		FilteredContent: alternatives[0],
+		AIAlternatives:  dto.AIAlternatives{Alternatives: alternatives},
 	}, nil
 }
@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/keywords"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"gorm.io/gorm"
+)
+
+// matchKeywordLimit is how many job description keywords are scored.
+const matchKeywordLimit = 30
+
+// GenerateMatchAIUseCase defines the interface for scoring a curriculum against a job description
+type GenerateMatchAIUseCase interface {
+	MatchJobDescription(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateMatchAIRequest, language string) (*dto.GenerateMatchAIResponse, error)
+}
+
+// generateMatchAIUseCase implements GenerateMatchAIUseCase interface
+type generateMatchAIUseCase struct {
+	openaiClient   *openai.Client
+	curriculumRepo repositories.CurriculumRepository
+}
+
+// matchRewrite is one rewrite suggestion as returned by the model
+type matchRewrite struct {
+	Index         int      `json:"index"`
+	Suggested     string   `json:"suggested"`
+	KeywordsAdded []string `json:"keywords_added"`
+}
+
+// NewGenerateMatchAIUseCase creates a new instance of GenerateMatchAIUseCase
+func NewGenerateMatchAIUseCase(apiKey string, curriculumRepo repositories.CurriculumRepository) (GenerateMatchAIUseCase, error) {
+	if apiKey == "" {
+		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
+	}
+
+	client := openai.NewClient(option.WithAPIKey(apiKey))
+
+	return &generateMatchAIUseCase{
+		openaiClient:   &client,
+		curriculumRepo: curriculumRepo,
+	}, nil
+}
+
+// MatchJobDescription scores the curriculum by keyword coverage of the job description and
+// asks the AI for work description rewrites that work in the missing keywords.
+func (uc *generateMatchAIUseCase) MatchJobDescription(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.GenerateMatchAIRequest, language string) (*dto.GenerateMatchAIResponse, error) {
+	curriculum, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	jobKeywords := keywords.Extract(req.JobDescription, matchKeywordLimit)
+	coverage := keywords.Cover(jobKeywords, curriculumSections(curriculum))
+
+	resp := &dto.GenerateMatchAIResponse{
+		Score:             keywords.Score(coverage),
+		MatchedKeywords:   []string{},
+		MissingKeywords:   []string{},
+		KeywordCoverage:   make([]dto.KeywordCoverage, 0, len(coverage)),
+		SuggestedRewrites: []dto.BulletRewrite{},
+	}
+	for _, c := range coverage {
+		if c.Matched() {
+			resp.MatchedKeywords = append(resp.MatchedKeywords, c.Keyword.Term)
+		} else {
+			resp.MissingKeywords = append(resp.MissingKeywords, c.Keyword.Term)
+		}
+		resp.KeywordCoverage = append(resp.KeywordCoverage, dto.KeywordCoverage{
+			Keyword:            c.Keyword.Term,
+			JobMentions:        c.Keyword.Count,
+			CurriculumMentions: c.Mentions,
+			Sections:           c.Sections,
+			Matched:            c.Matched(),
+		})
+	}
+
+	if len(resp.MissingKeywords) == 0 || len(curriculum.Works) == 0 {
+		return resp, nil
+	}
+
+	rewrites, err := uc.suggestRewrites(ctx, curriculum, req.JobDescription, resp.MissingKeywords, language)
+	if err != nil {
+		return nil, err
+	}
+	resp.SuggestedRewrites = rewrites
+
+	return resp, nil
+}
+
+func (uc *generateMatchAIUseCase) suggestRewrites(ctx context.Context, curriculum *models.Curriculums, jobDescription string, missing []string, language string) ([]dto.BulletRewrite, error) {
+	languageName := locale.Resolve(language).PromptName
+
+	var works strings.Builder
+	for i, work := range curriculum.Works {
+		fmt.Fprintf(&works, "[%d] %s at %s\n%s\n\n", i, work.Position, work.Company, work.Description)
+	}
+
+	prompt := fmt.Sprintf(`
+Suggest rewrites of the work descriptions below so they better match the job posting.
+
+Job posting:
+%s
+
+Keywords from the posting that the curriculum does not mention:
+%s
+
+Work experience (index in brackets):
+%s
+
+IMPORTANT: You MUST write the suggestions in %s language.
+
+Return ONLY a strict JSON object with this exact structure and keys:
+{
+  "rewrites": [ { "index": number, "suggested": string, "keywords_added": [string] } ]
+}
+
+STRICT RULES:
+- Output must be valid JSON only (no markdown, no backticks, no extra text)
+- At most one rewrite per work and at most 5 rewrites, most impactful first
+- Only add a keyword when the original description plausibly supports it; never invent experience, tools or numbers
+- keywords_added must only contain keywords from the list above
+`, jobDescription, strings.Join(missing, ", "), works.String(), languageName)
+
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(`You are a professional resume writer specialized in ATS optimization. You rewrite experience bullets with strong action verbs and the wording recruiters search for, while staying strictly truthful to what the candidate wrote. Respond with strict JSON only, matching the requested schema.`),
+			openai.UserMessage(prompt),
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := uc.openaiClient.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.NewAppError("no response from OpenAI")
+	}
+
+	var structured struct {
+		Rewrites []matchRewrite `json:"rewrites"`
+	}
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &structured); err != nil {
+		return nil, errors.WrapError(err, "failed to parse AI response as JSON")
+	}
+
+	rewrites := make([]dto.BulletRewrite, 0, len(structured.Rewrites))
+	seen := make(map[int]bool, len(structured.Rewrites))
+	for _, r := range structured.Rewrites {
+		suggested := strings.TrimSpace(r.Suggested)
+		if r.Index < 0 || r.Index >= len(curriculum.Works) || seen[r.Index] || suggested == "" {
+			continue
+		}
+		seen[r.Index] = true
+		work := curriculum.Works[r.Index]
+		rewrites = append(rewrites, dto.BulletRewrite{
+			Company:       work.Company,
+			Position:      work.Position,
+			Original:      work.Description,
+			Suggested:     suggested,
+			KeywordsAdded: r.KeywordsAdded,
+		})
+	}
+
+	return rewrites, nil
+}
+
+// curriculumSections splits a curriculum into the named sections used for keyword coverage.
+func curriculumSections(curriculum *models.Curriculums) []keywords.Section {
+	var works, educations strings.Builder
+	for _, work := range curriculum.Works {
+		fmt.Fprintf(&works, "%s. %s. %s\n", work.Position, work.Company, work.Description)
+	}
+	for _, education := range curriculum.Educations {
+		fmt.Fprintf(&educations, "%s. %s. %s\n", education.Degree, education.Institution, education.Description)
+	}
+
+	return []keywords.Section{
+		{Name: "intro", Text: curriculum.Intro},
+		{Name: "skills", Text: curriculum.Skills},
+		{Name: "works", Text: works.String()},
+		{Name: "educations", Text: educations.String()},
+		{Name: "courses", Text: curriculum.Courses},
+		{Name: "languages", Text: curriculum.Languages},
+	}
+}
@@ -14,6 +14,7 @@ import (
 // GenerateSkillAIUseCase defines the interface for AI filtering operations
 type GenerateSkillAIUseCase interface {
 	FilterContent(ctx context.Context, req *dto.GenerateSkillAIRequest) (*dto.GenerateSkillAIResponse, error)
+	GenerateStructured(ctx context.Context, req *dto.GenerateSkillAIRequest) (*dto.GenerateSkillAIV2Response, error)
 }
 
 // generateSkillAIUseCase implements GenerateSkillAIUseCase interface
@@ -85,6 +86,7 @@ ADDITIONAL INSTRUCTIONS:
 		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
 		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
 		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+		N:           openai.Int(int64(aiAlternativeCount(req.Alternatives))),
 	}
 
 	// Call OpenAI API
@@ -97,10 +99,43 @@ ADDITIONAL INSTRUCTIONS:
 		return nil, errors.NewAppError("no response from OpenAI")
 	}
 
-	// Get the filtered content
-	filteredContent := resp.Choices[0].Message.Content
+	// The first choice stays in filtered_content for clients that ignore alternatives
+	alternatives := choiceContents(resp)
 
 	return &dto.GenerateSkillAIResponse{
-		FilteredContent: filteredContent,
+		FilteredContent: alternatives[0],
+		AIAlternatives:  dto.AIAlternatives{Alternatives: alternatives},
 	}, nil
 }
+
+// skillListSchema is the JSON schema of the v2 skill generator
+var skillListSchema = structuredListSchema("skills", structuredObjectSchema(map[string]any{
+	"name":        map[string]any{"type": "string"},
+	"category":    stringEnumSchema("hard", "soft"),
+	"proficiency": stringEnumSchema("beginner", "intermediate", "advanced", "expert"),
+}))
+
+// GenerateStructured returns up to 10 related skills as typed items, each with a hard/soft
+// category and the proficiency the field usually expects, validated against a JSON schema.
+func (uc *generateSkillAIUseCase) GenerateStructured(ctx context.Context, req *dto.GenerateSkillAIRequest) (*dto.GenerateSkillAIV2Response, error) {
+	systemPrompt := `You are a professional career advisor specialized in identifying and suggesting related skills that complement and enhance a person's professional profile.
+
+Your task is to generate a list of up to 10 related skills based on a user-provided skill or area of expertise (e.g., Programming, Marketing, Design, Sales, Management, etc.).
+
+For each skill:
+- "name": a short, recruiter-friendly skill name (1 to 4 words), no trailing punctuation
+- "category": "hard" for technical or measurable skills, "soft" for interpersonal or behavioral skills
+- "proficiency": the level a professional in this area is usually expected to have
+
+Include both hard and soft skills. Every new request must result in a new and varied list.`
+
+	resp := &dto.GenerateSkillAIV2Response{}
+	userPrompt := fmt.Sprintf("Generate related skills based on this skill or area of expertise:\n\n%s", req.Content)
+	if err := completeStructured(ctx, uc.openaiClient, systemPrompt, userPrompt, "skill_list", skillListSchema, nil, resp); err != nil {
+		return nil, err
+	}
+	if resp.Skills == nil {
+		resp.Skills = []dto.GeneratedSkill{}
+	}
+	return resp, nil
+}
@@ -0,0 +1,209 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// GenerateTailorAIUseCase defines the interface for tailoring a curriculum to a job posting
+type GenerateTailorAIUseCase interface {
+	TailorCurriculum(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.TailorCurriculumRequest) (*dto.CurriculumResponse, error)
+}
+
+// generateTailorAIUseCase implements GenerateTailorAIUseCase interface
+type generateTailorAIUseCase struct {
+	openaiClient   *openai.Client
+	curriculumRepo repositories.CurriculumRepository
+	statsRepo      repositories.CurriculumCreationStatsRepository
+	logger         *zap.Logger
+}
+
+// tailoredWork is one experience entry in the order chosen by the model.
+// Index points at the work in the source curriculum.
+type tailoredWork struct {
+	Index       int    `json:"index"`
+	Description string `json:"description"`
+}
+
+// tailoredContent is the strict JSON the model returns
+type tailoredContent struct {
+	Intro  string         `json:"intro"`
+	Skills string         `json:"skills"`
+	Works  []tailoredWork `json:"works"`
+}
+
+// NewGenerateTailorAIUseCase creates a new instance of GenerateTailorAIUseCase
+func NewGenerateTailorAIUseCase(apiKey string, curriculumRepo repositories.CurriculumRepository, statsRepo repositories.CurriculumCreationStatsRepository, logger *zap.Logger) (GenerateTailorAIUseCase, error) {
+	if apiKey == "" {
+		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
+	}
+
+	client := openai.NewClient(option.WithAPIKey(apiKey))
+
+	return &generateTailorAIUseCase{
+		openaiClient:   &client,
+		curriculumRepo: curriculumRepo,
+		statsRepo:      statsRepo,
+		logger:         logger,
+	}, nil
+}
+
+// TailorCurriculum creates a new curriculum from the source, with the intro and skills rewritten
+// for the job posting and the works reordered by relevance. Companies, positions and dates are
+// never changed by the model; only descriptions are reworded.
+func (uc *generateTailorAIUseCase) TailorCurriculum(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.TailorCurriculumRequest) (*dto.CurriculumResponse, error) {
+	source, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if source.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	content, err := uc.generateTailoredContent(ctx, source, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tailored := cloneCurriculum(source)
+	tailored.JobDescription = req.JobDescription
+	if intro := strings.TrimSpace(content.Intro); intro != "" {
+		tailored.Intro = intro
+	}
+	if skills := strings.TrimSpace(content.Skills); skills != "" {
+		tailored.Skills = skills
+	}
+	tailored.Works = reorderTailoredWorks(tailored.Works, content.Works)
+
+	if err := uc.curriculumRepo.Create(ctx, tailored); err != nil {
+		return nil, err
+	}
+
+	if err := uc.statsRepo.IncrementCreationCount(ctx, userID); err != nil {
+		uc.logger.Warn("Failed to increment curriculum creation count; tailored curriculum was created",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+	}
+
+	uc.logger.Info("Tailored curriculum created",
+		zap.String("source_curriculum_id", source.ID.String()),
+		zap.String("curriculum_id", tailored.ID.String()),
+	)
+
+	resp := toCurriculumResponse(tailored)
+	return &resp, nil
+}
+
+func (uc *generateTailorAIUseCase) generateTailoredContent(ctx context.Context, source *models.Curriculums, req *dto.TailorCurriculumRequest) (*tailoredContent, error) {
+	languageInstruction := "Write in the same language the curriculum is written in."
+	if language, ok := locale.Lookup(req.Language); ok {
+		languageInstruction = fmt.Sprintf("You MUST write all content in %s.", language.PromptName)
+	}
+
+	var works strings.Builder
+	for i, work := range source.Works {
+		fmt.Fprintf(&works, "[%d] %s at %s\n%s\n\n", i, work.Position, work.Company, work.Description)
+	}
+
+	prompt := fmt.Sprintf(`
+Tailor the following curriculum to the job posting.
+
+Job posting:
+%s
+
+Current intro:
+%s
+
+Current skills:
+%s
+
+Work experience (index in brackets):
+%s
+
+%s
+
+Return ONLY a strict JSON object with this exact structure and keys:
+{
+  "intro": string, // intro rewritten to highlight what the job posting asks for
+  "skills": string, // the same skills, most relevant first, using the posting's wording where accurate
+  "works": [ { "index": number, "description": string } ] // every work index exactly once, most relevant first, description reworded for the posting
+}
+
+STRICT RULES:
+- Output must be valid JSON only (no markdown, no backticks, no extra text)
+- Never invent experience, employers, skills, tools, dates or numbers that are not in the curriculum
+- Keep each description roughly the same length as the original
+`, req.JobDescription, source.Intro, source.Skills, works.String(), languageInstruction)
+
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(`You are a professional resume writer. You adapt an existing curriculum to a specific job posting by reordering and rewording its content so the most relevant experience stands out. You are strictly truthful: you only rephrase and prioritize what the candidate already wrote. Respond with strict JSON only, matching the requested schema.`),
+			openai.UserMessage(prompt),
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000)) * 2),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := uc.openaiClient.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.NewAppError("no response from OpenAI")
+	}
+
+	var content tailoredContent
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &content); err != nil {
+		return nil, errors.WrapError(err, "failed to parse AI response as JSON")
+	}
+
+	return &content, nil
+}
+
+// reorderTailoredWorks applies the model's order and descriptions to the copied works.
+// Unknown or repeated indexes are ignored and works the model left out keep their
+// original description and are appended in their original order. SortOrder is renumbered.
+func reorderTailoredWorks(works []models.Work, order []tailoredWork) []models.Work {
+	used := make([]bool, len(works))
+	result := make([]models.Work, 0, len(works))
+
+	for _, item := range order {
+		if item.Index < 0 || item.Index >= len(works) || used[item.Index] {
+			continue
+		}
+		used[item.Index] = true
+		work := works[item.Index]
+		work.SortOrder = len(result)
+		if description := strings.TrimSpace(item.Description); description != "" {
+			work.Description = description
+		}
+		result = append(result, work)
+	}
+
+	for i, work := range works {
+		if !used[i] {
+			work.SortOrder = len(result)
+			result = append(result, work)
+		}
+	}
+
+	return result
+}
@@ -7,22 +7,32 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 )
 
 // GenerateTaskAIUseCase defines the interface for AI filtering operations
 type GenerateTaskAIUseCase interface {
-	FilterContent(ctx context.Context, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIResponse, error)
+	FilterContent(ctx context.Context, userID uuid.UUID, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIResponse, error)
+	GenerateStructured(ctx context.Context, userID uuid.UUID, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIV2Response, error)
 }
 
 // generateTaskAIUseCase implements GenerateTaskAIUseCase interface
 type generateTaskAIUseCase struct {
-	openaiClient *openai.Client
+	openaiClient   *openai.Client
+	contextSources curriculumContextSources
 }
 
-// NewGenerateTaskAIUseCase creates a new instance of GenerateTaskAIUseCase
-func NewGenerateTaskAIUseCase(apiKey string) (GenerateTaskAIUseCase, error) {
+// NewGenerateTaskAIUseCase creates a new instance of GenerateTaskAIUseCase.
+// The repositories are used to ground the prompt in a curriculum when the request has a curriculum_id.
+func NewGenerateTaskAIUseCase(
+	apiKey string,
+	curriculumRepo repositories.CurriculumRepository,
+	curriculumUseCase CurriculumUseCase,
+	configurationRepo repositories.ConfigurationRepository,
+) (GenerateTaskAIUseCase, error) {
 	if apiKey == "" {
 		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
 	}
@@ -31,15 +41,25 @@ func NewGenerateTaskAIUseCase(apiKey string) (GenerateTaskAIUseCase, error) {
 
 	return &generateTaskAIUseCase{
 		openaiClient: &client,
+		contextSources: curriculumContextSources{
+			curriculumRepo:    curriculumRepo,
+			curriculumUseCase: curriculumUseCase,
+			configurationRepo: configurationRepo,
+		},
 	}, nil
 }
 
 // FilterContent processes the content through OpenAI API to filter and improve it
-func (uc *generateTaskAIUseCase) FilterContent(ctx context.Context, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIResponse, error) {
+func (uc *generateTaskAIUseCase) FilterContent(ctx context.Context, userID uuid.UUID, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIResponse, error) {
+	genCtx, err := uc.contextSources.load(ctx, userID, req.CurriculumID, req.Language)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create chat completion request
 	chatReq := openai.ChatCompletionNewParams{
 		Model: "gpt-4o-mini",
-		Messages: []openai.ChatCompletionMessageParamUnion{
+		Messages: withGenerationContext([]openai.ChatCompletionMessageParamUnion{
 			openai.SystemMessage(`You are a professional resume writer specialized in crafting impactful and recruiter-friendly task lists that enhance resumes.
 
 Your task is to generate a list of relevant tasks based on a user-provided task, degree, or academic/professional field (e.g., Electrician, Computer Science, Business Administration, etc.).
@@ -74,10 +94,11 @@ ADDITIONAL INSTRUCTIONS:
 - RESPOND IN THE SAME LANGUAGE AS THE INPUT CONTENT. This is mandatory.
 - If the input is unclear or insufficient, ask briefly for more detail IN THE SAME LANGUAGE AS THE INPUT (e.g., "Can you specify the task or field of study better?").`),
 			openai.UserMessage(fmt.Sprintf("Generate a professional list of tasks based on this content:\n\n%s", req.Content)),
-		},
+		}, genCtx),
 		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000))),
 		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
 		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+		N:           openai.Int(int64(aiAlternativeCount(req.Alternatives))),
 	}
 
 	// Call OpenAI API
@@ -90,10 +111,48 @@ ADDITIONAL INSTRUCTIONS:
 		return nil, fmt.Errorf("no response from OpenAI")
 	}
 
-	// Get the filtered content
-	filteredContent := resp.Choices[0].Message.Content
+	// The first choice stays in filtered_content for clients that ignore alternatives
+	alternatives := choiceContents(resp)
 
 	return &dto.GenerateTaskAIResponse{
-		FilteredContent: filteredContent,
+		FilteredContent: alternatives[0],
+		AIAlternatives:  dto.AIAlternatives{Alternatives: alternatives},
 	}, nil
 }
+
+// taskListSchema is the JSON schema of the v2 task generator
+var taskListSchema = structuredListSchema("tasks", structuredObjectSchema(map[string]any{
+	"text":        map[string]any{"type": "string"},
+	"action_verb": map[string]any{"type": "string"},
+	"metric":      map[string]any{"type": "string"},
+}))
+
+// GenerateStructured returns 10 to 20 task bullets as typed items, each with the action verb
+// it starts with and a suggested metric, validated against a JSON schema.
+func (uc *generateTaskAIUseCase) GenerateStructured(ctx context.Context, userID uuid.UUID, req *dto.GenerateTaskAIRequest) (*dto.GenerateTaskAIV2Response, error) {
+	systemPrompt := `You are a professional resume writer specialized in crafting impactful and recruiter-friendly task lists that enhance resumes.
+
+Your task is to generate a list of 10 to 20 relevant tasks based on a user-provided task, degree, or academic/professional field (e.g., Electrician, Computer Science, Business Administration, etc.).
+
+For each task:
+- "text": one resume bullet that starts with a strong action verb, without a leading dash or bullet character
+- "action_verb": the verb the bullet starts with, exactly as written in "text"
+- "metric": what the candidate could measure to quantify the task (e.g., "tickets resolved per week"), or an empty string when nothing fits. Never invent numbers or results
+
+Vary the verbs and structure. Every new request must result in a new and varied list.`
+
+	genCtx, err := uc.contextSources.load(ctx, userID, req.CurriculumID, req.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GenerateTaskAIV2Response{}
+	userPrompt := fmt.Sprintf("Generate a professional list of tasks based on this content:\n\n%s", req.Content)
+	if err := completeStructured(ctx, uc.openaiClient, systemPrompt, userPrompt, "task_list", taskListSchema, genCtx, resp); err != nil {
+		return nil, err
+	}
+	if resp.Tasks == nil {
+		resp.Tasks = []dto.GeneratedTask{}
+	}
+	return resp, nil
+}
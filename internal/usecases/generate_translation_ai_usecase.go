@@ -9,22 +9,67 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
+// translationChunkChars bounds the text sent to the model in one call when translating a
+// stored curriculum. A single field longer than this is sent on its own.
+const translationChunkChars = 6000
+
 // GenerateTranslationAIUseCase defines the interface for AI translation operations
 type GenerateTranslationAIUseCase interface {
 	TranslateCurriculum(ctx context.Context, req *dto.GenerateTranslationAIRequest) (*dto.GenerateTranslationAIResponse, error)
+	TranslateStoredCurriculum(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.TranslateCurriculumRequest) (*dto.CurriculumResponse, error)
 }
 
 // generateTranslationAIUseCase implements GenerateTranslationAIUseCase interface
 type generateTranslationAIUseCase struct {
-	openaiClient *openai.Client
+	openaiClient   *openai.Client
+	curriculumRepo repositories.CurriculumRepository
+	statsRepo      repositories.CurriculumCreationStatsRepository
+	logger         *zap.Logger
+}
+
+// translationSegment is one whitelisted text field of a stored curriculum.
+// apply writes the translation back into the copied curriculum.
+type translationSegment struct {
+	ID    string
+	Text  string
+	apply func(string)
+}
+
+// translatedSegment is one item of the strict JSON the model returns
+type translatedSegment struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// translatedSegments is the strict JSON the model returns for one chunk
+type translatedSegments struct {
+	Segments []translatedSegment `json:"segments"`
 }
 
-// NewGenerateIntroAIUseCase creates a new instance of GenerateTranslationAIUseCase
-func NewGenerateTranslationAIUseCase(apiKey string) (GenerateTranslationAIUseCase, error) {
+// translatedSegmentsSchema is the JSON schema of translatedSegments
+var translatedSegmentsSchema = structuredObjectSchema(map[string]any{
+	"segments": map[string]any{
+		"type": "array",
+		"items": structuredObjectSchema(map[string]any{
+			"id":   map[string]any{"type": "string"},
+			"text": map[string]any{"type": "string"},
+		}),
+	},
+})
+
+// NewGenerateTranslationAIUseCase creates a new instance of GenerateTranslationAIUseCase
+func NewGenerateTranslationAIUseCase(apiKey string, curriculumRepo repositories.CurriculumRepository, statsRepo repositories.CurriculumCreationStatsRepository, logger *zap.Logger) (GenerateTranslationAIUseCase, error) {
 	if apiKey == "" {
 		return nil, errors.NewAppError("OPENAI_API_KEY environment variable is required")
 	}
@@ -32,7 +77,10 @@ func NewGenerateTranslationAIUseCase(apiKey string) (GenerateTranslationAIUseCas
 	client := openai.NewClient(option.WithAPIKey(apiKey))
 
 	return &generateTranslationAIUseCase{
-		openaiClient: &client,
+		openaiClient:   &client,
+		curriculumRepo: curriculumRepo,
+		statsRepo:      statsRepo,
+		logger:         logger,
 	}, nil
 }
 
@@ -45,7 +93,7 @@ func (uc *generateTranslationAIUseCase) TranslateCurriculum(ctx context.Context,
 	}
 
 	// Get target language name
-	targetLanguage := uc.getLanguageName(req.TargetLanguage)
+	targetLanguage := locale.Resolve(req.TargetLanguage).PromptName
 
 	// Prepare the prompt for translation
 	prompt := fmt.Sprintf(`
@@ -102,16 +150,158 @@ Rules:
 	}, nil
 }
 
-// getLanguageName returns the full language name for the given code
-func (uc *generateTranslationAIUseCase) getLanguageName(langCode string) string {
-	switch langCode {
-	case "pt":
-		return "Portuguese"
-	case "en":
-		return "English"
-	case "es":
-		return "Spanish"
-	default:
-		return "Portuguese"
+// TranslateStoredCurriculum saves a translated copy of the curriculum, linked to its source.
+// Only the intro, work positions and descriptions, and education degrees and descriptions are
+// sent to the model, in chunks; names, companies, institutions, contacts and dates are copied
+// in Go. A field the model leaves out keeps its original text.
+func (uc *generateTranslationAIUseCase) TranslateStoredCurriculum(ctx context.Context, userID, curriculumID uuid.UUID, req *dto.TranslateCurriculumRequest) (*dto.CurriculumResponse, error) {
+	source, err := uc.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if source.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	translated := cloneCurriculum(source)
+	segments := curriculumTranslationSegments(translated)
+	targetLanguage := locale.Resolve(req.TargetLanguage).PromptName
+
+	missing := 0
+	for _, chunk := range chunkTranslationSegments(segments, translationChunkChars) {
+		texts, err := uc.translateSegments(ctx, chunk, targetLanguage)
+		if err != nil {
+			return nil, err
+		}
+		for _, segment := range chunk {
+			text := strings.TrimSpace(texts[segment.ID])
+			if text == "" {
+				missing++
+				continue
+			}
+			segment.apply(text)
+		}
+	}
+
+	if err := uc.curriculumRepo.Create(ctx, translated); err != nil {
+		return nil, err
+	}
+
+	if err := uc.statsRepo.IncrementCreationCount(ctx, userID); err != nil {
+		uc.logger.Warn("Failed to increment curriculum creation count; translated curriculum was created",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+	}
+
+	uc.logger.Info("Translated curriculum created",
+		zap.String("source_curriculum_id", source.ID.String()),
+		zap.String("curriculum_id", translated.ID.String()),
+		zap.String("target_language", req.TargetLanguage),
+		zap.Int("segments", len(segments)),
+		zap.Int("untranslated_segments", missing),
+	)
+
+	resp := toCurriculumResponse(translated)
+	return &resp, nil
+}
+
+// translateSegments translates one chunk and returns the translations by segment ID
+func (uc *generateTranslationAIUseCase) translateSegments(ctx context.Context, chunk []translationSegment, targetLanguage string) (map[string]string, error) {
+	input := translatedSegments{Segments: make([]translatedSegment, 0, len(chunk))}
+	for _, segment := range chunk {
+		input.Segments = append(input.Segments, translatedSegment{ID: segment.ID, Text: segment.Text})
+	}
+	inputJSON, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to marshal curriculum segments")
+	}
+
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(`You are a professional translator specialized in curriculum vitae. You translate each segment's "text" and return every segment with its "id" unchanged. Keep the meaning, tone and line breaks; keep product, tool and technology names as they are. Never add or drop information.`),
+			openai.UserMessage(fmt.Sprintf("Translate every segment to %s:\n\n%s", targetLanguage, string(inputJSON))),
+		},
+		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   "curriculum_translation",
+					Strict: openai.Bool(true),
+					Schema: translatedSegmentsSchema,
+				},
+			},
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 4000))),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.3)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := uc.openaiClient.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return nil, errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, errors.NewAppError("no response from OpenAI")
+	}
+	if refusal := resp.Choices[0].Message.Refusal; refusal != "" {
+		return nil, errors.NewAppError("OpenAI refused the request: " + refusal)
+	}
+
+	var output translatedSegments
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), &output); err != nil {
+		return nil, errors.WrapError(err, "failed to parse translated segments")
+	}
+
+	texts := make(map[string]string, len(output.Segments))
+	for _, segment := range output.Segments {
+		texts[segment.ID] = segment.Text
+	}
+	return texts, nil
+}
+
+// curriculumTranslationSegments lists the whitelisted, non-empty text fields of the curriculum
+func curriculumTranslationSegments(curriculum *models.Curriculums) []translationSegment {
+	var segments []translationSegment
+	add := func(id string, field *string) {
+		if strings.TrimSpace(*field) == "" {
+			return
+		}
+		segments = append(segments, translationSegment{
+			ID:    id,
+			Text:  *field,
+			apply: func(text string) { *field = text },
+		})
+	}
+
+	add("intro", &curriculum.Intro)
+	for i := range curriculum.Works {
+		add(fmt.Sprintf("works.%d.position", i), &curriculum.Works[i].Position)
+		add(fmt.Sprintf("works.%d.description", i), &curriculum.Works[i].Description)
+	}
+	for i := range curriculum.Educations {
+		add(fmt.Sprintf("educations.%d.degree", i), &curriculum.Educations[i].Degree)
+		add(fmt.Sprintf("educations.%d.description", i), &curriculum.Educations[i].Description)
+	}
+	return segments
+}
+
+// chunkTranslationSegments groups segments in order so each chunk stays under maxChars
+func chunkTranslationSegments(segments []translationSegment, maxChars int) [][]translationSegment {
+	var chunks [][]translationSegment
+	var current []translationSegment
+	size := 0
+	for _, segment := range segments {
+		if len(current) > 0 && size+len(segment.Text) > maxChars {
+			chunks = append(chunks, current)
+			current, size = nil, 0
+		}
+		current = append(current, segment)
+		size += len(segment.Text)
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
 	}
+	return chunks
 }
@@ -0,0 +1,195 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidCurriculumID is returned when the curriculum_id of a generation request is not a UUID
+var ErrInvalidCurriculumID = errors.New("invalid curriculum_id format")
+
+// generationContext is the background added to the intro and task prompts when the
+// request names one of the user's curriculums
+type generationContext struct {
+	CurriculumBody  string
+	LatestPosition  string
+	ExperienceYears int
+	Seniority       string
+	// Language is the prompt name of the language to write in: the one of the request
+	// when given, otherwise the configured one
+	Language string
+}
+
+// curriculumContextSources are the dependencies needed to build a generationContext
+type curriculumContextSources struct {
+	curriculumRepo    repositories.CurriculumRepository
+	curriculumUseCase CurriculumUseCase
+	configurationRepo repositories.ConfigurationRepository
+}
+
+// load returns nil when rawCurriculumID is empty. A curriculum of another user is
+// reported as not found (gorm.ErrRecordNotFound, wrapped). requestLanguage, when set,
+// takes precedence over the configured language.
+func (s curriculumContextSources) load(ctx context.Context, userID uuid.UUID, rawCurriculumID, requestLanguage string) (*generationContext, error) {
+	if rawCurriculumID == "" {
+		return nil, nil
+	}
+	curriculumID, err := uuid.Parse(rawCurriculumID)
+	if err != nil {
+		return nil, ErrInvalidCurriculumID
+	}
+
+	curriculum, err := s.curriculumRepo.GetByID(ctx, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+	if curriculum.UserID != userID {
+		return nil, fmt.Errorf("curriculum %s: %w", curriculumID.String(), gorm.ErrRecordNotFound)
+	}
+
+	body, err := s.curriculumUseCase.GetCurriculumBody(ctx, curriculum.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get curriculum body: %w", err)
+	}
+
+	genCtx := &generationContext{CurriculumBody: body.Body}
+	if work := latestWork(curriculum.Works); work != nil {
+		genCtx.LatestPosition = work.Position
+	}
+	genCtx.ExperienceYears = experienceYears(curriculum.Works, time.Now())
+	genCtx.Seniority = seniorityLevel(genCtx.ExperienceYears, len(curriculum.Works))
+
+	if language, ok := locale.Lookup(requestLanguage); ok {
+		genCtx.Language = language.PromptName
+		return genCtx, nil
+	}
+
+	configuration, err := s.configurationRepo.GetByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if configuration != nil {
+		if language, ok := locale.Lookup(configuration.Language); ok {
+			genCtx.Language = language.PromptName
+		}
+	}
+
+	return genCtx, nil
+}
+
+// message is the system message that grounds the generator in the curriculum. Its
+// language overrides the "answer in the input language" rule of the system prompt.
+func (g *generationContext) message() openai.ChatCompletionMessageParamUnion {
+	var b strings.Builder
+	b.WriteString("CANDIDATE CONTEXT: the output is for the person whose curriculum is below. Keep it consistent with their actual history and seniority, and never add employers, tools, dates or numbers that the curriculum does not support.\n\n")
+	if g.Seniority != "" {
+		fmt.Fprintf(&b, "Seniority: %s (about %d years of professional experience)\n", g.Seniority, g.ExperienceYears)
+	}
+	if g.LatestPosition != "" {
+		fmt.Fprintf(&b, "Most recent position: %s\n", g.LatestPosition)
+	}
+	if g.Language != "" {
+		fmt.Fprintf(&b, "LANGUAGE OVERRIDE: write the output in %s, even if the input is in another language.\n", g.Language)
+	}
+	fmt.Fprintf(&b, "\nCurriculum:\n%s", g.CurriculumBody)
+	return openai.SystemMessage(b.String())
+}
+
+// withGenerationContext appends the context message, if any, after the system prompt.
+func withGenerationContext(messages []openai.ChatCompletionMessageParamUnion, genCtx *generationContext) []openai.ChatCompletionMessageParamUnion {
+	if genCtx == nil {
+		return messages
+	}
+	withCtx := make([]openai.ChatCompletionMessageParamUnion, 0, len(messages)+1)
+	withCtx = append(withCtx, messages[0], genCtx.message())
+	return append(withCtx, messages[1:]...)
+}
+
+// latestWork returns the current work (no end date), or the one that started last when
+// every work has ended. Works are stored in the user's sort order, not by date. Among
+// several current works the one that started last wins.
+func latestWork(works []models.Work) *models.Work {
+	var latest *models.Work
+	for i := range works {
+		work := &works[i]
+		if latest == nil {
+			latest = work
+			continue
+		}
+		current, latestCurrent := work.EndDate == nil, latest.EndDate == nil
+		if current != latestCurrent {
+			if current {
+				latest = work
+			}
+			continue
+		}
+		if work.StartDate.After(latest.StartDate) {
+			latest = work
+		}
+	}
+	return latest
+}
+
+// experienceYears counts the whole years covered by the works, without counting
+// overlapping periods twice. Current works run until now.
+func experienceYears(works []models.Work, now time.Time) int {
+	type period struct{ start, end time.Time }
+	periods := make([]period, 0, len(works))
+	for _, work := range works {
+		end := now
+		if work.EndDate != nil {
+			end = *work.EndDate
+		}
+		if end.After(work.StartDate) {
+			periods = append(periods, period{start: work.StartDate, end: end})
+		}
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].start.Before(periods[j].start) })
+
+	var total time.Duration
+	var current *period
+	for i := range periods {
+		p := periods[i]
+		if current != nil && !p.start.After(current.end) {
+			if p.end.After(current.end) {
+				current.end = p.end
+			}
+			continue
+		}
+		if current != nil {
+			total += current.end.Sub(current.start)
+		}
+		current = &p
+	}
+	if current != nil {
+		total += current.end.Sub(current.start)
+	}
+	return int(total.Hours() / 24 / 365)
+}
+
+// seniorityLevel is a rough level from years of experience; empty without works
+func seniorityLevel(years, works int) string {
+	switch {
+	case works == 0:
+		return ""
+	case years < 2:
+		return "junior"
+	case years < 5:
+		return "mid-level"
+	case years < 10:
+		return "senior"
+	default:
+		return "lead / principal"
+	}
+}
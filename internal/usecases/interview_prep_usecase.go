@@ -0,0 +1,145 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// InterviewPrepUseCase manages stored interview practice sets. Sets are created by
+// GenerateInterviewPrepAIUseCase.
+type InterviewPrepUseCase interface {
+	ListPracticeSets(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]dto.InterviewPracticeSetResponse, error)
+	GetPracticeSet(ctx context.Context, userID, practiceSetID uuid.UUID) (*dto.InterviewPracticeSetResponse, error)
+	UpdateQuestion(ctx context.Context, userID, practiceSetID, questionID uuid.UUID, req *dto.UpdateInterviewQuestionRequest) (*dto.InterviewQuestionResponse, error)
+	DeletePracticeSet(ctx context.Context, userID, practiceSetID uuid.UUID) error
+}
+
+type interviewPrepUseCase struct {
+	interviewPrepRepo repositories.InterviewPrepRepository
+	logger            *zap.Logger
+}
+
+// NewInterviewPrepUseCase creates a new instance of InterviewPrepUseCase.
+func NewInterviewPrepUseCase(interviewPrepRepo repositories.InterviewPrepRepository, logger *zap.Logger) InterviewPrepUseCase {
+	return &interviewPrepUseCase{
+		interviewPrepRepo: interviewPrepRepo,
+		logger:            logger,
+	}
+}
+
+func (uc *interviewPrepUseCase) ListPracticeSets(ctx context.Context, userID uuid.UUID, curriculumID *uuid.UUID) ([]dto.InterviewPracticeSetResponse, error) {
+	sets, err := uc.interviewPrepRepo.ListByUser(ctx, userID, curriculumID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]dto.InterviewPracticeSetResponse, 0, len(sets))
+	for i := range sets {
+		resp = append(resp, toInterviewPracticeSetResponse(&sets[i]))
+	}
+	return resp, nil
+}
+
+func (uc *interviewPrepUseCase) GetPracticeSet(ctx context.Context, userID, practiceSetID uuid.UUID) (*dto.InterviewPracticeSetResponse, error) {
+	set, err := uc.getOwnedPracticeSet(ctx, userID, practiceSetID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toInterviewPracticeSetResponse(set)
+	return &resp, nil
+}
+
+// UpdateQuestion saves the user's notes and practiced flag for one question of the set.
+func (uc *interviewPrepUseCase) UpdateQuestion(ctx context.Context, userID, practiceSetID, questionID uuid.UUID, req *dto.UpdateInterviewQuestionRequest) (*dto.InterviewQuestionResponse, error) {
+	set, err := uc.getOwnedPracticeSet(ctx, userID, practiceSetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var question *models.InterviewQuestion
+	for i := range set.Questions {
+		if set.Questions[i].ID == questionID {
+			question = &set.Questions[i]
+			break
+		}
+	}
+	if question == nil {
+		return nil, fmt.Errorf("interview question %s: %w", questionID.String(), gorm.ErrRecordNotFound)
+	}
+
+	question.Notes = req.Notes
+	question.Practiced = req.Practiced
+	if err := uc.interviewPrepRepo.UpdateQuestion(ctx, question); err != nil {
+		return nil, err
+	}
+
+	resp := toInterviewQuestionResponse(question)
+	return &resp, nil
+}
+
+func (uc *interviewPrepUseCase) DeletePracticeSet(ctx context.Context, userID, practiceSetID uuid.UUID) error {
+	set, err := uc.getOwnedPracticeSet(ctx, userID, practiceSetID)
+	if err != nil {
+		return err
+	}
+	return uc.interviewPrepRepo.Delete(ctx, set.ID)
+}
+
+// getOwnedPracticeSet returns gorm.ErrRecordNotFound (wrapped) when the set belongs to another user.
+func (uc *interviewPrepUseCase) getOwnedPracticeSet(ctx context.Context, userID, practiceSetID uuid.UUID) (*models.InterviewPracticeSet, error) {
+	set, err := uc.interviewPrepRepo.GetByID(ctx, practiceSetID)
+	if err != nil {
+		return nil, err
+	}
+	if set.UserID != userID {
+		return nil, fmt.Errorf("interview practice set %s: %w", practiceSetID.String(), gorm.ErrRecordNotFound)
+	}
+	return set, nil
+}
+
+func toInterviewPracticeSetResponse(set *models.InterviewPracticeSet) dto.InterviewPracticeSetResponse {
+	resp := dto.InterviewPracticeSetResponse{
+		ID:             set.ID,
+		CurriculumID:   set.CurriculumID,
+		ApplicationID:  set.ApplicationID,
+		Title:          set.Title,
+		Company:        set.Company,
+		Position:       set.Position,
+		JobDescription: set.JobDescription,
+		Language:       set.Language,
+		Questions:      make([]dto.InterviewQuestionResponse, 0, len(set.Questions)),
+		CreatedAt:      set.CreatedAt,
+	}
+	for i := range set.Questions {
+		if set.Questions[i].Practiced {
+			resp.PracticedCount++
+		}
+		resp.Questions = append(resp.Questions, toInterviewQuestionResponse(&set.Questions[i]))
+	}
+	return resp
+}
+
+func toInterviewQuestionResponse(question *models.InterviewQuestion) dto.InterviewQuestionResponse {
+	return dto.InterviewQuestionResponse{
+		ID:       question.ID,
+		Category: string(question.Category),
+		Question: question.Question,
+		WorkID:   question.WorkID,
+		Answer: dto.STARAnswerResponse{
+			Situation: question.Situation,
+			Task:      question.Task,
+			Action:    question.Action,
+			Result:    question.Result,
+		},
+		Notes:     question.Notes,
+		Practiced: question.Practiced,
+	}
+}
@@ -0,0 +1,190 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/linkedin"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ErrInvalidLinkedInExport is returned when the uploaded file is not a readable LinkedIn data export.
+var ErrInvalidLinkedInExport = errors.New("invalid LinkedIn data export")
+
+// maxPhoneLength matches the size of Curriculums.Phone.
+const maxPhoneLength = 20
+
+// LinkedInImportUseCase creates draft curriculums from LinkedIn data exports
+type LinkedInImportUseCase interface {
+	ImportLinkedIn(ctx context.Context, userID uuid.UUID, archive []byte) (*dto.LinkedInImportResponse, error)
+}
+
+type linkedInImportUseCase struct {
+	curriculumRepo repositories.CurriculumRepository
+	statsRepo      repositories.CurriculumCreationStatsRepository
+	userRepo       repositories.UserRepository
+	logger         *zap.Logger
+}
+
+// NewLinkedInImportUseCase creates a new instance of LinkedInImportUseCase.
+func NewLinkedInImportUseCase(curriculumRepo repositories.CurriculumRepository, statsRepo repositories.CurriculumCreationStatsRepository, userRepo repositories.UserRepository, logger *zap.Logger) LinkedInImportUseCase {
+	return &linkedInImportUseCase{
+		curriculumRepo: curriculumRepo,
+		statsRepo:      statsRepo,
+		userRepo:       userRepo,
+		logger:         logger,
+	}
+}
+
+// ImportLinkedIn parses the export ZIP and stores a draft curriculum with the works and
+// educations that could be mapped. Name and email fall back to the user's account when the
+// export does not include them.
+func (uc *linkedInImportUseCase) ImportLinkedIn(ctx context.Context, userID uuid.UUID, archive []byte) (*dto.LinkedInImportResponse, error) {
+	export, err := linkedin.Parse(archive)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLinkedInExport, err.Error())
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculum := curriculumFromLinkedIn(export)
+	curriculum.UserID = userID
+	curriculum.Status = models.CurriculumStatusDraft
+	if curriculum.FullName == "" {
+		curriculum.FullName = user.Name
+	}
+	if curriculum.Email == "" {
+		curriculum.Email = user.Email
+	}
+
+	if err := uc.curriculumRepo.Create(ctx, curriculum); err != nil {
+		return nil, err
+	}
+
+	if err := uc.statsRepo.IncrementCreationCount(ctx, userID); err != nil {
+		uc.logger.Warn("Failed to increment curriculum creation count; imported curriculum was created",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+	}
+
+	uc.logger.Info("Curriculum imported from LinkedIn",
+		zap.String("curriculum_id", curriculum.ID.String()),
+		zap.Int("works", len(curriculum.Works)),
+		zap.Int("educations", len(curriculum.Educations)),
+		zap.Int("skipped_rows", len(export.Issues)),
+	)
+
+	resp := &dto.LinkedInImportResponse{
+		Curriculum:         toCurriculumResponse(curriculum),
+		FilesRead:          export.Files,
+		ImportedWorks:      len(curriculum.Works),
+		ImportedEducations: len(curriculum.Educations),
+		ImportedSkills:     len(export.Skills),
+		ImportedLanguages:  len(export.Languages),
+		MissingFields:      missingCurriculumFields(curriculum),
+		Skipped:            make([]dto.LinkedInImportIssue, 0, len(export.Issues)),
+	}
+	for _, issue := range export.Issues {
+		resp.Skipped = append(resp.Skipped, dto.LinkedInImportIssue{
+			File:   issue.File,
+			Row:    issue.Row,
+			Reason: issue.Reason,
+		})
+	}
+	return resp, nil
+}
+
+// curriculumFromLinkedIn maps the export onto a curriculum. Works keep the export order,
+// which LinkedIn sorts most recent first.
+func curriculumFromLinkedIn(export *linkedin.Export) *models.Curriculums {
+	intro := export.Profile.Summary
+	if intro == "" {
+		intro = export.Profile.Headline
+	}
+
+	languages := make([]string, 0, len(export.Languages))
+	for _, language := range export.Languages {
+		if language.Proficiency != "" {
+			languages = append(languages, fmt.Sprintf("%s (%s)", language.Name, language.Proficiency))
+		} else {
+			languages = append(languages, language.Name)
+		}
+	}
+
+	phone := export.Phone
+	if runes := []rune(phone); len(runes) > maxPhoneLength {
+		phone = string(runes[:maxPhoneLength])
+	}
+
+	curriculum := &models.Curriculums{
+		FullName:    export.Profile.FullName(),
+		Email:       export.Email,
+		Phone:       phone,
+		Intro:       intro,
+		Skills:      strings.Join(export.Skills, ", "),
+		Languages:   strings.Join(languages, ", "),
+		SocialLinks: strings.Join(export.Profile.Websites, ", "),
+	}
+
+	for i, position := range export.Positions {
+		curriculum.Works = append(curriculum.Works, models.Work{
+			Position:    position.Title,
+			Company:     position.Company,
+			Description: position.Description,
+			StartDate:   position.StartedOn,
+			EndDate:     position.FinishedOn,
+			SortOrder:   i,
+		})
+	}
+
+	for _, education := range export.Educations {
+		curriculum.Educations = append(curriculum.Educations, models.Education{
+			Institution: education.School,
+			Degree:      education.Degree,
+			StartDate:   education.StartDate,
+			EndDate:     education.EndDate,
+			Description: education.Notes,
+		})
+	}
+
+	return curriculum
+}
+
+// missingCurriculumFields lists the required curriculum fields that are still empty,
+// including educations imported without a degree (educations.<index>.degree).
+func missingCurriculumFields(curriculum *models.Curriculums) []string {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"full_name", curriculum.FullName},
+		{"email", curriculum.Email},
+		{"phone", curriculum.Phone},
+		{"intro", curriculum.Intro},
+		{"skills", curriculum.Skills},
+		{"languages", curriculum.Languages},
+	}
+
+	missing := make([]string, 0)
+	for _, field := range fields {
+		if strings.TrimSpace(field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	for i, education := range curriculum.Educations {
+		if strings.TrimSpace(education.Degree) == "" {
+			missing = append(missing, fmt.Sprintf("educations.%d.degree", i))
+		}
+	}
+	return missing
+}
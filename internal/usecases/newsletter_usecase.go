@@ -0,0 +1,442 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/newsletter"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrNewsletterUnavailable is returned when emails or newsletter links are not configured.
+	ErrNewsletterUnavailable = errors.New("newsletter is not configured")
+	// ErrInvalidNewsletterToken is returned for forged, expired or replaced links.
+	ErrInvalidNewsletterToken = errors.New("invalid or expired newsletter link")
+	// ErrNewsletterCampaignNotDraft is returned when sending a campaign that was already sent.
+	ErrNewsletterCampaignNotDraft = errors.New("newsletter campaign was already sent")
+)
+
+// Paths of the public newsletter links, relative to NewsletterConfig.LinkBaseURL.
+const (
+	newsletterConfirmPath     = "/api/v1/newsletter/confirm"
+	newsletterUnsubscribePath = "/api/v1/newsletter/unsubscribe"
+)
+
+// NewsletterUseCase defines the interface for newsletter subscriptions and campaigns
+type NewsletterUseCase interface {
+	GetSubscription(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error)
+	Subscribe(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error)
+	Confirm(ctx context.Context, token string) (*dto.NewsletterSubscriptionResponse, error)
+	UnsubscribeWithToken(ctx context.Context, token string) error
+	CreateCampaign(ctx context.Context, adminID uuid.UUID, req *dto.CreateNewsletterCampaignRequest) (*dto.NewsletterCampaignResponse, error)
+	ListCampaigns(ctx context.Context) ([]dto.NewsletterCampaignResponse, error)
+	GetCampaign(ctx context.Context, id uuid.UUID) (*dto.NewsletterCampaignResponse, error)
+	SendCampaign(ctx context.Context, id uuid.UUID) (*dto.NewsletterCampaignResponse, error)
+	// SendCampaignBatches sends the next batch of every campaign being sent and returns how
+	// many emails were sent.
+	SendCampaignBatches(ctx context.Context) (int, error)
+	// SyncQueuedDeliveries records the final status of campaign emails retried by the email
+	// outbox and returns how many deliveries were updated.
+	SyncQueuedDeliveries(ctx context.Context) (int, error)
+}
+
+type newsletterUseCase struct {
+	newsletterRepo repositories.NewsletterRepository
+	userRepo       repositories.UserRepository
+	emailUseCase   EmailUseCase
+	cacheService   *cache.CacheService
+	signer         *newsletter.Signer
+	cfg            config.NewsletterConfig
+	logger         *zap.Logger
+	now            func() time.Time
+}
+
+// NewNewsletterUseCase creates a new instance of NewsletterUseCase.
+// emailUseCase may be nil, in which case subscribing and sending campaigns are unavailable.
+func NewNewsletterUseCase(
+	newsletterRepo repositories.NewsletterRepository,
+	userRepo repositories.UserRepository,
+	emailUseCase EmailUseCase,
+	cacheService *cache.CacheService,
+	cfg config.NewsletterConfig,
+	logger *zap.Logger,
+) NewsletterUseCase {
+	return &newsletterUseCase{
+		newsletterRepo: newsletterRepo,
+		userRepo:       userRepo,
+		emailUseCase:   emailUseCase,
+		cacheService:   cacheService,
+		signer:         newsletter.NewSigner(cfg.SigningSecret),
+		cfg:            cfg,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// GetSubscription returns the newsletter subscription of a user
+func (uc *newsletterUseCase) GetSubscription(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error) {
+	configuration, err := uc.getConfiguration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return newsletterSubscriptionToResponse(configuration), nil
+}
+
+// Subscribe starts the double opt-in: the subscription stays pending until the user opens the
+// link of the confirmation email. Calling it again while pending sends a new link, which
+// replaces the previous one.
+func (uc *newsletterUseCase) Subscribe(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error) {
+	user, err := uc.userRepo.GetByIDWithConfiguration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Configuration == nil {
+		return nil, fmt.Errorf("configuration of user %s: %w", userID.String(), gorm.ErrRecordNotFound)
+	}
+	if user.Configuration.NewsletterStatus() == models.NewsletterStatusSubscribed {
+		return newsletterSubscriptionToResponse(user.Configuration), nil
+	}
+	if !uc.available() {
+		return nil, ErrNewsletterUnavailable
+	}
+
+	// Tokens carry the request time in whole seconds, so it is stored without fractions
+	requestedAt := uc.now().Truncate(time.Second)
+	if err := uc.newsletterRepo.RequestSubscription(ctx, userID, requestedAt); err != nil {
+		return nil, err
+	}
+	uc.invalidateConfigurationCache(ctx, userID)
+
+	token := uc.signer.Sign(newsletter.Claims{
+		Purpose:  newsletter.PurposeConfirm,
+		UserID:   userID,
+		IssuedAt: requestedAt,
+	})
+	if err := uc.emailUseCase.SendNewsletterConfirmationEmail(emailRecipientOf(user), uc.link(newsletterConfirmPath, token), uc.cfg.ConfirmationTTL); err != nil {
+		return nil, err
+	}
+
+	user.Configuration.Newsletter = true
+	user.Configuration.NewsletterRequestedAt = &requestedAt
+	user.Configuration.NewsletterConfirmedAt = nil
+	return newsletterSubscriptionToResponse(user.Configuration), nil
+}
+
+// Unsubscribe opts the user out of the newsletter, confirmed or not
+func (uc *newsletterUseCase) Unsubscribe(ctx context.Context, userID uuid.UUID) (*dto.NewsletterSubscriptionResponse, error) {
+	if _, err := uc.newsletterRepo.Unsubscribe(ctx, userID, uc.now()); err != nil {
+		return nil, err
+	}
+	uc.invalidateConfigurationCache(ctx, userID)
+	return uc.GetSubscription(ctx, userID)
+}
+
+// Confirm completes the double opt-in with the token of a confirmation email. Opening the
+// link of an already confirmed subscription again is not an error.
+func (uc *newsletterUseCase) Confirm(ctx context.Context, token string) (*dto.NewsletterSubscriptionResponse, error) {
+	claims, err := uc.signer.Verify(token, newsletter.PurposeConfirm)
+	if err != nil || uc.now().After(claims.IssuedAt.Add(uc.cfg.ConfirmationTTL)) {
+		return nil, ErrInvalidNewsletterToken
+	}
+
+	confirmed, err := uc.newsletterRepo.ConfirmSubscription(ctx, claims.UserID, claims.IssuedAt, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if confirmed {
+		uc.invalidateConfigurationCache(ctx, claims.UserID)
+		uc.logger.Info("Newsletter subscription confirmed", zap.String("user_id", claims.UserID.String()))
+	}
+
+	configuration, err := uc.getConfiguration(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidNewsletterToken
+		}
+		return nil, err
+	}
+	if !confirmed && (configuration.NewsletterStatus() != models.NewsletterStatusSubscribed ||
+		configuration.NewsletterRequestedAt == nil || !configuration.NewsletterRequestedAt.Equal(claims.IssuedAt)) {
+		return nil, ErrInvalidNewsletterToken
+	}
+	return newsletterSubscriptionToResponse(configuration), nil
+}
+
+// UnsubscribeWithToken opts out the user of an unsubscribe link. It succeeds for users who are
+// already unsubscribed, so the link can be opened any number of times.
+func (uc *newsletterUseCase) UnsubscribeWithToken(ctx context.Context, token string) error {
+	claims, err := uc.signer.Verify(token, newsletter.PurposeUnsubscribe)
+	if err != nil {
+		return ErrInvalidNewsletterToken
+	}
+
+	now := uc.now()
+	unsubscribed, err := uc.newsletterRepo.Unsubscribe(ctx, claims.UserID, now)
+	if err != nil {
+		return err
+	}
+	if !unsubscribed {
+		return nil
+	}
+	uc.invalidateConfigurationCache(ctx, claims.UserID)
+
+	if claims.CampaignID != uuid.Nil {
+		if err := uc.newsletterRepo.MarkDeliveryUnsubscribed(ctx, claims.CampaignID, claims.UserID, now); err != nil {
+			uc.logger.Warn("Failed to track newsletter unsubscribe", zap.String("campaign_id", claims.CampaignID.String()), zap.Error(err))
+		}
+	}
+	uc.logger.Info("Newsletter unsubscribed through link",
+		zap.String("user_id", claims.UserID.String()),
+		zap.String("campaign_id", claims.CampaignID.String()),
+	)
+	return nil
+}
+
+// CreateCampaign stores a draft campaign; it is mailed by SendCampaign
+func (uc *newsletterUseCase) CreateCampaign(ctx context.Context, adminID uuid.UUID, req *dto.CreateNewsletterCampaignRequest) (*dto.NewsletterCampaignResponse, error) {
+	campaign := &models.NewsletterCampaign{
+		Subject:     strings.TrimSpace(req.Subject),
+		HTMLBody:    req.HTMLBody,
+		TextBody:    req.TextBody,
+		Status:      models.NewsletterCampaignStatusDraft,
+		CreatedByID: adminID,
+	}
+	if req.Language != "" {
+		campaign.Language = locale.Resolve(req.Language).Tag
+	}
+
+	if err := uc.newsletterRepo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	resp := newsletterCampaignToResponse(campaign, repositories.NewsletterCampaignStats{})
+	return &resp, nil
+}
+
+// ListCampaigns returns every campaign with its delivery counts, newest first
+func (uc *newsletterUseCase) ListCampaigns(ctx context.Context) ([]dto.NewsletterCampaignResponse, error) {
+	campaigns, err := uc.newsletterRepo.ListCampaigns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(campaigns))
+	for i := range campaigns {
+		ids[i] = campaigns[i].ID
+	}
+	stats, err := uc.newsletterRepo.GetCampaignStats(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.NewsletterCampaignResponse, len(campaigns))
+	for i := range campaigns {
+		responses[i] = newsletterCampaignToResponse(&campaigns[i], stats[campaigns[i].ID])
+	}
+	return responses, nil
+}
+
+// GetCampaign returns a campaign with its delivery counts
+func (uc *newsletterUseCase) GetCampaign(ctx context.Context, id uuid.UUID) (*dto.NewsletterCampaignResponse, error) {
+	campaign, err := uc.newsletterRepo.GetCampaignByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := uc.newsletterRepo.GetCampaignStats(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := newsletterCampaignToResponse(campaign, stats[id])
+	return &resp, nil
+}
+
+// SendCampaign starts sending a draft campaign. The emails are sent in batches by
+// SendCampaignBatches, so the campaign is returned in the sending status.
+func (uc *newsletterUseCase) SendCampaign(ctx context.Context, id uuid.UUID) (*dto.NewsletterCampaignResponse, error) {
+	if !uc.available() {
+		return nil, ErrNewsletterUnavailable
+	}
+	if _, err := uc.newsletterRepo.GetCampaignByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	started, err := uc.newsletterRepo.StartCampaign(ctx, id, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, ErrNewsletterCampaignNotDraft
+	}
+
+	uc.logger.Info("Newsletter campaign started", zap.String("campaign_id", id.String()))
+	return uc.GetCampaign(ctx, id)
+}
+
+// SendCampaignBatches sends up to the configured batch size of emails per campaign being sent.
+// A campaign without remaining recipients is marked as sent.
+func (uc *newsletterUseCase) SendCampaignBatches(ctx context.Context) (int, error) {
+	if !uc.available() {
+		return 0, nil
+	}
+
+	campaigns, err := uc.newsletterRepo.ListSendingCampaigns(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range campaigns {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		batchSent, err := uc.sendCampaignBatch(ctx, &campaigns[i])
+		sent += batchSent
+		if err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// SyncQueuedDeliveries marks queued deliveries sent or failed once the email outbox is done
+// with them.
+func (uc *newsletterUseCase) SyncQueuedDeliveries(ctx context.Context) (int, error) {
+	updated, err := uc.newsletterRepo.SyncQueuedDeliveries(ctx)
+	return int(updated), err
+}
+
+func (uc *newsletterUseCase) sendCampaignBatch(ctx context.Context, campaign *models.NewsletterCampaign) (int, error) {
+	recipients, err := uc.newsletterRepo.ListPendingRecipients(ctx, campaign, uc.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(recipients) == 0 {
+		if err := uc.newsletterRepo.CompleteCampaign(ctx, campaign.ID, uc.now()); err != nil {
+			return 0, err
+		}
+		uc.logger.Info("Newsletter campaign sent", zap.String("campaign_id", campaign.ID.String()))
+		return 0, nil
+	}
+
+	sent := 0
+	for i := range recipients {
+		user := &recipients[i]
+		delivery := &models.NewsletterDelivery{
+			CampaignID: campaign.ID,
+			UserID:     user.ID,
+			Status:     models.NewsletterDeliveryStatusPending,
+		}
+		claimed, err := uc.newsletterRepo.CreateDelivery(ctx, delivery)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		token := uc.signer.Sign(newsletter.Claims{
+			Purpose:    newsletter.PurposeUnsubscribe,
+			UserID:     user.ID,
+			CampaignID: campaign.ID,
+			IssuedAt:   uc.now(),
+		})
+		outboxEmailID, sendErr := uc.emailUseCase.SendNewsletterEmail(emailRecipientOf(user), campaign.Subject, campaign.HTMLBody, campaign.TextBody, uc.link(newsletterUnsubscribePath, token))
+
+		switch {
+		case sendErr != nil:
+			delivery.Status = models.NewsletterDeliveryStatusFailed
+			delivery.Error = sendErr.Error()
+		case outboxEmailID != uuid.Nil:
+			delivery.Status = models.NewsletterDeliveryStatusQueued
+			delivery.OutboxEmailID = &outboxEmailID
+		default:
+			sentAt := uc.now()
+			delivery.Status = models.NewsletterDeliveryStatusSent
+			delivery.SentAt = &sentAt
+			sent++
+		}
+		if err := uc.newsletterRepo.SaveDelivery(ctx, delivery); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// available reports whether confirmation and campaign emails can be sent with working links
+func (uc *newsletterUseCase) available() bool {
+	return uc.emailUseCase != nil && uc.cfg.LinkBaseURL != "" && uc.cfg.SigningSecret != ""
+}
+
+// link returns the public URL of a newsletter endpoint carrying token
+func (uc *newsletterUseCase) link(path, token string) string {
+	return uc.cfg.LinkBaseURL + path + "?token=" + url.QueryEscape(token)
+}
+
+func (uc *newsletterUseCase) getConfiguration(ctx context.Context, userID uuid.UUID) (*models.Configuration, error) {
+	user, err := uc.userRepo.GetByIDWithConfiguration(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Configuration == nil {
+		return nil, fmt.Errorf("configuration of user %s: %w", userID.String(), gorm.ErrRecordNotFound)
+	}
+	return user.Configuration, nil
+}
+
+// invalidateConfigurationCache drops the cached configuration, which shows the newsletter status
+func (uc *newsletterUseCase) invalidateConfigurationCache(ctx context.Context, userID uuid.UUID) {
+	if uc.cacheService == nil {
+		return
+	}
+	if err := uc.cacheService.Delete(ctx, cache.GenerateConfigurationCacheKey(userID.String())); err != nil {
+		uc.logger.Warn("Failed to invalidate configuration cache after newsletter change",
+			zap.Error(err),
+			zap.String("user_id", userID.String()))
+	}
+}
+
+func newsletterSubscriptionToResponse(configuration *models.Configuration) *dto.NewsletterSubscriptionResponse {
+	return &dto.NewsletterSubscriptionResponse{
+		Status:         string(configuration.NewsletterStatus()),
+		RequestedAt:    configuration.NewsletterRequestedAt,
+		ConfirmedAt:    configuration.NewsletterConfirmedAt,
+		UnsubscribedAt: configuration.NewsletterUnsubscribedAt,
+	}
+}
+
+func newsletterCampaignToResponse(campaign *models.NewsletterCampaign, stats repositories.NewsletterCampaignStats) dto.NewsletterCampaignResponse {
+	return dto.NewsletterCampaignResponse{
+		ID:          campaign.ID,
+		Subject:     campaign.Subject,
+		HTMLBody:    campaign.HTMLBody,
+		TextBody:    campaign.TextBody,
+		Language:    campaign.Language,
+		Status:      string(campaign.Status),
+		CreatedByID: campaign.CreatedByID,
+		StartedAt:   campaign.StartedAt,
+		CompletedAt: campaign.CompletedAt,
+		CreatedAt:   campaign.CreatedAt,
+		Stats: dto.NewsletterCampaignStatsResponse{
+			Recipients:   stats.Recipients,
+			Sent:         stats.Sent,
+			Queued:       stats.Queued,
+			Failed:       stats.Failed,
+			Unsubscribed: stats.Unsubscribed,
+		},
+	}
+}
@@ -0,0 +1,791 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/checkout/session"
+	"github.com/stripe/stripe-go/v81/customer"
+	stripesub "github.com/stripe/stripe-go/v81/subscription"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// organizationInvitationTTL is how long an invitation token can be accepted.
+const organizationInvitationTTL = 7 * 24 * time.Hour
+
+// ErrOrganizationForbidden is returned when the caller's role does not allow the operation.
+var ErrOrganizationForbidden = errors.New("insufficient organization role")
+
+// Organization business rule errors, reported to clients by their code.
+var (
+	ErrAlreadyInOrganization          = apperrors.NewCodedError(apperrors.CodeAlreadyInOrganization, "")
+	ErrInvalidOrganizationRole        = apperrors.NewCodedError(apperrors.CodeInvalidOrganizationRole, "")
+	ErrInvalidOrganizationPlan        = apperrors.NewCodedError(apperrors.CodeInvalidPlan, "")
+	ErrNoSeatsAvailable               = apperrors.NewCodedError(apperrors.CodeNoSeatsAvailable, "")
+	ErrSeatsBelowUsage                = apperrors.NewCodedError(apperrors.CodeSeatsBelowUsage, "")
+	ErrInvitationNotPending           = apperrors.NewCodedError(apperrors.CodeInvitationNotPending, "")
+	ErrInvitationEmailMismatch        = apperrors.NewCodedError(apperrors.CodeInvitationEmailMismatch, "")
+	ErrOrganizationOwnerImmutable     = apperrors.NewCodedError(apperrors.CodeOrganizationOwnerImmutable, "")
+	ErrOrganizationSubscriptionActive = apperrors.NewCodedError(apperrors.CodeOrganizationSubscriptionActive, "")
+	ErrNoOrganizationSubscription     = apperrors.NewCodedError(apperrors.CodeNoOrganizationSubscription, "")
+)
+
+// OrganizationUseCase manages organizations, their members and invitations, and
+// the seat-based subscription whose quota is pooled across members.
+type OrganizationUseCase interface {
+	CreateOrganization(ctx context.Context, userID uuid.UUID, req *dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error)
+	GetMyOrganization(ctx context.Context, userID uuid.UUID) (*dto.OrganizationResponse, error)
+	InviteMember(ctx context.Context, userID uuid.UUID, req *dto.InviteOrganizationMemberRequest) (*dto.OrganizationInvitationResponse, error)
+	RevokeInvitation(ctx context.Context, userID, invitationID uuid.UUID) error
+	AcceptInvitation(ctx context.Context, userID uuid.UUID, req *dto.AcceptOrganizationInvitationRequest) (*dto.OrganizationResponse, error)
+	UpdateMemberRole(ctx context.Context, userID, memberUserID uuid.UUID, req *dto.UpdateOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error)
+	RemoveMember(ctx context.Context, userID, memberUserID uuid.UUID) error
+	CreateCheckoutSession(ctx context.Context, userID uuid.UUID, req *dto.CreateOrganizationCheckoutRequest) (*dto.CreateCheckoutSessionResponse, error)
+	UpdateSeats(ctx context.Context, userID uuid.UUID, req *dto.UpdateOrganizationSeatsRequest) (*dto.OrganizationResponse, error)
+	GetEntitlement(ctx context.Context, userID uuid.UUID) (*Entitlement, error)
+	HandleCheckoutCompleted(ctx context.Context, s *stripe.CheckoutSession) error
+	SyncStripeSubscription(ctx context.Context, s *stripe.Subscription, deleted bool) (bool, error)
+	SyncStripeInvoice(ctx context.Context, inv *stripe.Invoice, paid bool) (bool, error)
+}
+
+type organizationUseCase struct {
+	organizationRepo repositories.OrganizationRepository
+	userRepo         repositories.UserRepository
+	prices           *priceResolver
+	stripeCfg        config.StripeConfig
+	policy           config.SubscriptionPolicy
+	appURL           string
+	logger           *zap.Logger
+	now              func() time.Time
+}
+
+// NewOrganizationUseCase creates a new instance of OrganizationUseCase.
+func NewOrganizationUseCase(
+	organizationRepo repositories.OrganizationRepository,
+	userRepo repositories.UserRepository,
+	planPriceRepo repositories.PlanPriceRepository,
+	stripeCfg config.StripeConfig,
+	policy config.SubscriptionPolicy,
+	appURL string,
+	logger *zap.Logger,
+) OrganizationUseCase {
+	return &organizationUseCase{
+		organizationRepo: organizationRepo,
+		userRepo:         userRepo,
+		prices:           newPriceResolver(planPriceRepo, stripeCfg.Prices, logger),
+		stripeCfg:        stripeCfg,
+		policy:           policy,
+		appURL:           strings.TrimRight(appURL, "/"),
+		logger:           logger,
+		now:              time.Now,
+	}
+}
+
+// CreateOrganization creates an organization owned by the caller. Seats are bought through checkout.
+func (uc *organizationUseCase) CreateOrganization(ctx context.Context, userID uuid.UUID, req *dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error) {
+	membership, err := uc.organizationRepo.GetMembershipByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if membership != nil {
+		return nil, ErrAlreadyInOrganization
+	}
+
+	organization := &models.Organization{
+		Name:        strings.TrimSpace(req.Name),
+		OwnerUserID: userID,
+		Plan:        models.SubscriptionPlanFree,
+		Status:      models.SubscriptionStatusIncomplete,
+		Seats:       1,
+	}
+	owner := &models.OrganizationMember{
+		UserID: userID,
+		Role:   models.OrganizationRoleOwner,
+	}
+	if err := uc.organizationRepo.CreateWithOwner(ctx, organization, owner); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Organization created",
+		zap.String("organization_id", organization.ID.String()),
+		zap.String("owner_user_id", userID.String()),
+	)
+
+	return uc.GetMyOrganization(ctx, userID)
+}
+
+// GetMyOrganization returns gorm.ErrRecordNotFound (wrapped) when the user is not in an organization.
+func (uc *organizationUseCase) GetMyOrganization(ctx context.Context, userID uuid.UUID) (*dto.OrganizationResponse, error) {
+	membership, err := uc.requireMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organization := &membership.Organization
+
+	members, err := uc.organizationRepo.ListMembers(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := uc.organizationRepo.ListPendingInvitations(ctx, organization.ID, uc.now())
+	if err != nil {
+		return nil, err
+	}
+
+	plan := models.SubscriptionPlanFree
+	if uc.isActive(organization) {
+		plan = organization.Plan
+	}
+
+	resp := &dto.OrganizationResponse{
+		ID:                organization.ID,
+		Name:              organization.Name,
+		Role:              string(membership.Role),
+		Plan:              string(plan),
+		Status:            string(organization.Status),
+		Seats:             organization.Seats,
+		SeatsUsed:         int64(len(members) + len(invitations)),
+		BillingInterval:   organization.BillingInterval,
+		Currency:          organization.Currency,
+		CurrentPeriodEnd:  organization.CurrentPeriodEnd,
+		CancelAtPeriodEnd: organization.CancelAtPeriodEnd,
+		Members:           make([]dto.OrganizationMemberResponse, 0, len(members)),
+	}
+	for i := range members {
+		resp.Members = append(resp.Members, toOrganizationMemberResponse(&members[i]))
+	}
+	if membership.Role.CanManageMembers() {
+		for i := range invitations {
+			resp.Invitations = append(resp.Invitations, toOrganizationInvitationResponse(&invitations[i]))
+		}
+	}
+
+	return resp, nil
+}
+
+// InviteMember reserves a seat for the email address until the invitation is accepted, revoked or expires.
+func (uc *organizationUseCase) InviteMember(ctx context.Context, userID uuid.UUID, req *dto.InviteOrganizationMemberRequest) (*dto.OrganizationInvitationResponse, error) {
+	membership, err := uc.requireManager(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organization := &membership.Organization
+
+	role := models.OrganizationRole(req.Role)
+	if role == "" {
+		role = models.OrganizationRoleMember
+	}
+	if role == models.OrganizationRoleOwner || !role.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOrganizationRole, req.Role)
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if invitee, err := uc.userRepo.GetByEmail(ctx, email); err == nil {
+		existing, err := uc.organizationRepo.GetMembershipByUserID(ctx, invitee.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, ErrAlreadyInOrganization
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	used, err := uc.seatsUsed(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	if used >= int64(organization.Seats) {
+		return nil, ErrNoSeatsAvailable
+	}
+
+	token, err := GenerateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate invitation token: %w", err)
+	}
+
+	invitation := &models.OrganizationInvitation{
+		OrganizationID:  organization.ID,
+		Email:           email,
+		Role:            role,
+		TokenHash:       hashInvitationToken(token),
+		InvitedByUserID: userID,
+		ExpiresAt:       uc.now().Add(organizationInvitationTTL),
+	}
+	if err := uc.organizationRepo.CreateInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	resp := toOrganizationInvitationResponse(invitation)
+	resp.Token = token
+	if uc.appURL != "" {
+		resp.InviteURL = uc.appURL + "/organizations/invitations/accept?token=" + token
+	}
+	return &resp, nil
+}
+
+func (uc *organizationUseCase) RevokeInvitation(ctx context.Context, userID, invitationID uuid.UUID) error {
+	membership, err := uc.requireManager(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := uc.organizationRepo.GetInvitationByID(ctx, membership.OrganizationID, invitationID)
+	if err != nil {
+		return err
+	}
+	if !invitation.IsPending(uc.now()) {
+		return ErrInvitationNotPending
+	}
+
+	now := uc.now()
+	invitation.RevokedAt = &now
+	return uc.organizationRepo.SaveInvitation(ctx, invitation)
+}
+
+// AcceptInvitation adds the caller to the organization. The invitation must be addressed to the caller's email.
+func (uc *organizationUseCase) AcceptInvitation(ctx context.Context, userID uuid.UUID, req *dto.AcceptOrganizationInvitationRequest) (*dto.OrganizationResponse, error) {
+	existing, err := uc.organizationRepo.GetMembershipByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrAlreadyInOrganization
+	}
+
+	invitation, err := uc.organizationRepo.GetInvitationByTokenHash(ctx, hashInvitationToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if !invitation.IsPending(now) {
+		return nil, ErrInvitationNotPending
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, ErrInvitationEmailMismatch
+	}
+
+	// The seats are checked inside the transaction that adds the member
+	invitation.AcceptedAt = &now
+	member := &models.OrganizationMember{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           invitation.Role,
+	}
+	accepted, err := uc.organizationRepo.AcceptInvitation(ctx, invitation, member)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, ErrNoSeatsAvailable
+	}
+
+	uc.logger.Info("Organization invitation accepted",
+		zap.String("organization_id", invitation.OrganizationID.String()),
+		zap.String("user_id", userID.String()),
+	)
+
+	return uc.GetMyOrganization(ctx, userID)
+}
+
+// UpdateMemberRole switches a member between admin and member. The owner's role cannot be
+// changed, and only the owner promotes or demotes admins, as only the owner removes them.
+func (uc *organizationUseCase) UpdateMemberRole(ctx context.Context, userID, memberUserID uuid.UUID, req *dto.UpdateOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error) {
+	membership, err := uc.requireManager(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := uc.requireSameOrganization(ctx, membership, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == models.OrganizationRoleOwner {
+		return nil, ErrOrganizationOwnerImmutable
+	}
+
+	role := models.OrganizationRole(req.Role)
+	if role == models.OrganizationRoleOwner || !role.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOrganizationRole, req.Role)
+	}
+	if (target.Role == models.OrganizationRoleAdmin || role == models.OrganizationRoleAdmin) && membership.Role != models.OrganizationRoleOwner {
+		return nil, ErrOrganizationForbidden
+	}
+
+	target.Role = role
+	if err := uc.organizationRepo.SaveMember(ctx, target); err != nil {
+		return nil, err
+	}
+
+	resp := toOrganizationMemberResponse(target)
+	return &resp, nil
+}
+
+// RemoveMember removes a member, freeing their seat. Members may remove themselves
+// (leave); removing others requires owner or admin, and only the owner removes admins.
+func (uc *organizationUseCase) RemoveMember(ctx context.Context, userID, memberUserID uuid.UUID) error {
+	membership, err := uc.requireMembership(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	target := membership
+	if memberUserID != userID {
+		if !membership.Role.CanManageMembers() {
+			return ErrOrganizationForbidden
+		}
+		target, err = uc.requireSameOrganization(ctx, membership, memberUserID)
+		if err != nil {
+			return err
+		}
+		if target.Role == models.OrganizationRoleAdmin && membership.Role != models.OrganizationRoleOwner {
+			return ErrOrganizationForbidden
+		}
+	}
+	if target.Role == models.OrganizationRoleOwner {
+		return ErrOrganizationOwnerImmutable
+	}
+
+	return uc.organizationRepo.DeleteMember(ctx, target)
+}
+
+// CreateCheckoutSession starts a Stripe checkout billing the plan price once per seat.
+func (uc *organizationUseCase) CreateCheckoutSession(ctx context.Context, userID uuid.UUID, req *dto.CreateOrganizationCheckoutRequest) (*dto.CreateCheckoutSessionResponse, error) {
+	if uc.stripeCfg.SecretKey == "" {
+		return nil, errors.New("stripe secret key not configured")
+	}
+
+	membership, err := uc.requireOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organization := &membership.Organization
+
+	if organization.StripeSubscriptionID != nil && *organization.StripeSubscriptionID != "" && uc.isActive(organization) {
+		return nil, ErrOrganizationSubscriptionActive
+	}
+
+	plan := models.SubscriptionPlan(req.Plan)
+	if !plan.IsValid() || plan == models.SubscriptionPlanFree {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOrganizationPlan, req.Plan)
+	}
+	used, err := uc.seatsUsed(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	if int64(req.Seats) < used {
+		return nil, fmt.Errorf("%w: %d in use", ErrSeatsBelowUsage, used)
+	}
+
+	interval := req.Interval
+	if interval == "" {
+		interval = config.BillingIntervalMonth
+	}
+	currency := req.Currency
+	if currency == "" {
+		currency = uc.stripeCfg.Prices.DefaultCurrency
+	}
+	price, err := uc.prices.resolve(ctx, plan, interval, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	stripe.Key = uc.stripeCfg.SecretKey
+
+	stripeCustomerID := strVal(organization.StripeCustomerID)
+	if stripeCustomerID == "" {
+		owner, err := uc.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("get user %s: %w", userID.String(), err)
+		}
+		params := &stripe.CustomerParams{
+			Email: stripe.String(owner.Email),
+			Name:  stripe.String(organization.Name),
+		}
+		params.AddMetadata("organization_id", organization.ID.String())
+
+		stripeCustomer, err := customer.New(params)
+		if err != nil {
+			return nil, fmt.Errorf("create stripe customer: %w", err)
+		}
+		stripeCustomerID = stripeCustomer.ID
+		organization.StripeCustomerID = &stripeCustomerID
+		if err := uc.organizationRepo.Save(ctx, organization); err != nil {
+			return nil, err
+		}
+	}
+
+	checkoutParams := &stripe.CheckoutSessionParams{
+		Mode:                stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		SuccessURL:          stripe.String(req.SuccessURL),
+		CancelURL:           stripe.String(req.CancelURL),
+		Customer:            stripe.String(stripeCustomerID),
+		AllowPromotionCodes: stripe.Bool(true),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				Price:    stripe.String(price.PriceID),
+				Quantity: stripe.Int64(int64(req.Seats)),
+			},
+		},
+		// Subscription events can arrive before checkout.session.completed; the
+		// metadata lets them be matched to the organization.
+		SubscriptionData: &stripe.CheckoutSessionSubscriptionDataParams{
+			Metadata: map[string]string{"organization_id": organization.ID.String()},
+		},
+	}
+	checkoutParams.AddMetadata("organization_id", organization.ID.String())
+	checkoutParams.AddMetadata("plan", string(plan))
+	checkoutParams.AddMetadata("seats", strconv.Itoa(req.Seats))
+	checkoutParams.AddMetadata("interval", price.Interval)
+	checkoutParams.AddMetadata("currency", price.Currency)
+
+	s, err := session.New(checkoutParams)
+	if err != nil {
+		return nil, fmt.Errorf("create checkout session: %w", err)
+	}
+
+	return &dto.CreateCheckoutSessionResponse{
+		SessionID:   s.ID,
+		CheckoutURL: s.URL,
+	}, nil
+}
+
+// UpdateSeats changes the subscription quantity. Added seats are invoiced prorated right away.
+func (uc *organizationUseCase) UpdateSeats(ctx context.Context, userID uuid.UUID, req *dto.UpdateOrganizationSeatsRequest) (*dto.OrganizationResponse, error) {
+	if uc.stripeCfg.SecretKey == "" {
+		return nil, errors.New("stripe secret key not configured")
+	}
+
+	membership, err := uc.requireOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organization := &membership.Organization
+
+	if organization.StripeSubscriptionID == nil || *organization.StripeSubscriptionID == "" || !uc.isActive(organization) {
+		return nil, ErrNoOrganizationSubscription
+	}
+	used, err := uc.seatsUsed(ctx, organization.ID)
+	if err != nil {
+		return nil, err
+	}
+	if int64(req.Seats) < used {
+		return nil, fmt.Errorf("%w: %d in use", ErrSeatsBelowUsage, used)
+	}
+
+	stripe.Key = uc.stripeCfg.SecretKey
+
+	stripeSub, err := stripesub.Get(*organization.StripeSubscriptionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get stripe subscription: %w", err)
+	}
+	if stripeSub.Items == nil || len(stripeSub.Items.Data) != 1 {
+		return nil, errors.New("stripe subscription must have exactly one item to change seats")
+	}
+
+	params := &stripe.SubscriptionParams{
+		Items: []*stripe.SubscriptionItemsParams{
+			{
+				ID:       stripe.String(stripeSub.Items.Data[0].ID),
+				Quantity: stripe.Int64(int64(req.Seats)),
+			},
+		},
+		ProrationBehavior: stripe.String(prorationAlwaysInvoice),
+	}
+	if _, err := stripesub.Update(stripeSub.ID, params); err != nil {
+		return nil, fmt.Errorf("update stripe subscription: %w", err)
+	}
+
+	organization.Seats = req.Seats
+	if err := uc.organizationRepo.Save(ctx, organization); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Organization seats updated",
+		zap.String("organization_id", organization.ID.String()),
+		zap.Int("seats", req.Seats),
+	)
+
+	return uc.GetMyOrganization(ctx, userID)
+}
+
+// GetEntitlement returns the organization plan available to the user, or nil when
+// the user has no organization or its subscription is not active.
+func (uc *organizationUseCase) GetEntitlement(ctx context.Context, userID uuid.UUID) (*Entitlement, error) {
+	membership, err := uc.organizationRepo.GetMembershipByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, nil
+	}
+
+	organization := &membership.Organization
+	if !uc.isActive(organization) || !organization.Plan.IsValid() || organization.Plan == models.SubscriptionPlanFree {
+		return nil, nil
+	}
+
+	organizationID := organization.ID
+	return &Entitlement{
+		Plan:           organization.Plan,
+		OrganizationID: &organizationID,
+		Seats:          organization.Seats,
+	}, nil
+}
+
+// HandleCheckoutCompleted links a completed organization checkout to its Stripe subscription.
+func (uc *organizationUseCase) HandleCheckoutCompleted(ctx context.Context, s *stripe.CheckoutSession) error {
+	organizationID, err := uuid.Parse(s.Metadata["organization_id"])
+	if err != nil {
+		return fmt.Errorf("invalid organization_id metadata: %w", err)
+	}
+	plan := models.SubscriptionPlan(s.Metadata["plan"])
+	if !plan.IsValid() || plan == models.SubscriptionPlanFree {
+		return fmt.Errorf("invalid plan metadata: %q", s.Metadata["plan"])
+	}
+
+	organization, err := uc.organizationRepo.GetByID(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	organization.Plan = plan
+	if seats, err := strconv.Atoi(s.Metadata["seats"]); err == nil && seats > 0 {
+		organization.Seats = seats
+	}
+	if interval := s.Metadata["interval"]; interval != "" {
+		organization.BillingInterval = interval
+	}
+	if currency := s.Metadata["currency"]; currency != "" {
+		organization.Currency = currency
+	}
+	if cid := stripeIDFromCustomer(s.Customer); cid != "" {
+		organization.StripeCustomerID = &cid
+	}
+	if sid := stripeIDFromSubscription(s.Subscription); sid != "" {
+		organization.StripeSubscriptionID = &sid
+	}
+	// A subscription event may already have activated the organization.
+	if !uc.isActive(organization) {
+		organization.Status = models.SubscriptionStatusIncomplete
+	}
+
+	uc.logger.Info("Organization checkout completed",
+		zap.String("organization_id", organization.ID.String()),
+		zap.String("plan", string(plan)),
+		zap.Int("seats", organization.Seats),
+	)
+
+	return uc.organizationRepo.Save(ctx, organization)
+}
+
+// SyncStripeSubscription applies a customer.subscription event to the organization it bills.
+// It reports false when the subscription does not belong to an organization.
+func (uc *organizationUseCase) SyncStripeSubscription(ctx context.Context, s *stripe.Subscription, deleted bool) (bool, error) {
+	organization, err := uc.organizationRepo.GetByStripeSubscriptionID(ctx, s.ID)
+	if err != nil {
+		return false, err
+	}
+	if organization == nil {
+		organizationID, err := uuid.Parse(s.Metadata["organization_id"])
+		if err != nil {
+			return false, nil
+		}
+		organization, err = uc.organizationRepo.GetByID(ctx, organizationID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if deleted {
+		now := uc.now()
+		organization.Status = models.SubscriptionStatusCanceled
+		organization.Plan = models.SubscriptionPlanFree
+		organization.CanceledAt = &now
+		organization.CancelAtPeriodEnd = false
+		organization.CurrentPeriodEnd = nil
+		organization.PastDueSince = nil
+		return true, uc.organizationRepo.Save(ctx, organization)
+	}
+
+	sid := s.ID
+	organization.StripeSubscriptionID = &sid
+	if cid := stripeIDFromCustomer(s.Customer); cid != "" {
+		organization.StripeCustomerID = &cid
+	}
+	organization.CancelAtPeriodEnd = s.CancelAtPeriodEnd
+	if s.CurrentPeriodEnd > 0 {
+		end := time.Unix(s.CurrentPeriodEnd, 0).UTC()
+		organization.CurrentPeriodEnd = &end
+	}
+	if mapped, ok := mapStripeSubscriptionStatus(s.Status); ok {
+		uc.applyStatus(organization, mapped)
+	}
+	if s.Items != nil && len(s.Items.Data) > 0 {
+		item := s.Items.Data[0]
+		if item.Quantity > 0 {
+			organization.Seats = int(item.Quantity)
+		}
+		if item.Price != nil {
+			if entry, ok := uc.prices.findByPriceID(ctx, item.Price.ID); ok {
+				organization.Plan = entry.Plan
+				organization.BillingInterval = entry.Interval
+				organization.Currency = entry.Currency
+			}
+		}
+	}
+
+	return true, uc.organizationRepo.Save(ctx, organization)
+}
+
+// SyncStripeInvoice applies a paid or failed invoice to the organization it bills.
+// It reports false when the invoice does not belong to an organization.
+func (uc *organizationUseCase) SyncStripeInvoice(ctx context.Context, inv *stripe.Invoice, paid bool) (bool, error) {
+	stripeSubscriptionID := stripeIDFromSubscription(inv.Subscription)
+	if stripeSubscriptionID == "" {
+		return false, nil
+	}
+
+	organization, err := uc.organizationRepo.GetByStripeSubscriptionID(ctx, stripeSubscriptionID)
+	if err != nil || organization == nil {
+		return false, err
+	}
+
+	if paid {
+		uc.applyStatus(organization, models.SubscriptionStatusActive)
+		if periodEnd := derivePeriodEndFromInvoice(inv); periodEnd != nil {
+			organization.CurrentPeriodEnd = periodEnd
+		}
+	} else {
+		uc.applyStatus(organization, models.SubscriptionStatusPastDue)
+	}
+
+	return true, uc.organizationRepo.Save(ctx, organization)
+}
+
+// isActive applies the personal subscription rules (including the past_due grace period) to the organization.
+func (uc *organizationUseCase) isActive(organization *models.Organization) bool {
+	active, _ := isSubscriptionActive(uc.now(), uc.policy, &models.Subscription{
+		Status:           organization.Status,
+		CurrentPeriodEnd: organization.CurrentPeriodEnd,
+		PastDueSince:     organization.PastDueSince,
+	})
+	return active
+}
+
+func (uc *organizationUseCase) applyStatus(organization *models.Organization, status models.SubscriptionStatus) {
+	if status == models.SubscriptionStatusPastDue {
+		if organization.PastDueSince == nil {
+			now := uc.now()
+			organization.PastDueSince = &now
+		}
+	} else {
+		organization.PastDueSince = nil
+	}
+	organization.Status = status
+}
+
+// seatsUsed counts members plus pending invitations, since each invitation reserves a seat.
+func (uc *organizationUseCase) seatsUsed(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	members, err := uc.organizationRepo.CountMembers(ctx, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	invitations, err := uc.organizationRepo.CountPendingInvitations(ctx, organizationID, uc.now())
+	if err != nil {
+		return 0, err
+	}
+	return members + invitations, nil
+}
+
+func (uc *organizationUseCase) requireMembership(ctx context.Context, userID uuid.UUID) (*models.OrganizationMember, error) {
+	membership, err := uc.organizationRepo.GetMembershipByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, fmt.Errorf("organization for user %s: %w", userID.String(), gorm.ErrRecordNotFound)
+	}
+	return membership, nil
+}
+
+func (uc *organizationUseCase) requireManager(ctx context.Context, userID uuid.UUID) (*models.OrganizationMember, error) {
+	membership, err := uc.requireMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !membership.Role.CanManageMembers() {
+		return nil, ErrOrganizationForbidden
+	}
+	return membership, nil
+}
+
+func (uc *organizationUseCase) requireOwner(ctx context.Context, userID uuid.UUID) (*models.OrganizationMember, error) {
+	membership, err := uc.requireMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if membership.Role != models.OrganizationRoleOwner {
+		return nil, ErrOrganizationForbidden
+	}
+	return membership, nil
+}
+
+// requireSameOrganization loads the target user's membership and returns gorm.ErrRecordNotFound
+// (wrapped) when they are not in the caller's organization.
+func (uc *organizationUseCase) requireSameOrganization(ctx context.Context, membership *models.OrganizationMember, memberUserID uuid.UUID) (*models.OrganizationMember, error) {
+	target, err := uc.organizationRepo.GetMembershipByUserID(ctx, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || target.OrganizationID != membership.OrganizationID {
+		return nil, fmt.Errorf("organization member %s: %w", memberUserID.String(), gorm.ErrRecordNotFound)
+	}
+	return target, nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func toOrganizationMemberResponse(member *models.OrganizationMember) dto.OrganizationMemberResponse {
+	return dto.OrganizationMemberResponse{
+		UserID:   member.UserID,
+		Name:     member.User.Name,
+		Email:    member.User.Email,
+		Role:     string(member.Role),
+		JoinedAt: member.CreatedAt,
+	}
+}
+
+func toOrganizationInvitationResponse(invitation *models.OrganizationInvitation) dto.OrganizationInvitationResponse {
+	return dto.OrganizationInvitationResponse{
+		ID:        invitation.ID,
+		Email:     invitation.Email,
+		Role:      string(invitation.Role),
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
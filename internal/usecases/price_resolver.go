@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"go.uber.org/zap"
+)
+
+// priceResolver maps plans to Stripe prices for personal and organization checkouts.
+type priceResolver struct {
+	planPriceRepo repositories.PlanPriceRepository
+	prices        config.PriceCatalog
+	logger        *zap.Logger
+}
+
+func newPriceResolver(planPriceRepo repositories.PlanPriceRepository, prices config.PriceCatalog, logger *zap.Logger) *priceResolver {
+	return &priceResolver{
+		planPriceRepo: planPriceRepo,
+		prices:        prices,
+		logger:        logger,
+	}
+}
+
+// catalog returns the effective catalog: active database prices first,
+// followed by the STRIPE_PRICE_ID_* environment entries as fallback.
+func (r *priceResolver) catalog(ctx context.Context) config.PriceCatalog {
+	catalog := config.PriceCatalog{DefaultCurrency: r.prices.DefaultCurrency}
+
+	if r.planPriceRepo != nil {
+		prices, err := r.planPriceRepo.ListActive(ctx)
+		if err != nil {
+			if r.logger != nil {
+				r.logger.Warn("Failed to load plan prices from database, using environment catalog", zap.Error(err))
+			}
+		} else {
+			for _, p := range prices {
+				catalog.Entries = append(catalog.Entries, config.PriceCatalogEntry{
+					Plan:     p.Plan,
+					Interval: p.Interval,
+					Currency: p.Currency,
+					PriceID:  p.StripePriceID,
+				})
+			}
+		}
+	}
+
+	catalog.Entries = append(catalog.Entries, r.prices.Entries...)
+	return catalog
+}
+
+func (r *priceResolver) resolve(ctx context.Context, plan models.SubscriptionPlan, interval, currency string) (config.PriceCatalogEntry, error) {
+	entry, ok := r.catalog(ctx).Find(plan, interval, currency)
+	if !ok {
+		return config.PriceCatalogEntry{}, fmt.Errorf("no stripe price configured for plan %s (%s, %s)", plan, interval, currency)
+	}
+	return entry, nil
+}
+
+// findByPriceID maps a Stripe price ID back to the plan, interval and currency it sells.
+func (r *priceResolver) findByPriceID(ctx context.Context, priceID string) (config.PriceCatalogEntry, bool) {
+	return r.catalog(ctx).FindByPriceID(priceID)
+}
@@ -0,0 +1,447 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"github.com/google/uuid"
+	"github.com/stripe/stripe-go/v81"
+	stripesub "github.com/stripe/stripe-go/v81/subscription"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	referralCodeLength = 8
+	// Unambiguous characters only (no 0/O, 1/I/L) so codes can be typed from a screenshot.
+	referralCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	referralCodeAttempts = 5
+)
+
+// ReferralUseCase defines the interface for referral codes, campaigns and rewards
+type ReferralUseCase interface {
+	PrepareSignup(ctx context.Context, user *models.User, referralCode string) error
+	GetMyReferral(ctx context.Context, userID uuid.UUID) (*dto.ReferralSummaryResponse, error)
+	HandlePaidConversion(ctx context.Context, userID uuid.UUID) error
+	CreateCampaign(ctx context.Context, req *dto.CreateReferralCampaignRequest) (*dto.ReferralCampaignResponse, error)
+	ListCampaigns(ctx context.Context) ([]dto.ReferralCampaignResponse, error)
+	DeactivateCampaign(ctx context.Context, id uuid.UUID) (*dto.ReferralCampaignResponse, error)
+}
+
+// Referral campaign errors, reported to clients by their code.
+var (
+	ErrInvalidRewardType     = apperrors.NewCodedError(apperrors.CodeInvalidRewardType, "")
+	ErrRewardCreditsRequired = apperrors.NewCodedError(apperrors.CodeRewardCreditsRequired, "")
+	ErrStripeCouponRequired  = apperrors.NewCodedError(apperrors.CodeStripeCouponRequired, "")
+	ErrInvalidCampaignPeriod = apperrors.NewCodedError(apperrors.CodeInvalidCampaignPeriod, "")
+)
+
+type referralUseCase struct {
+	referralRepo     repositories.ReferralRepository
+	userRepo         repositories.UserRepository
+	subscriptionRepo repositories.SubscriptionRepository
+	stripeSecretKey  string
+	appURL           string
+	logger           *zap.Logger
+	now              func() time.Time
+}
+
+// NewReferralUseCase creates a new instance of ReferralUseCase
+func NewReferralUseCase(
+	referralRepo repositories.ReferralRepository,
+	userRepo repositories.UserRepository,
+	subscriptionRepo repositories.SubscriptionRepository,
+	stripeSecretKey string,
+	appURL string,
+	logger *zap.Logger,
+) ReferralUseCase {
+	return &referralUseCase{
+		referralRepo:     referralRepo,
+		userRepo:         userRepo,
+		subscriptionRepo: subscriptionRepo,
+		stripeSecretKey:  stripeSecretKey,
+		appURL:           appURL,
+		logger:           logger,
+		now:              time.Now,
+	}
+}
+
+// PrepareSignup assigns a referral code to a new user and, when a valid code was
+// provided, attributes the signup to its owner and the running campaign.
+// Unknown codes are ignored so a typo never blocks registration.
+func (uc *referralUseCase) PrepareSignup(ctx context.Context, user *models.User, referralCode string) error {
+	code, err := uc.generateUniqueCode(ctx)
+	if err != nil {
+		return err
+	}
+	user.ReferralCode = &code
+
+	referralCode = normalizeReferralCode(referralCode)
+	if referralCode == "" {
+		return nil
+	}
+
+	referrer, err := uc.userRepo.GetByReferralCode(ctx, referralCode)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			uc.logger.Info("Unknown referral code at signup, ignoring", zap.String("referral_code", referralCode))
+			return nil
+		}
+		return err
+	}
+	user.ReferredByUserID = &referrer.ID
+
+	campaign, err := uc.referralRepo.GetRunningCampaign(ctx, uc.now())
+	if err != nil {
+		return err
+	}
+	if campaign != nil {
+		user.ReferralCampaignID = &campaign.ID
+	}
+
+	return nil
+}
+
+// GetMyReferral returns the user's referral code (created on first access for older accounts) and results
+func (uc *referralUseCase) GetMyReferral(ctx context.Context, userID uuid.UUID) (*dto.ReferralSummaryResponse, error) {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.ReferralCode == nil || *user.ReferralCode == "" {
+		code, err := uc.generateUniqueCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.userRepo.SetReferralCode(ctx, userID, code); err != nil {
+			return nil, err
+		}
+		// Re-read in case a concurrent request assigned a different code first.
+		if user, err = uc.userRepo.GetByID(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	referred, err := uc.userRepo.CountReferredBy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := uc.referralRepo.ListRewardsByReferrer(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.ReferralSummaryResponse{
+		Code:           *user.ReferralCode,
+		ReferredCount:  referred,
+		ConvertedCount: int64(len(rewards)),
+	}
+	if uc.appURL != "" {
+		resp.ShareURL = strings.TrimRight(uc.appURL, "/") + "/signup?ref=" + *user.ReferralCode
+	}
+
+	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription != nil {
+		resp.BonusAIRequests = subscription.BonusAIRequests
+	}
+
+	campaign, err := uc.referralRepo.GetRunningCampaign(ctx, uc.now())
+	if err != nil {
+		return nil, err
+	}
+	if campaign != nil {
+		c := referralCampaignToResponse(campaign)
+		// Coupon IDs are internal to billing; users only need to know a reward exists.
+		c.StripeCouponID = nil
+		resp.Campaign = &c
+	}
+
+	return resp, nil
+}
+
+// HandlePaidConversion is called when a user pays for a plan. It rewards the user's
+// referrer once (first paid conversion only) and applies any coupon rewards the user
+// earned as a referrer while not having a paid subscription.
+func (uc *referralUseCase) HandlePaidConversion(ctx context.Context, userID uuid.UUID) error {
+	if err := uc.rewardReferrer(ctx, userID); err != nil {
+		return err
+	}
+	return uc.applyPendingCoupons(ctx, userID)
+}
+
+func (uc *referralUseCase) rewardReferrer(ctx context.Context, referredUserID uuid.UUID) error {
+	user, err := uc.userRepo.GetByID(ctx, referredUserID)
+	if err != nil {
+		return err
+	}
+	if user.ReferredByUserID == nil || user.ReferralCampaignID == nil {
+		return nil
+	}
+
+	existing, err := uc.referralRepo.GetRewardByReferredUserID(ctx, referredUserID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		// Retry credits whose grant failed on a previous delivery; pending coupons wait for the referrer's payment.
+		if existing.Status == models.ReferralRewardStatusPending && existing.RewardType == models.ReferralRewardAICredits {
+			return uc.grant(ctx, existing)
+		}
+		return nil
+	}
+
+	campaign, err := uc.referralRepo.GetCampaignByID(ctx, *user.ReferralCampaignID)
+	if err != nil {
+		return err
+	}
+	// Deactivation stops rewards for signups that have not converted yet.
+	if !campaign.Active {
+		return nil
+	}
+
+	reward := &models.ReferralReward{
+		ReferrerUserID: *user.ReferredByUserID,
+		ReferredUserID: referredUserID,
+		CampaignID:     &campaign.ID,
+		RewardType:     campaign.RewardType,
+		RewardCredits:  campaign.RewardCredits,
+		StripeCouponID: campaign.StripeCouponID,
+		Status:         models.ReferralRewardStatusPending,
+	}
+	// The unique index on referred_user_id guards against concurrent webhook deliveries.
+	if err := uc.referralRepo.CreateReward(ctx, reward); err != nil {
+		return err
+	}
+
+	return uc.grant(ctx, reward)
+}
+
+func (uc *referralUseCase) grant(ctx context.Context, reward *models.ReferralReward) error {
+	switch reward.RewardType {
+	case models.ReferralRewardAICredits:
+		now := uc.now()
+		granted, err := uc.referralRepo.GrantAICreditsReward(ctx, reward, now)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return nil
+		}
+		reward.Status = models.ReferralRewardStatusGranted
+		reward.GrantedAt = &now
+		uc.logGranted(reward)
+		return nil
+	case models.ReferralRewardStripeCoupon:
+		return uc.applyCoupon(ctx, reward)
+	default:
+		return fmt.Errorf("unsupported referral reward type: %s", reward.RewardType)
+	}
+}
+
+func (uc *referralUseCase) applyPendingCoupons(ctx context.Context, referrerID uuid.UUID) error {
+	rewards, err := uc.referralRepo.ListRewardsByReferrer(ctx, referrerID)
+	if err != nil {
+		return err
+	}
+	for i := range rewards {
+		reward := &rewards[i]
+		if reward.Status != models.ReferralRewardStatusPending || reward.RewardType != models.ReferralRewardStripeCoupon {
+			continue
+		}
+		if err := uc.applyCoupon(ctx, reward); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyCoupon attaches the reward coupon to the referrer's Stripe subscription. Referrers
+// without a paid subscription keep the reward pending until their own first payment.
+func (uc *referralUseCase) applyCoupon(ctx context.Context, reward *models.ReferralReward) error {
+	if reward.StripeCouponID == nil || *reward.StripeCouponID == "" {
+		return fmt.Errorf("referral reward %s has no stripe coupon", reward.ID.String())
+	}
+
+	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, reward.ReferrerUserID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil || subscription.StripeSubscriptionID == nil || *subscription.StripeSubscriptionID == "" {
+		uc.logger.Info(
+			"Referrer has no Stripe subscription yet, keeping coupon reward pending",
+			zap.String("referrer_user_id", reward.ReferrerUserID.String()),
+			zap.String("reward_id", reward.ID.String()),
+		)
+		return nil
+	}
+	if uc.stripeSecretKey == "" {
+		return errors.New("stripe secret key not configured")
+	}
+
+	stripe.Key = uc.stripeSecretKey
+	params := &stripe.SubscriptionParams{
+		Discounts: []*stripe.SubscriptionDiscountParams{
+			{Coupon: stripe.String(*reward.StripeCouponID)},
+		},
+	}
+	params.AddMetadata("referral_reward_id", reward.ID.String())
+	if _, err := stripesub.Update(*subscription.StripeSubscriptionID, params); err != nil {
+		return fmt.Errorf("apply referral coupon to stripe subscription: %w", err)
+	}
+
+	return uc.markGranted(ctx, reward)
+}
+
+func (uc *referralUseCase) markGranted(ctx context.Context, reward *models.ReferralReward) error {
+	now := uc.now()
+	reward.Status = models.ReferralRewardStatusGranted
+	reward.GrantedAt = &now
+	if err := uc.referralRepo.SaveReward(ctx, reward); err != nil {
+		return err
+	}
+
+	uc.logGranted(reward)
+	return nil
+}
+
+func (uc *referralUseCase) logGranted(reward *models.ReferralReward) {
+	uc.logger.Info(
+		"Referral reward granted",
+		zap.String("referrer_user_id", reward.ReferrerUserID.String()),
+		zap.String("referred_user_id", reward.ReferredUserID.String()),
+		zap.String("reward_type", string(reward.RewardType)),
+	)
+}
+
+// CreateCampaign creates a new active referral campaign
+func (uc *referralUseCase) CreateCampaign(ctx context.Context, req *dto.CreateReferralCampaignRequest) (*dto.ReferralCampaignResponse, error) {
+	rewardType := models.ReferralRewardType(req.RewardType)
+	if !rewardType.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRewardType, req.RewardType)
+	}
+	if rewardType == models.ReferralRewardAICredits && req.RewardCredits <= 0 {
+		return nil, ErrRewardCreditsRequired
+	}
+	if rewardType == models.ReferralRewardStripeCoupon && strings.TrimSpace(req.StripeCouponID) == "" {
+		return nil, ErrStripeCouponRequired
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return nil, ErrInvalidCampaignPeriod
+	}
+
+	campaign := &models.ReferralCampaign{
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		RewardType:  rewardType,
+		Active:      true,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+	}
+	if rewardType == models.ReferralRewardAICredits {
+		campaign.RewardCredits = req.RewardCredits
+	} else {
+		couponID := strings.TrimSpace(req.StripeCouponID)
+		campaign.StripeCouponID = &couponID
+	}
+
+	if err := uc.referralRepo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	resp := referralCampaignToResponse(campaign)
+	return &resp, nil
+}
+
+// ListCampaigns returns every referral campaign, newest first
+func (uc *referralUseCase) ListCampaigns(ctx context.Context) ([]dto.ReferralCampaignResponse, error) {
+	campaigns, err := uc.referralRepo.ListCampaigns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ReferralCampaignResponse, len(campaigns))
+	for i := range campaigns {
+		responses[i] = referralCampaignToResponse(&campaigns[i])
+	}
+	return responses, nil
+}
+
+// DeactivateCampaign stops a campaign from attributing new signups and granting pending rewards
+func (uc *referralUseCase) DeactivateCampaign(ctx context.Context, id uuid.UUID) (*dto.ReferralCampaignResponse, error) {
+	campaign, err := uc.referralRepo.GetCampaignByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Active {
+		now := uc.now()
+		campaign.Active = false
+		campaign.DeactivatedAt = &now
+		if err := uc.referralRepo.SaveCampaign(ctx, campaign); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := referralCampaignToResponse(campaign)
+	return &resp, nil
+}
+
+func (uc *referralUseCase) generateUniqueCode(ctx context.Context) (string, error) {
+	for i := 0; i < referralCodeAttempts; i++ {
+		code, err := generateReferralCode()
+		if err != nil {
+			return "", fmt.Errorf("generate referral code: %w", err)
+		}
+		_, err = uc.userRepo.GetByReferralCode(ctx, code)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return code, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", errors.New("failed to generate a unique referral code")
+}
+
+func generateReferralCode() (string, error) {
+	buf := make([]byte, referralCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = referralCodeAlphabet[int(b)%len(referralCodeAlphabet)]
+	}
+	return string(buf), nil
+}
+
+func normalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func referralCampaignToResponse(c *models.ReferralCampaign) dto.ReferralCampaignResponse {
+	return dto.ReferralCampaignResponse{
+		ID:             c.ID,
+		Name:           c.Name,
+		Description:    c.Description,
+		RewardType:     string(c.RewardType),
+		RewardCredits:  c.RewardCredits,
+		StripeCouponID: c.StripeCouponID,
+		Active:         c.Active,
+		StartsAt:       c.StartsAt,
+		EndsAt:         c.EndsAt,
+		DeactivatedAt:  c.DeactivatedAt,
+		CreatedAt:      c.CreatedAt,
+	}
+}
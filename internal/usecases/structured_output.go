@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/shared"
+)
+
+// structuredLanguageRule is shared by the v2 generators: text values follow the input language.
+const structuredLanguageRule = `CRITICAL LANGUAGE RULE: You MUST detect the language of the input content and write every text value EXACTLY in the same language. If the input is in English, respond in English. If the input is in Portuguese, respond in Portuguese. If the input is in Spanish, respond in Spanish. Enum values (such as categories and levels) stay exactly as defined in the schema.
+
+If the input is too vague to produce a meaningful list, return an empty list and put a short question asking for more detail, in the language of the input, in "clarification". Otherwise "clarification" is an empty string.`
+
+// structuredObjectSchema builds a strict JSON schema object: every property is required and
+// no other properties are allowed, as OpenAI structured outputs demand.
+func structuredObjectSchema(properties map[string]any) map[string]any {
+	required := make([]string, 0, len(properties))
+	for name := range properties {
+		required = append(required, name)
+	}
+	sort.Strings(required)
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// structuredListSchema is the top-level schema of the v2 generators: a list under itemsKey
+// plus the clarification string.
+func structuredListSchema(itemsKey string, item map[string]any) map[string]any {
+	return structuredObjectSchema(map[string]any{
+		itemsKey: map[string]any{
+			"type":  "array",
+			"items": item,
+		},
+		"clarification": map[string]any{"type": "string"},
+	})
+}
+
+func stringEnumSchema(values ...string) map[string]any {
+	return map[string]any{"type": "string", "enum": values}
+}
+
+// completeStructured asks the model for output matching schema and decodes it into out.
+// genCtx may be nil; when set, the prompt is grounded in the user's curriculum.
+func completeStructured(ctx context.Context, client *openai.Client, systemPrompt, userPrompt, schemaName string, schema map[string]any, genCtx *generationContext, out any) error {
+	chatReq := openai.ChatCompletionNewParams{
+		Model: "gpt-4o-mini",
+		Messages: withGenerationContext([]openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(systemPrompt + "\n\n" + structuredLanguageRule),
+			openai.UserMessage(userPrompt),
+		}, genCtx),
+		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &shared.ResponseFormatJSONSchemaParam{
+				JSONSchema: shared.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   schemaName,
+					Strict: openai.Bool(true),
+					Schema: schema,
+				},
+			},
+		},
+		MaxTokens:   openai.Int(int64(config.ParseIntEnv("OPENAI_MAX_TOKENS", 1000)) * 2),
+		Temperature: openai.Float(config.ParseFloatEnv("OPENAI_TEMPERATURE", 0.7)),
+		TopP:        openai.Float(config.ParseFloatEnv("OPENAI_TOP_P", 1.0)),
+	}
+
+	resp, err := client.Chat.Completions.New(ctx, chatReq)
+	if err != nil {
+		return errors.WrapError(err, "failed to get OpenAI response")
+	}
+
+	if len(resp.Choices) == 0 {
+		return errors.NewAppError("no response from OpenAI")
+	}
+	if refusal := resp.Choices[0].Message.Refusal; refusal != "" {
+		return errors.NewAppError("OpenAI refused the request: " + refusal)
+	}
+
+	if err := json.Unmarshal([]byte(resp.Choices[0].Message.Content), out); err != nil {
+		return errors.WrapError(err, "failed to parse AI response as JSON")
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
+	"go.uber.org/zap"
+)
+
+// SubscriptionLifecycleUseCase runs the periodic trial and past_due policies.
+// Each method returns how many subscriptions it acted on.
+type SubscriptionLifecycleUseCase interface {
+	SendTrialEndingReminders(ctx context.Context) (int, error)
+	SendPaymentFailedReminders(ctx context.Context) (int, error)
+	ExpireTrials(ctx context.Context) (int, error)
+}
+
+type subscriptionLifecycleUseCase struct {
+	subscriptionRepo repositories.SubscriptionRepository
+	userRepo         repositories.UserRepository
+	emailUseCase     EmailUseCase
+	policy           config.SubscriptionPolicy
+	logger           *zap.Logger
+	now              func() time.Time
+}
+
+// NewSubscriptionLifecycleUseCase creates a new instance of SubscriptionLifecycleUseCase.
+// emailUseCase may be nil, in which case reminders are skipped.
+func NewSubscriptionLifecycleUseCase(
+	subscriptionRepo repositories.SubscriptionRepository,
+	userRepo repositories.UserRepository,
+	emailUseCase EmailUseCase,
+	policy config.SubscriptionPolicy,
+	logger *zap.Logger,
+) SubscriptionLifecycleUseCase {
+	return &subscriptionLifecycleUseCase{
+		subscriptionRepo: subscriptionRepo,
+		userRepo:         userRepo,
+		emailUseCase:     emailUseCase,
+		policy:           policy,
+		logger:           logger,
+		now:              time.Now,
+	}
+}
+
+// SendTrialEndingReminders emails users whose trial ends within the configured reminder window.
+func (uc *subscriptionLifecycleUseCase) SendTrialEndingReminders(ctx context.Context) (int, error) {
+	if uc.emailUseCase == nil || uc.policy.TrialReminderDays <= 0 {
+		return 0, nil
+	}
+
+	now := uc.now()
+	subscriptions, err := uc.subscriptionRepo.ListTrialsEndingBefore(ctx, now.AddDate(0, 0, uc.policy.TrialReminderDays))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		if subscription.TrialEndsAt == nil || now.After(*subscription.TrialEndsAt) {
+			continue
+		}
+
+		// The user is loaded before claiming the reminder, so a failed lookup is retried
+		// on the next run instead of dropping the reminder.
+		user, err := uc.userRepo.GetByIDWithConfiguration(ctx, subscription.UserID)
+		if err != nil {
+			uc.logger.Warn("Failed to load user for trial reminder", zap.String("user_id", subscription.UserID.String()), zap.Error(err))
+			continue
+		}
+
+		claimed, err := uc.subscriptionRepo.MarkTrialReminderSent(ctx, subscription.ID, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := uc.emailUseCase.SendTrialEndingEmail(emailRecipientOf(user), string(subscription.Plan), *subscription.TrialEndsAt); err != nil {
+			uc.logger.Warn("Failed to send trial reminder", zap.String("user_id", subscription.UserID.String()), zap.Error(err))
+			if err := uc.subscriptionRepo.ReleaseTrialReminder(ctx, subscription.ID); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// SendPaymentFailedReminders emails users whose renewal failed, once per past_due episode.
+func (uc *subscriptionLifecycleUseCase) SendPaymentFailedReminders(ctx context.Context) (int, error) {
+	if uc.emailUseCase == nil {
+		return 0, nil
+	}
+
+	now := uc.now()
+	since := now.Add(-time.Duration(uc.policy.PaymentFailedReminderHours) * time.Hour)
+	subscriptions, err := uc.subscriptionRepo.ListPastDueSince(ctx, since)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+
+		user, err := uc.userRepo.GetByIDWithConfiguration(ctx, subscription.UserID)
+		if err != nil {
+			uc.logger.Warn("Failed to load user for payment failure reminder", zap.String("user_id", subscription.UserID.String()), zap.Error(err))
+			continue
+		}
+
+		claimed, err := uc.subscriptionRepo.MarkPaymentFailedReminderSent(ctx, subscription.ID, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		graceEndsAt := subscription.PastDueSince.Add(uc.policy.PastDueGrace())
+		if err := uc.emailUseCase.SendPaymentFailedEmail(emailRecipientOf(user), string(subscription.Plan), graceEndsAt); err != nil {
+			uc.logger.Warn("Failed to send payment failure reminder", zap.String("user_id", subscription.UserID.String()), zap.Error(err))
+			if err := uc.subscriptionRepo.ReleasePaymentFailedReminder(ctx, subscription.ID); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// ExpireTrials moves ended local trials back to the free plan. Trials managed by
+// Stripe are left alone; their status arrives through webhooks.
+func (uc *subscriptionLifecycleUseCase) ExpireTrials(ctx context.Context) (int, error) {
+	subscriptions, err := uc.subscriptionRepo.ListExpiredLocalTrials(ctx, uc.now())
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		subscription.Plan = models.SubscriptionPlanFree
+		subscription.Status = models.SubscriptionStatusActive
+		if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
+			return expired, err
+		}
+		uc.logger.Info("Free trial expired", zap.String("user_id", subscription.UserID.String()))
+		expired++
+	}
+
+	return expired, nil
+}
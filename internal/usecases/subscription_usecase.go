@@ -9,6 +9,7 @@ import (
 
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/config"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
+	apperrors "github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/google/uuid"
@@ -16,39 +17,89 @@ import (
 	billingportalsession "github.com/stripe/stripe-go/v81/billingportal/session"
 	"github.com/stripe/stripe-go/v81/checkout/session"
 	"github.com/stripe/stripe-go/v81/customer"
+	"github.com/stripe/stripe-go/v81/invoice"
 	stripesub "github.com/stripe/stripe-go/v81/subscription"
+	"github.com/stripe/stripe-go/v81/subscriptionschedule"
 	"github.com/stripe/stripe-go/v81/webhook"
 	"go.uber.org/zap"
 )
 
+// Entitlement is the plan a user may use and the scope its AI quota is counted in.
+type Entitlement struct {
+	Plan models.SubscriptionPlan
+	// OrganizationID is set when the plan comes from an organization; the quota
+	// is then multiplied by Seats and shared by all members.
+	OrganizationID *uuid.UUID
+	Seats          int
+}
+
 type SubscriptionUseCase interface {
 	GetMySubscription(ctx context.Context, userID uuid.UUID) (*dto.SubscriptionResponse, error)
 	GetEntitlement(ctx context.Context, userID uuid.UUID) (models.SubscriptionPlan, error)
+	ResolveEntitlement(ctx context.Context, userID uuid.UUID) (*Entitlement, error)
 	CreateCheckoutSession(ctx context.Context, userID uuid.UUID, req *dto.CreateCheckoutSessionRequest) (*dto.CreateCheckoutSessionResponse, error)
 	CreatePortalSession(ctx context.Context, userID uuid.UUID, req *dto.CreatePortalSessionRequest) (*dto.CreatePortalSessionResponse, error)
+	StartTrial(ctx context.Context, userID uuid.UUID) (*dto.SubscriptionResponse, error)
+	ConsumeBonusAIRequest(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListInvoices(ctx context.Context, userID uuid.UUID, limit int) (*dto.InvoicesResponse, error)
+	PreviewPlanChange(ctx context.Context, userID uuid.UUID, plan string) (*dto.ChangePlanPreviewResponse, error)
+	ChangePlan(ctx context.Context, userID uuid.UUID, req *dto.ChangePlanRequest) (*dto.ChangePlanResponse, error)
 	HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error
+	NotifyQuotaNearlyExhausted(ctx context.Context, userID uuid.UUID, plan models.SubscriptionPlan, used, limit int64, renewsAt time.Time) error
 }
 
+// Checkout, plan change and free trial errors, reported to clients by their code.
+var (
+	ErrInvalidSubscriptionPlan   = apperrors.NewCodedError(apperrors.CodeInvalidPlan, "")
+	ErrNoActiveSubscription      = apperrors.NewCodedError(apperrors.CodeNoActiveSubscription, "")
+	ErrPlanUnchanged             = apperrors.NewCodedError(apperrors.CodePlanUnchanged, "")
+	ErrSubscriptionAlreadyActive = apperrors.NewCodedError(apperrors.CodeSubscriptionAlreadyActive, "")
+	ErrNoBillingAccount          = apperrors.NewCodedError(apperrors.CodeNoBillingAccount, "")
+	ErrReturnURLRequired         = apperrors.NewCodedError(apperrors.CodeValidation, "return_url is required")
+
+	ErrTrialUnavailable           = apperrors.NewCodedError(apperrors.CodeTrialUnavailable, "")
+	ErrTrialAlreadyUsed           = apperrors.NewCodedError(apperrors.CodeTrialAlreadyUsed, "")
+	ErrTrialAfterPaidSubscription = apperrors.NewCodedError(apperrors.CodeTrialAfterPaidSubscription, "")
+)
+
 type subscriptionUseCase struct {
-	subscriptionRepo repositories.SubscriptionRepository
-	userRepo         repositories.UserRepository
-	logger           *zap.Logger
-	stripeCfg        config.StripeConfig
-	now              func() time.Time
+	subscriptionRepo    repositories.SubscriptionRepository
+	userRepo            repositories.UserRepository
+	prices              *priceResolver
+	invoiceRepo         repositories.InvoiceRepository
+	referralUseCase     ReferralUseCase
+	organizationUseCase OrganizationUseCase
+	emailUseCase        EmailUseCase
+	logger              *zap.Logger
+	stripeCfg           config.StripeConfig
+	policy              config.SubscriptionPolicy
+	now                 func() time.Time
 }
 
 func NewSubscriptionUseCase(
 	subscriptionRepo repositories.SubscriptionRepository,
 	userRepo repositories.UserRepository,
+	planPriceRepo repositories.PlanPriceRepository,
+	invoiceRepo repositories.InvoiceRepository,
+	referralUseCase ReferralUseCase,
+	organizationUseCase OrganizationUseCase,
+	emailUseCase EmailUseCase,
 	stripeCfg config.StripeConfig,
+	policy config.SubscriptionPolicy,
 	logger *zap.Logger,
 ) SubscriptionUseCase {
 	return &subscriptionUseCase{
-		subscriptionRepo: subscriptionRepo,
-		userRepo:         userRepo,
-		logger:           logger,
-		stripeCfg:        stripeCfg,
-		now:              time.Now,
+		subscriptionRepo:    subscriptionRepo,
+		userRepo:            userRepo,
+		prices:              newPriceResolver(planPriceRepo, stripeCfg.Prices, logger),
+		invoiceRepo:         invoiceRepo,
+		referralUseCase:     referralUseCase,
+		organizationUseCase: organizationUseCase,
+		emailUseCase:        emailUseCase,
+		logger:              logger,
+		stripeCfg:           stripeCfg,
+		policy:              policy,
+		now:                 time.Now,
 	}
 }
 
@@ -66,24 +117,7 @@ func (uc *subscriptionUseCase) GetMySubscription(ctx context.Context, userID uui
 		}, nil
 	}
 
-	planToReturn := subscription.Plan
-	if active, _ := isSubscriptionActive(uc.now(), subscription); !active {
-		planToReturn = models.SubscriptionPlanFree
-	} else if !planToReturn.IsValid() {
-		planToReturn = models.SubscriptionPlanFree
-	}
-
-	return &dto.SubscriptionResponse{
-		UserID:            subscription.UserID,
-		Plan:              string(planToReturn),
-		Status:            string(subscription.Status),
-		StripeCustomerID:  subscription.StripeCustomerID,
-		CurrentPeriodEnd:  subscription.CurrentPeriodEnd,
-		CancelAtPeriodEnd: subscription.CancelAtPeriodEnd,
-		CanceledAt:        subscription.CanceledAt,
-		TrialEndsAt:       subscription.TrialEndsAt,
-		AccessRevokedAt:   subscription.AccessRevokedAt,
-	}, nil
+	return uc.toSubscriptionResponse(subscription), nil
 }
 
 func (uc *subscriptionUseCase) GetEntitlement(ctx context.Context, userID uuid.UUID) (models.SubscriptionPlan, error) {
@@ -95,7 +129,7 @@ func (uc *subscriptionUseCase) GetEntitlement(ctx context.Context, userID uuid.U
 		return models.SubscriptionPlanFree, nil
 	}
 
-	active, reason := isSubscriptionActive(uc.now(), subscription)
+	active, reason := isSubscriptionActive(uc.now(), uc.policy, subscription)
 	if !active {
 		uc.logger.Info(
 			"Subscription not active, falling back to free plan",
@@ -114,6 +148,28 @@ func (uc *subscriptionUseCase) GetEntitlement(ctx context.Context, userID uuid.U
 	return subscription.Plan, nil
 }
 
+// ResolveEntitlement picks between the user's own plan and their organization's
+// plan, preferring the organization unless the personal plan ranks higher.
+func (uc *subscriptionUseCase) ResolveEntitlement(ctx context.Context, userID uuid.UUID) (*Entitlement, error) {
+	plan, err := uc.GetEntitlement(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	personal := &Entitlement{Plan: plan}
+
+	if uc.organizationUseCase == nil {
+		return personal, nil
+	}
+	organization, err := uc.organizationUseCase.GetEntitlement(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if organization == nil || organization.Plan.Rank() < plan.Rank() {
+		return personal, nil
+	}
+	return organization, nil
+}
+
 func (uc *subscriptionUseCase) CreateCheckoutSession(ctx context.Context, userID uuid.UUID, req *dto.CreateCheckoutSessionRequest) (*dto.CreateCheckoutSessionResponse, error) {
 	if uc.stripeCfg.SecretKey == "" {
 		return nil, errors.New("stripe secret key not configured")
@@ -121,10 +177,19 @@ func (uc *subscriptionUseCase) CreateCheckoutSession(ctx context.Context, userID
 
 	plan := models.SubscriptionPlan(req.Plan)
 	if !plan.IsValid() || plan == models.SubscriptionPlanFree {
-		return nil, fmt.Errorf("invalid plan: %s", req.Plan)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSubscriptionPlan, req.Plan)
+	}
+
+	interval := req.Interval
+	if interval == "" {
+		interval = config.BillingIntervalMonth
+	}
+	currency := req.Currency
+	if currency == "" {
+		currency = uc.stripeCfg.Prices.DefaultCurrency
 	}
 
-	priceID, err := uc.priceIDForPlan(plan)
+	price, err := uc.prices.resolve(ctx, plan, interval, currency)
 	if err != nil {
 		return nil, err
 	}
@@ -141,6 +206,12 @@ func (uc *subscriptionUseCase) CreateCheckoutSession(ctx context.Context, userID
 		return nil, err
 	}
 
+	// An existing paid subscription must be modified in place; a new checkout
+	// would create a second Stripe subscription and reset the local one.
+	if hasActivePaidSubscription(uc.now(), uc.policy, subscription) {
+		return nil, ErrSubscriptionAlreadyActive
+	}
+
 	stripeCustomerID := ""
 	if subscription != nil && subscription.StripeCustomerID != nil && *subscription.StripeCustomerID != "" {
 		stripeCustomerID = *subscription.StripeCustomerID
@@ -164,14 +235,22 @@ func (uc *subscriptionUseCase) CreateCheckoutSession(ctx context.Context, userID
 			Plan:             plan,
 			Status:           models.SubscriptionStatusIncomplete,
 			StripeCustomerID: &stripeCustomerID,
+			BillingInterval:  price.Interval,
+			Currency:         price.Currency,
 		}
 		if err := uc.subscriptionRepo.Create(ctx, subscription); err != nil {
 			return nil, err
 		}
 	} else {
-		subscription.Plan = plan
-		subscription.Status = models.SubscriptionStatusIncomplete
+		// A running local trial keeps its access until checkout.session.completed
+		// provisions the paid plan, so an abandoned checkout does not end the trial.
+		if !isLocalTrialActive(uc.now(), subscription) {
+			subscription.Plan = plan
+			subscription.Status = models.SubscriptionStatusIncomplete
+		}
 		subscription.StripeCustomerID = &stripeCustomerID
+		subscription.BillingInterval = price.Interval
+		subscription.Currency = price.Currency
 		if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
 			return nil, err
 		}
@@ -186,13 +265,15 @@ func (uc *subscriptionUseCase) CreateCheckoutSession(ctx context.Context, userID
 		AllowPromotionCodes: stripe.Bool(true),
 		LineItems: []*stripe.CheckoutSessionLineItemParams{
 			{
-				Price:    stripe.String(priceID),
+				Price:    stripe.String(price.PriceID),
 				Quantity: stripe.Int64(1),
 			},
 		},
 	}
 	checkoutParams.AddMetadata("user_id", userID.String())
 	checkoutParams.AddMetadata("plan", string(plan))
+	checkoutParams.AddMetadata("interval", price.Interval)
+	checkoutParams.AddMetadata("currency", price.Currency)
 
 	s, err := session.New(checkoutParams)
 	if err != nil {
@@ -210,7 +291,7 @@ func (uc *subscriptionUseCase) CreatePortalSession(ctx context.Context, userID u
 		return nil, errors.New("stripe secret key not configured")
 	}
 	if req == nil || req.ReturnURL == "" {
-		return nil, errors.New("return_url is required")
+		return nil, ErrReturnURLRequired
 	}
 
 	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, userID)
@@ -218,7 +299,7 @@ func (uc *subscriptionUseCase) CreatePortalSession(ctx context.Context, userID u
 		return nil, err
 	}
 	if subscription == nil || subscription.StripeCustomerID == nil || *subscription.StripeCustomerID == "" {
-		return nil, errors.New("stripe customer not found for user")
+		return nil, ErrNoBillingAccount
 	}
 
 	stripe.Key = uc.stripeCfg.SecretKey
@@ -240,6 +321,399 @@ func (uc *subscriptionUseCase) CreatePortalSession(ctx context.Context, userID u
 	return &dto.CreatePortalSessionResponse{PortalURL: s.URL}, nil
 }
 
+const (
+	planChangeUpgrade   = "upgrade"
+	planChangeDowngrade = "downgrade"
+
+	prorationAlwaysInvoice = "always_invoice"
+	prorationNone          = "none"
+)
+
+// planChangeTarget bundles everything needed to move a Stripe subscription to another plan.
+type planChangeTarget struct {
+	subscription *models.Subscription
+	stripeSub    *stripe.Subscription
+	item         *stripe.SubscriptionItem
+	newPlan      models.SubscriptionPlan
+	newPriceID   string
+	changeType   string
+}
+
+// StartTrial grants the configured trial plan once per user, without a card.
+// The trial is tracked locally; checkout can be completed at any time to keep the plan.
+func (uc *subscriptionUseCase) StartTrial(ctx context.Context, userID uuid.UUID) (*dto.SubscriptionResponse, error) {
+	if !uc.policy.TrialEnabled() {
+		return nil, ErrTrialUnavailable
+	}
+
+	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription != nil && subscription.TrialUsedAt != nil {
+		return nil, ErrTrialAlreadyUsed
+	}
+	if subscription != nil && subscription.StripeSubscriptionID != nil && *subscription.StripeSubscriptionID != "" {
+		return nil, ErrTrialAfterPaidSubscription
+	}
+
+	now := uc.now()
+	trialEnd := now.Add(uc.policy.TrialDuration())
+
+	if subscription == nil {
+		subscription = &models.Subscription{UserID: userID}
+	}
+	subscription.Plan = uc.policy.TrialPlan
+	subscription.Status = models.SubscriptionStatusTrialing
+	subscription.TrialUsedAt = &now
+	subscription.TrialEndsAt = &trialEnd
+	subscription.TrialReminderSentAt = nil
+	subscription.CurrentPeriodEnd = nil
+
+	if subscription.ID == uuid.Nil {
+		err = uc.subscriptionRepo.Create(ctx, subscription)
+	} else {
+		err = uc.subscriptionRepo.Save(ctx, subscription)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info(
+		"Free trial started",
+		zap.String("user_id", userID.String()),
+		zap.String("plan", string(subscription.Plan)),
+		zap.Time("trial_ends_at", trialEnd),
+	)
+
+	return uc.toSubscriptionResponse(subscription), nil
+}
+
+// ConsumeBonusAIRequest spends one bonus AI request (e.g. from referral rewards) once the plan quota is exhausted.
+func (uc *subscriptionUseCase) ConsumeBonusAIRequest(ctx context.Context, userID uuid.UUID) (bool, error) {
+	return uc.subscriptionRepo.ConsumeBonusAIRequest(ctx, userID)
+}
+
+// ListInvoices returns the user's invoice history from the local copy kept in sync by webhooks.
+func (uc *subscriptionUseCase) ListInvoices(ctx context.Context, userID uuid.UUID, limit int) (*dto.InvoicesResponse, error) {
+	if limit < 1 || limit > 100 {
+		limit = 24
+	}
+
+	resp := &dto.InvoicesResponse{Invoices: []dto.InvoiceResponse{}}
+
+	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil || subscription.StripeCustomerID == nil || *subscription.StripeCustomerID == "" {
+		return resp, nil
+	}
+
+	invoices, err := uc.invoiceRepo.ListByStripeCustomerID(ctx, *subscription.StripeCustomerID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, inv := range invoices {
+		resp.Invoices = append(resp.Invoices, dto.InvoiceResponse{
+			ID:               inv.StripeInvoiceID,
+			Number:           inv.Number,
+			Status:           inv.Status,
+			Currency:         inv.Currency,
+			AmountDue:        inv.AmountDue,
+			AmountPaid:       inv.AmountPaid,
+			Total:            inv.Total,
+			HostedInvoiceURL: inv.HostedInvoiceURL,
+			InvoicePDF:       inv.InvoicePDF,
+			PeriodStart:      inv.PeriodStart,
+			PeriodEnd:        inv.PeriodEnd,
+			IssuedAt:         inv.IssuedAt,
+			PaidAt:           inv.PaidAt,
+		})
+	}
+
+	return resp, nil
+}
+
+func (uc *subscriptionUseCase) PreviewPlanChange(ctx context.Context, userID uuid.UUID, plan string) (*dto.ChangePlanPreviewResponse, error) {
+	target, err := uc.loadPlanChangeTarget(ctx, userID, models.SubscriptionPlan(plan))
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	periodEnd := time.Unix(target.stripeSub.CurrentPeriodEnd, 0).UTC()
+
+	details := &stripe.InvoiceCreatePreviewSubscriptionDetailsParams{
+		Items: []*stripe.InvoiceCreatePreviewSubscriptionDetailsItemParams{
+			{
+				ID:    stripe.String(target.item.ID),
+				Price: stripe.String(target.newPriceID),
+			},
+		},
+	}
+	effectiveAt := periodEnd
+	if target.changeType == planChangeUpgrade {
+		details.ProrationBehavior = stripe.String(prorationAlwaysInvoice)
+		details.ProrationDate = stripe.Int64(now.Unix())
+		effectiveAt = now
+	} else {
+		details.ProrationBehavior = stripe.String(prorationNone)
+	}
+
+	preview, err := invoice.CreatePreview(&stripe.InvoiceCreatePreviewParams{
+		Customer:            stripe.String(stripeIDFromCustomer(target.stripeSub.Customer)),
+		Subscription:        stripe.String(target.stripeSub.ID),
+		SubscriptionDetails: details,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create invoice preview: %w", err)
+	}
+
+	var prorationAmount int64
+	if preview.Lines != nil {
+		for _, line := range preview.Lines.Data {
+			if line.Proration {
+				prorationAmount += line.Amount
+			}
+		}
+	}
+
+	resp := &dto.ChangePlanPreviewResponse{
+		CurrentPlan:     string(target.subscription.Plan),
+		NewPlan:         string(target.newPlan),
+		ChangeType:      target.changeType,
+		EffectiveAt:     effectiveAt,
+		Currency:        string(preview.Currency),
+		ProrationAmount: prorationAmount,
+		AmountDue:       preview.AmountDue,
+	}
+	if target.changeType == planChangeDowngrade {
+		// Nothing is charged now; the preview is the first invoice at the new price.
+		resp.NextInvoiceAt = &periodEnd
+	}
+
+	return resp, nil
+}
+
+func (uc *subscriptionUseCase) ChangePlan(ctx context.Context, userID uuid.UUID, req *dto.ChangePlanRequest) (*dto.ChangePlanResponse, error) {
+	if req == nil {
+		return nil, ErrInvalidSubscriptionPlan
+	}
+
+	target, err := uc.loadPlanChangeTarget(ctx, userID, models.SubscriptionPlan(req.Plan))
+	if err != nil {
+		return nil, err
+	}
+
+	if target.changeType == planChangeUpgrade {
+		err = uc.applyUpgrade(target)
+	} else {
+		err = uc.scheduleDowngrade(target)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.subscriptionRepo.Save(ctx, target.subscription); err != nil {
+		return nil, err
+	}
+
+	if uc.logger != nil {
+		uc.logger.Info(
+			"Subscription plan change applied",
+			zap.String("user_id", userID.String()),
+			zap.String("change_type", target.changeType),
+			zap.String("plan", string(target.subscription.Plan)),
+			zap.String("new_plan", string(target.newPlan)),
+			zap.String("stripe_subscription_id", target.stripeSub.ID),
+		)
+	}
+
+	return &dto.ChangePlanResponse{
+		Plan:                  string(target.subscription.Plan),
+		Status:                string(target.subscription.Status),
+		ChangeType:            target.changeType,
+		ScheduledPlan:         planPtrToStrPtr(target.subscription.ScheduledPlan),
+		ScheduledPlanChangeAt: target.subscription.ScheduledPlanChangeAt,
+		CurrentPeriodEnd:      target.subscription.CurrentPeriodEnd,
+	}, nil
+}
+
+func (uc *subscriptionUseCase) loadPlanChangeTarget(ctx context.Context, userID uuid.UUID, plan models.SubscriptionPlan) (*planChangeTarget, error) {
+	if uc.stripeCfg.SecretKey == "" {
+		return nil, errors.New("stripe secret key not configured")
+	}
+	if !plan.IsValid() || plan == models.SubscriptionPlanFree {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSubscriptionPlan, plan)
+	}
+
+	subscription, err := uc.subscriptionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasActivePaidSubscription(uc.now(), uc.policy, subscription) {
+		return nil, ErrNoActiveSubscription
+	}
+	if subscription.Plan == plan && subscription.ScheduledPlan == nil {
+		return nil, fmt.Errorf("%w: %s", ErrPlanUnchanged, plan)
+	}
+
+	stripe.Key = uc.stripeCfg.SecretKey
+
+	stripeSub, err := stripesub.Get(*subscription.StripeSubscriptionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get stripe subscription: %w", err)
+	}
+	if stripeSub.Items == nil || len(stripeSub.Items.Data) != 1 {
+		return nil, errors.New("stripe subscription must have exactly one item to change plan")
+	}
+
+	// Plan changes keep the billing interval and currency the customer already pays in.
+	interval, currency := subscription.BillingInterval, subscription.Currency
+	if current := stripeSub.Items.Data[0].Price; current != nil {
+		if current.Recurring != nil && current.Recurring.Interval != "" {
+			interval = string(current.Recurring.Interval)
+		}
+		if current.Currency != "" {
+			currency = string(current.Currency)
+		}
+	}
+	if interval == "" {
+		interval = config.BillingIntervalMonth
+	}
+	if currency == "" {
+		currency = uc.stripeCfg.Prices.DefaultCurrency
+	}
+
+	newPrice, err := uc.prices.resolve(ctx, plan, interval, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-selecting the current plan while a downgrade is pending is treated as an
+	// upgrade so the pending schedule gets released.
+	changeType := planChangeDowngrade
+	if plan.Rank() >= subscription.Plan.Rank() {
+		changeType = planChangeUpgrade
+	}
+
+	return &planChangeTarget{
+		subscription: subscription,
+		stripeSub:    stripeSub,
+		item:         stripeSub.Items.Data[0],
+		newPlan:      plan,
+		newPriceID:   newPrice.PriceID,
+		changeType:   changeType,
+	}, nil
+}
+
+// applyUpgrade swaps the subscription price immediately and invoices the prorated difference.
+func (uc *subscriptionUseCase) applyUpgrade(target *planChangeTarget) error {
+	if err := uc.releaseSchedule(target); err != nil {
+		return err
+	}
+
+	if target.item.Price == nil || target.item.Price.ID != target.newPriceID {
+		params := &stripe.SubscriptionParams{
+			Items: []*stripe.SubscriptionItemsParams{
+				{
+					ID:    stripe.String(target.item.ID),
+					Price: stripe.String(target.newPriceID),
+				},
+			},
+			ProrationBehavior: stripe.String(prorationAlwaysInvoice),
+			ProrationDate:     stripe.Int64(uc.now().Unix()),
+		}
+		params.AddMetadata("plan", string(target.newPlan))
+
+		updated, err := stripesub.Update(target.stripeSub.ID, params)
+		if err != nil {
+			return fmt.Errorf("update stripe subscription: %w", err)
+		}
+		if updated.CurrentPeriodEnd > 0 {
+			end := time.Unix(updated.CurrentPeriodEnd, 0).UTC()
+			target.subscription.CurrentPeriodEnd = &end
+		}
+	}
+
+	target.subscription.Plan = target.newPlan
+	return nil
+}
+
+// scheduleDowngrade keeps the current price until the period ends and then moves
+// the subscription to the cheaper price through a Stripe subscription schedule.
+func (uc *subscriptionUseCase) scheduleDowngrade(target *planChangeTarget) error {
+	scheduleID := ""
+	if target.stripeSub.Schedule != nil && target.stripeSub.Schedule.ID != "" {
+		scheduleID = target.stripeSub.Schedule.ID
+	} else {
+		schedule, err := subscriptionschedule.New(&stripe.SubscriptionScheduleParams{
+			FromSubscription: stripe.String(target.stripeSub.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("create stripe subscription schedule: %w", err)
+		}
+		scheduleID = schedule.ID
+	}
+
+	currentPriceID := ""
+	if target.item.Price != nil {
+		currentPriceID = target.item.Price.ID
+	}
+
+	params := &stripe.SubscriptionScheduleParams{
+		EndBehavior: stripe.String(string(stripe.SubscriptionScheduleEndBehaviorRelease)),
+		Phases: []*stripe.SubscriptionSchedulePhaseParams{
+			{
+				Items: []*stripe.SubscriptionSchedulePhaseItemParams{
+					{Price: stripe.String(currentPriceID), Quantity: stripe.Int64(target.item.Quantity)},
+				},
+				StartDate: stripe.Int64(target.stripeSub.CurrentPeriodStart),
+				EndDate:   stripe.Int64(target.stripeSub.CurrentPeriodEnd),
+			},
+			{
+				Items: []*stripe.SubscriptionSchedulePhaseItemParams{
+					{Price: stripe.String(target.newPriceID), Quantity: stripe.Int64(target.item.Quantity)},
+				},
+				Iterations:        stripe.Int64(1),
+				ProrationBehavior: stripe.String(prorationNone),
+			},
+		},
+	}
+	params.AddMetadata("scheduled_plan", string(target.newPlan))
+
+	if _, err := subscriptionschedule.Update(scheduleID, params); err != nil {
+		return fmt.Errorf("update stripe subscription schedule: %w", err)
+	}
+
+	changeAt := time.Unix(target.stripeSub.CurrentPeriodEnd, 0).UTC()
+	scheduledPlan := target.newPlan
+	target.subscription.ScheduledPlan = &scheduledPlan
+	target.subscription.ScheduledPlanChangeAt = &changeAt
+	target.subscription.StripeScheduleID = &scheduleID
+	return nil
+}
+
+// releaseSchedule detaches a pending downgrade schedule so the subscription can be edited directly.
+func (uc *subscriptionUseCase) releaseSchedule(target *planChangeTarget) error {
+	scheduleID := strVal(target.subscription.StripeScheduleID)
+	if scheduleID == "" && target.stripeSub.Schedule != nil {
+		scheduleID = target.stripeSub.Schedule.ID
+	}
+	if scheduleID != "" {
+		if _, err := subscriptionschedule.Release(scheduleID, nil); err != nil {
+			return fmt.Errorf("release stripe subscription schedule: %w", err)
+		}
+	}
+
+	target.subscription.ScheduledPlan = nil
+	target.subscription.ScheduledPlanChangeAt = nil
+	target.subscription.StripeScheduleID = nil
+	return nil
+}
+
 func (uc *subscriptionUseCase) HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
 	if uc.stripeCfg.WebhookSecret == "" {
 		return errors.New("stripe webhook secret not configured")
@@ -292,6 +766,13 @@ func (uc *subscriptionUseCase) handleCheckoutSessionCompleted(ctx context.Contex
 		return fmt.Errorf("unmarshal checkout.session.completed: %w", err)
 	}
 
+	if s.Metadata["organization_id"] != "" {
+		if uc.organizationUseCase == nil {
+			return nil
+		}
+		return uc.organizationUseCase.HandleCheckoutCompleted(ctx, &s)
+	}
+
 	userID, err := uuid.Parse(s.ClientReferenceID)
 	if err != nil {
 		return fmt.Errorf("invalid client_reference_id (expected user id): %w", err)
@@ -317,6 +798,16 @@ func (uc *subscriptionUseCase) handleCheckoutSessionCompleted(ctx context.Contex
 	}
 
 	subscription.Plan = plan
+	if subscription.StripeSubscriptionID == nil && subscription.TrialEndsAt != nil {
+		// Paying ends the local trial; Stripe webhooks own the trial dates from here on.
+		subscription.TrialEndsAt = nil
+	}
+	if interval := s.Metadata["interval"]; interval != "" {
+		subscription.BillingInterval = interval
+	}
+	if currency := s.Metadata["currency"]; currency != "" {
+		subscription.Currency = currency
+	}
 	if cid := stripeIDFromCustomer(s.Customer); cid != "" {
 		subscription.StripeCustomerID = &cid
 	} else {
@@ -340,7 +831,14 @@ func (uc *subscriptionUseCase) handleCheckoutSessionCompleted(ctx context.Contex
 		)
 	}
 
-	return uc.subscriptionRepo.Save(ctx, subscription)
+	if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return err
+	}
+
+	uc.sendUserEmail(ctx, userID, "subscription activated", func(to EmailRecipient) error {
+		return uc.emailUseCase.SendSubscriptionActivatedEmail(to, string(plan))
+	})
+	return nil
 }
 
 func (uc *subscriptionUseCase) handleCustomerSubscriptionUpsert(ctx context.Context, event stripe.Event) error {
@@ -366,6 +864,12 @@ func (uc *subscriptionUseCase) handleCustomerSubscriptionUpsert(ctx context.Cont
 		}
 	}
 
+	if subscription == nil && uc.organizationUseCase != nil {
+		if handled, err := uc.organizationUseCase.SyncStripeSubscription(ctx, &s, false); handled || err != nil {
+			return err
+		}
+	}
+
 	if subscription == nil {
 		if uc.logger != nil {
 			uc.logger.Warn(
@@ -391,7 +895,36 @@ func (uc *subscriptionUseCase) handleCustomerSubscriptionUpsert(ctx context.Cont
 	}
 
 	if mapped, ok := mapStripeSubscriptionStatus(s.Status); ok {
-		subscription.Status = mapped
+		uc.applyStatus(subscription, mapped)
+	}
+	if s.TrialEnd > 0 {
+		trialEnd := time.Unix(s.TrialEnd, 0).UTC()
+		subscription.TrialEndsAt = &trialEnd
+		if subscription.TrialUsedAt == nil {
+			now := uc.now()
+			subscription.TrialUsedAt = &now
+		}
+	}
+
+	// Keep the local plan in sync with the Stripe price, e.g. when a scheduled downgrade takes effect.
+	if s.Items != nil && len(s.Items.Data) > 0 && s.Items.Data[0].Price != nil {
+		if entry, ok := uc.prices.findByPriceID(ctx, s.Items.Data[0].Price.ID); ok {
+			subscription.Plan = entry.Plan
+			subscription.BillingInterval = entry.Interval
+			subscription.Currency = entry.Currency
+		} else if uc.logger != nil {
+			uc.logger.Warn(
+				"Stripe price not found in price catalog, keeping local plan",
+				zap.String("event_id", event.ID),
+				zap.String("stripe_subscription_id", s.ID),
+				zap.String("price_id", s.Items.Data[0].Price.ID),
+			)
+		}
+	}
+	if s.Schedule == nil || s.Schedule.ID == "" {
+		subscription.ScheduledPlan = nil
+		subscription.ScheduledPlanChangeAt = nil
+		subscription.StripeScheduleID = nil
 	}
 
 	if uc.logger != nil {
@@ -437,6 +970,11 @@ func (uc *subscriptionUseCase) handleInvoicePaid(ctx context.Context, event stri
 	if err != nil {
 		return err
 	}
+	if subscription == nil && uc.organizationUseCase != nil {
+		if handled, err := uc.organizationUseCase.SyncStripeInvoice(ctx, &inv, true); handled || err != nil {
+			return err
+		}
+	}
 	if subscription == nil {
 		if uc.logger != nil {
 			uc.logger.Warn(
@@ -452,7 +990,7 @@ func (uc *subscriptionUseCase) handleInvoicePaid(ctx context.Context, event stri
 	}
 
 	now := uc.now()
-	subscription.Status = models.SubscriptionStatusActive
+	uc.applyStatus(subscription, models.SubscriptionStatusActive)
 	subscription.AccessRevokedAt = nil
 	subscription.AccessRevokeReason = nil
 
@@ -469,7 +1007,7 @@ func (uc *subscriptionUseCase) handleInvoicePaid(ctx context.Context, event stri
 				}
 				subscription.CancelAtPeriodEnd = stripeSub.CancelAtPeriodEnd
 				if mapped, ok := mapStripeSubscriptionStatus(stripeSub.Status); ok {
-					subscription.Status = mapped
+					uc.applyStatus(subscription, mapped)
 				}
 			} else if uc.logger != nil && err != nil {
 				uc.logger.Warn(
@@ -500,7 +1038,98 @@ func (uc *subscriptionUseCase) handleInvoicePaid(ctx context.Context, event stri
 		subscription.CanceledAt = nil
 	}
 
-	return uc.subscriptionRepo.Save(ctx, subscription)
+	if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return err
+	}
+
+	uc.recordInvoice(ctx, event, &inv, subscription)
+
+	// A failed referral reward fails the event so Stripe retries it; the invoice updates above
+	// are idempotent and rewards are granted once.
+	if uc.referralUseCase != nil && inv.AmountPaid > 0 && subscription.Plan != models.SubscriptionPlanFree {
+		if err := uc.referralUseCase.HandlePaidConversion(ctx, subscription.UserID); err != nil {
+			return fmt.Errorf("process referral conversion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recordInvoice stores the invoice in the local history. Failures are logged only:
+// the history is a cache and must not block subscription updates.
+func (uc *subscriptionUseCase) recordInvoice(ctx context.Context, event stripe.Event, inv *stripe.Invoice, subscription *models.Subscription) {
+	if uc.invoiceRepo == nil || inv.ID == "" {
+		return
+	}
+
+	customerID := stripeIDFromCustomer(inv.Customer)
+	if customerID == "" {
+		customerID = strVal(subscription.StripeCustomerID)
+	}
+
+	record := &models.Invoice{
+		UserID:           subscription.UserID,
+		StripeInvoiceID:  inv.ID,
+		StripeCustomerID: customerID,
+		Number:           inv.Number,
+		Status:           string(inv.Status),
+		Currency:         string(inv.Currency),
+		AmountDue:        inv.AmountDue,
+		AmountPaid:       inv.AmountPaid,
+		Total:            inv.Total,
+		HostedInvoiceURL: inv.HostedInvoiceURL,
+		InvoicePDF:       inv.InvoicePDF,
+		IssuedAt:         time.Unix(inv.Created, 0).UTC(),
+	}
+	if sid := stripeIDFromSubscription(inv.Subscription); sid != "" {
+		record.StripeSubscriptionID = &sid
+	}
+	if start, end := deriveInvoicePeriod(inv); end != nil {
+		record.PeriodStart = start
+		record.PeriodEnd = end
+	}
+	if inv.StatusTransitions != nil && inv.StatusTransitions.PaidAt > 0 {
+		paidAt := time.Unix(inv.StatusTransitions.PaidAt, 0).UTC()
+		record.PaidAt = &paidAt
+	}
+
+	if err := uc.invoiceRepo.Upsert(ctx, record); err != nil && uc.logger != nil {
+		uc.logger.Warn(
+			"Failed to store invoice history",
+			zap.String("event_id", event.ID),
+			zap.String("invoice_id", inv.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// deriveInvoicePeriod returns the billed service period, preferring line item periods
+// over the invoice period (which covers the previous cycle for subscription renewals).
+func deriveInvoicePeriod(inv *stripe.Invoice) (*time.Time, *time.Time) {
+	var start, end time.Time
+	if inv.Lines != nil {
+		for _, line := range inv.Lines.Data {
+			if line.Period == nil || line.Period.End <= 0 {
+				continue
+			}
+			lineStart := time.Unix(line.Period.Start, 0).UTC()
+			lineEnd := time.Unix(line.Period.End, 0).UTC()
+			if start.IsZero() || lineStart.Before(start) {
+				start = lineStart
+			}
+			if lineEnd.After(end) {
+				end = lineEnd
+			}
+		}
+	}
+	if end.IsZero() && inv.PeriodEnd > 0 {
+		start = time.Unix(inv.PeriodStart, 0).UTC()
+		end = time.Unix(inv.PeriodEnd, 0).UTC()
+	}
+	if end.IsZero() {
+		return nil, nil
+	}
+	return &start, &end
 }
 
 func derivePeriodEndFromInvoice(inv *stripe.Invoice) *time.Time {
@@ -535,6 +1164,38 @@ func derivePeriodEndFromInvoice(inv *stripe.Invoice) *time.Time {
 	return nil
 }
 
+// NotifyQuotaNearlyExhausted emails the user that most of the plan's monthly AI requests are used.
+// The caller decides when the threshold is crossed; this only sends the email.
+func (uc *subscriptionUseCase) NotifyQuotaNearlyExhausted(ctx context.Context, userID uuid.UUID, plan models.SubscriptionPlan, used, limit int64, renewsAt time.Time) error {
+	if uc.emailUseCase == nil {
+		return nil
+	}
+	user, err := uc.userRepo.GetByIDWithConfiguration(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return uc.emailUseCase.SendQuotaNearlyExhaustedEmail(emailRecipientOf(user), string(plan), used, limit, renewsAt)
+}
+
+// sendUserEmail sends a best-effort notification to a user: failures are logged, never
+// returned, so they cannot fail the webhook that triggered them.
+func (uc *subscriptionUseCase) sendUserEmail(ctx context.Context, userID uuid.UUID, kind string, send func(to EmailRecipient) error) {
+	if uc.emailUseCase == nil {
+		return
+	}
+	user, err := uc.userRepo.GetByIDWithConfiguration(ctx, userID)
+	if err == nil {
+		err = send(emailRecipientOf(user))
+	}
+	if err != nil && uc.logger != nil {
+		uc.logger.Warn("Failed to send subscription email",
+			zap.String("kind", kind),
+			zap.String("user_id", userID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
 func strVal(p *string) string {
 	if p == nil {
 		return ""
@@ -593,11 +1254,20 @@ func (uc *subscriptionUseCase) handleInvoicePaymentFailed(ctx context.Context, e
 		return err
 	}
 	if subscription == nil {
+		if uc.organizationUseCase != nil {
+			_, err := uc.organizationUseCase.SyncStripeInvoice(ctx, &inv, false)
+			return err
+		}
 		return nil
 	}
 
-	subscription.Status = models.SubscriptionStatusPastDue
-	return uc.subscriptionRepo.Save(ctx, subscription)
+	uc.applyStatus(subscription, models.SubscriptionStatusPastDue)
+	if err := uc.subscriptionRepo.Save(ctx, subscription); err != nil {
+		return err
+	}
+
+	uc.recordInvoice(ctx, event, &inv, subscription)
+	return nil
 }
 
 func (uc *subscriptionUseCase) handleCustomerSubscriptionDeleted(ctx context.Context, event stripe.Event) error {
@@ -614,6 +1284,10 @@ func (uc *subscriptionUseCase) handleCustomerSubscriptionDeleted(ctx context.Con
 		return err
 	}
 	if subscription == nil {
+		if uc.organizationUseCase != nil {
+			_, err := uc.organizationUseCase.SyncStripeSubscription(ctx, &s, true)
+			return err
+		}
 		return nil
 	}
 
@@ -653,35 +1327,104 @@ func (uc *subscriptionUseCase) handleChargeRefunded(ctx context.Context, event s
 	return uc.subscriptionRepo.Save(ctx, subscription)
 }
 
-func (uc *subscriptionUseCase) priceIDForPlan(plan models.SubscriptionPlan) (string, error) {
-	switch plan {
-	case models.SubscriptionPlanSimple:
-		if uc.stripeCfg.PriceSimple == "" {
-			return "", errors.New("stripe price id for simple plan not configured")
-		}
-		return uc.stripeCfg.PriceSimple, nil
-	case models.SubscriptionPlanMedium:
-		if uc.stripeCfg.PriceMedium == "" {
-			return "", errors.New("stripe price id for medium plan not configured")
-		}
-		return uc.stripeCfg.PriceMedium, nil
-	case models.SubscriptionPlanUltra:
-		if uc.stripeCfg.PriceUltra == "" {
-			return "", errors.New("stripe price id for ultra plan not configured")
+// applyStatus sets the subscription status and tracks when it entered past_due,
+// which starts the grace period and the payment failure reminder.
+func (uc *subscriptionUseCase) applyStatus(subscription *models.Subscription, status models.SubscriptionStatus) {
+	if status == models.SubscriptionStatusPastDue {
+		if subscription.PastDueSince == nil {
+			now := uc.now()
+			subscription.PastDueSince = &now
 		}
-		return uc.stripeCfg.PriceUltra, nil
-	default:
-		return "", fmt.Errorf("unsupported plan: %s", plan)
+	} else {
+		subscription.PastDueSince = nil
+		subscription.PaymentFailedReminderSentAt = nil
 	}
+	subscription.Status = status
 }
 
-func isSubscriptionActive(now time.Time, subscription *models.Subscription) (bool, string) {
+func (uc *subscriptionUseCase) toSubscriptionResponse(subscription *models.Subscription) *dto.SubscriptionResponse {
+	planToReturn := subscription.Plan
+	if active, _ := isSubscriptionActive(uc.now(), uc.policy, subscription); !active {
+		planToReturn = models.SubscriptionPlanFree
+	} else if !planToReturn.IsValid() {
+		planToReturn = models.SubscriptionPlanFree
+	}
+
+	var graceEndsAt *time.Time
+	if subscription.Status == models.SubscriptionStatusPastDue && subscription.PastDueSince != nil {
+		end := subscription.PastDueSince.Add(uc.policy.PastDueGrace())
+		graceEndsAt = &end
+	}
+
+	return &dto.SubscriptionResponse{
+		UserID:                subscription.UserID,
+		Plan:                  string(planToReturn),
+		Status:                string(subscription.Status),
+		StripeCustomerID:      subscription.StripeCustomerID,
+		BillingInterval:       subscription.BillingInterval,
+		Currency:              subscription.Currency,
+		CurrentPeriodEnd:      subscription.CurrentPeriodEnd,
+		CancelAtPeriodEnd:     subscription.CancelAtPeriodEnd,
+		CanceledAt:            subscription.CanceledAt,
+		TrialEndsAt:           subscription.TrialEndsAt,
+		TrialAvailable:        uc.policy.TrialEnabled() && subscription.TrialUsedAt == nil && subscription.StripeSubscriptionID == nil,
+		GracePeriodEndsAt:     graceEndsAt,
+		BonusAIRequests:       subscription.BonusAIRequests,
+		AccessRevokedAt:       subscription.AccessRevokedAt,
+		ScheduledPlan:         planPtrToStrPtr(subscription.ScheduledPlan),
+		ScheduledPlanChangeAt: subscription.ScheduledPlanChangeAt,
+	}
+}
+
+// hasActivePaidSubscription reports whether the user already pays for a plan through Stripe.
+func hasActivePaidSubscription(now time.Time, policy config.SubscriptionPolicy, subscription *models.Subscription) bool {
+	if subscription == nil || subscription.Plan == models.SubscriptionPlanFree {
+		return false
+	}
+	if subscription.StripeSubscriptionID == nil || *subscription.StripeSubscriptionID == "" {
+		return false
+	}
+	active, _ := isSubscriptionActive(now, policy, subscription)
+	return active
+}
+
+// isLocalTrialActive reports whether the user is in a trial started without Stripe.
+func isLocalTrialActive(now time.Time, subscription *models.Subscription) bool {
+	if subscription == nil || subscription.Status != models.SubscriptionStatusTrialing {
+		return false
+	}
+	if subscription.StripeSubscriptionID != nil && *subscription.StripeSubscriptionID != "" {
+		return false
+	}
+	return subscription.TrialEndsAt != nil && now.Before(*subscription.TrialEndsAt)
+}
+
+func planPtrToStrPtr(p *models.SubscriptionPlan) *string {
+	if p == nil {
+		return nil
+	}
+	s := string(*p)
+	return &s
+}
+
+func isSubscriptionActive(now time.Time, policy config.SubscriptionPolicy, subscription *models.Subscription) (bool, string) {
 	if subscription.AccessRevokedAt != nil {
 		return false, "access_revoked"
 	}
 
 	switch subscription.Status {
-	case models.SubscriptionStatusActive, models.SubscriptionStatusTrialing:
+	case models.SubscriptionStatusActive:
+	case models.SubscriptionStatusTrialing:
+		if subscription.TrialEndsAt != nil && now.After(*subscription.TrialEndsAt) {
+			return false, "trial_ended"
+		}
+	case models.SubscriptionStatusPastDue:
+		// Renewal failed: keep the paid plan until the grace period runs out,
+		// even though the billed period has already ended.
+		if subscription.PastDueSince == nil || now.After(subscription.PastDueSince.Add(policy.PastDueGrace())) {
+			return false, "past_due_grace_expired"
+		}
+		return true, "past_due_grace"
 	default:
 		return false, "stripe_status_not_active"
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/cache"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/dto"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/errors"
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/models"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/repositories"
 	"github.com/google/uuid"
@@ -29,15 +30,20 @@ type userUseCase struct {
 	userRepo          repositories.UserRepository
 	configurationRepo repositories.ConfigurationRepository
 	subscriptionRepo  repositories.SubscriptionRepository
+	referralUseCase   ReferralUseCase
+	emailUseCase      EmailUseCase
 	cacheService      *cache.CacheService
 	logger            *zap.Logger
 }
 
-// NewUserUseCase creates a new instance of UserUseCase
+// NewUserUseCase creates a new instance of UserUseCase.
+// emailUseCase may be nil, in which case the welcome and account deletion emails are not sent.
 func NewUserUseCase(
 	userRepo repositories.UserRepository,
 	configurationRepo repositories.ConfigurationRepository,
 	subscriptionRepo repositories.SubscriptionRepository,
+	referralUseCase ReferralUseCase,
+	emailUseCase EmailUseCase,
 	cacheService *cache.CacheService,
 	logger *zap.Logger,
 ) UserUseCase {
@@ -45,6 +51,8 @@ func NewUserUseCase(
 		userRepo:          userRepo,
 		configurationRepo: configurationRepo,
 		subscriptionRepo:  subscriptionRepo,
+		referralUseCase:   referralUseCase,
+		emailUseCase:      emailUseCase,
 		cacheService:      cacheService,
 		logger:            logger,
 	}
@@ -65,6 +73,12 @@ func (uc *userUseCase) CreateUser(ctx context.Context, req *dto.RegisterRequest)
 		ImageURL: req.ImageURL,
 	}
 
+	// Assign the user's own referral code and attribute the signup to a referrer, if any
+	if err := uc.referralUseCase.PrepareSignup(ctx, user, req.ReferralCode); err != nil {
+		uc.logger.Error("Failed to prepare referral for signup", zap.Error(err))
+		return nil, fmt.Errorf("failed to prepare referral for signup: %w", err)
+	}
+
 	// Save user to database
 	if err := uc.userRepo.Create(ctx, user); err != nil {
 		uc.logger.Error("Failed to create user in database", zap.Error(err))
@@ -74,8 +88,8 @@ func (uc *userUseCase) CreateUser(ctx context.Context, req *dto.RegisterRequest)
 	// Create default configuration for the user
 	configuration := &models.Configuration{
 		UserID:     user.ID,
-		Language:   "en",  // Default language
-		Newsletter: false, // Default: newsletter off
+		Language:   locale.Resolve(req.Language).Tag, // Signup language, else the default one
+		Newsletter: false,                            // Default: newsletter off
 	}
 
 	if err := uc.configurationRepo.Create(ctx, configuration); err != nil {
@@ -94,6 +108,14 @@ func (uc *userUseCase) CreateUser(ctx context.Context, req *dto.RegisterRequest)
 		return nil, fmt.Errorf("failed to create user subscription: %w", err)
 	}
 
+	// The welcome email is best effort: the account exists whether or not it is delivered
+	if uc.emailUseCase != nil {
+		recipient := EmailRecipient{Email: user.Email, Name: user.Name, Language: configuration.Language}
+		if err := uc.emailUseCase.SendWelcomeEmail(recipient); err != nil {
+			uc.logger.Warn("Failed to send welcome email", zap.Error(err), zap.String("user_id", user.ID.String()))
+		}
+	}
+
 	// Return response
 	return &dto.UserResponse{
 		ID:         user.ID,
@@ -302,8 +324,8 @@ func (uc *userUseCase) UpdateUser(ctx context.Context, id uuid.UUID, req *dto.Up
 
 // DeleteUser removes a user from the system
 func (uc *userUseCase) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	// Check if user exists
-	_, err := uc.userRepo.GetByID(ctx, id)
+	// Check if user exists (the configuration gives the language of the confirmation email)
+	user, err := uc.userRepo.GetByIDWithConfiguration(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -323,5 +345,11 @@ func (uc *userUseCase) DeleteUser(ctx context.Context, id uuid.UUID) error {
 		uc.logger.Debug("User cache invalidated after deletion", zap.String("user_id", id.String()))
 	}
 
+	if uc.emailUseCase != nil {
+		if err := uc.emailUseCase.SendAccountDeletedEmail(emailRecipientOf(user)); err != nil {
+			uc.logger.Warn("Failed to send account deletion email", zap.Error(err), zap.String("user_id", id.String()))
+		}
+	}
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package validators
 
 import (
+	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/locale"
 	"github.com/Daniel-Fonseca-da-Silva/dafon-cv-api/internal/validation"
 	"github.com/go-playground/validator/v10"
 )
@@ -9,6 +10,7 @@ import (
 func RegisterCustomValidators(v *validator.Validate) {
 	v.RegisterValidation("phone", validatePhone)
 	v.RegisterValidation("strong_password", validateStrongPassword)
+	v.RegisterValidation("locale", validateLocale)
 }
 
 // validatePhone is a custom validator function for phone numbers
@@ -28,3 +30,12 @@ func validateStrongPassword(fl validator.FieldLevel) bool {
 	}
 	return validation.IsStrongPassword(password)
 }
+
+// validateLocale is a custom validator function for language codes and tags of the locale registry
+func validateLocale(fl validator.FieldLevel) bool {
+	value, ok := fl.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+	return locale.IsSupported(value)
+}